@@ -92,6 +92,7 @@ variable "service_labels" {
     "elbv2",
     "emr",
     "emrcontainers",
+    "emrserverless",
     "events",
     "firehose",
     "fms",
@@ -104,6 +105,7 @@ variable "service_labels" {
     "glue",
     "grafana",
     "greengrass",
+    "greengrassv2",
     "groundstation",
     "guardduty",
     "honeycode",
@@ -129,6 +131,7 @@ variable "service_labels" {
     "lakeformation",
     "lambda",
     "lexmodels",
+    "lexv2models",
     "licensemanager",
     "lightsail",
     "location",
@@ -105,6 +105,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/emrserverless"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/finspace"
 	"github.com/aws/aws-sdk-go/service/finspacedata"
@@ -388,6 +389,7 @@ const (
 	ELBV2                         = "elbv2"
 	EMR                           = "emr"
 	EMRContainers                 = "emrcontainers"
+	EMRServerless                 = "emrserverless"
 	Events                        = "events"
 	FinSpace                      = "finspace"
 	FinSpaceData                  = "finspacedata"
@@ -685,6 +687,7 @@ func init() {
 	serviceData[ELBV2] = &ServiceDatum{AWSClientName: "ELBV2", AWSServiceName: elbv2.ServiceName, AWSEndpointsID: elbv2.EndpointsID, AWSServiceID: elbv2.ServiceID, ProviderNameUpper: "ELBV2", HCLKeys: []string{"elbv2"}}
 	serviceData[EMR] = &ServiceDatum{AWSClientName: "EMR", AWSServiceName: emr.ServiceName, AWSEndpointsID: emr.EndpointsID, AWSServiceID: emr.ServiceID, ProviderNameUpper: "EMR", HCLKeys: []string{"emr"}}
 	serviceData[EMRContainers] = &ServiceDatum{AWSClientName: "EMRContainers", AWSServiceName: emrcontainers.ServiceName, AWSEndpointsID: emrcontainers.EndpointsID, AWSServiceID: emrcontainers.ServiceID, ProviderNameUpper: "EMRContainers", HCLKeys: []string{"emrcontainers"}}
+	serviceData[EMRServerless] = &ServiceDatum{AWSClientName: "EMRServerless", AWSServiceName: emrserverless.ServiceName, AWSEndpointsID: emrserverless.EndpointsID, AWSServiceID: emrserverless.ServiceID, ProviderNameUpper: "EMRServerless", HCLKeys: []string{"emrserverless"}}
 	serviceData[Events] = &ServiceDatum{AWSClientName: "EventBridge", AWSServiceName: eventbridge.ServiceName, AWSEndpointsID: eventbridge.EndpointsID, AWSServiceID: eventbridge.ServiceID, ProviderNameUpper: "Events", HCLKeys: []string{"eventbridge", "cloudwatchevents", "events"}}
 	serviceData[FinSpace] = &ServiceDatum{AWSClientName: "Finspace", AWSServiceName: finspace.ServiceName, AWSEndpointsID: finspace.EndpointsID, AWSServiceID: finspace.ServiceID, ProviderNameUpper: "FinSpace", HCLKeys: []string{"finspace"}}
 	serviceData[FinSpaceData] = &ServiceDatum{AWSClientName: "FinSpaceData", AWSServiceName: finspacedata.ServiceName, AWSEndpointsID: finspacedata.EndpointsID, AWSServiceID: finspacedata.ServiceID, ProviderNameUpper: "FinSpaceData", HCLKeys: []string{"finspacedata"}}
@@ -995,6 +998,7 @@ type AWSClient struct {
 	ELBV2Conn                         *elbv2.ELBV2
 	EMRConn                           *emr.EMR
 	EMRContainersConn                 *emrcontainers.EMRContainers
+	EMRServerlessConn                 *emrserverless.EMRServerless
 	EventsConn                        *eventbridge.EventBridge
 	FinSpaceConn                      *finspace.Finspace
 	FinSpaceDataConn                  *finspacedata.FinSpaceData
@@ -1394,6 +1398,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		ELBV2Conn:                         elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELBV2])})),
 		EMRConn:                           emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMR])})),
 		EMRContainersConn:                 emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMRContainers])})),
+		EMRServerlessConn:                 emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMRServerless])})),
 		EventsConn:                        eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Events])})),
 		FinSpaceConn:                      finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpace])})),
 		FinSpaceDataConn:                  finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpaceData])})),
@@ -300,6 +300,8 @@ func awsServiceName(s string) (string, error) {
 		return "eventbridge", nil
 	case "lexmodels":
 		return "lexmodelbuildingservice", nil
+	case "lexv2models":
+		return "lexmodelsv2", nil
 	case "serverlessrepo":
 		return "serverlessapplicationrepository", nil
 	}
@@ -335,6 +337,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["eventbridge"], nil
 	case "lexmodels":
 		return awsServiceNames["lexmodelbuildingservice"], nil
+	case "lexv2models":
+		return awsServiceNames["lexmodelsv2"], nil
 	case "serverlessrepo":
 		return awsServiceNames["serverlessapplicationrepository"], nil
 	}
@@ -449,6 +453,7 @@ func init() {
 	awsServiceNames["elbv2"] = "ELBV2"
 	awsServiceNames["emr"] = "EMR"
 	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["emrserverless"] = "EMRServerless"
 	awsServiceNames["eventbridge"] = "EventBridge"
 	awsServiceNames["expression"] = "Expression"
 	awsServiceNames["finspace"] = "FinSpace"
@@ -618,7 +623,7 @@ func init() {
 	awsServiceNames["textract"] = "Textract"
 	awsServiceNames["timestreamquery"] = "TimestreamQuery"
 	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
-	awsServiceNames["transcribe"] = "Transcribe"
+	awsServiceNames["transcribeservice"] = "TranscribeService"
 	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
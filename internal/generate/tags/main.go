@@ -788,6 +788,8 @@ func awsServiceName(s string) (string, error) {
 	switch s {
 	case "amp":
 		return "prometheusservice", nil
+	case "ce":
+		return "costexplorer", nil
 	case "cloudcontrol":
 		return "cloudcontrolapi", nil
 	case "cognitoidp":
@@ -800,6 +802,8 @@ func awsServiceName(s string) (string, error) {
 		return "eventbridge", nil
 	case "lexmodels":
 		return "lexmodelbuildingservice", nil
+	case "lexv2models":
+		return "lexmodelsv2", nil
 	case "serverlessrepo":
 		return "serverlessapplicationrepository", nil
 	}
@@ -823,6 +827,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["prometheusservice"], nil
 	case "appautoscaling":
 		return awsServiceNames["applicationautoscaling"], nil
+	case "ce":
+		return awsServiceNames["costexplorer"], nil
 	case "cloudcontrol":
 		return awsServiceNames["cloudcontrolapi"], nil
 	case "cognitoidp":
@@ -835,6 +841,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["eventbridge"], nil
 	case "lexmodels":
 		return awsServiceNames["lexmodelbuildingservice"], nil
+	case "lexv2models":
+		return awsServiceNames["lexmodelsv2"], nil
 	case "serverlessrepo":
 		return awsServiceNames["serverlessapplicationrepository"], nil
 	}
@@ -869,7 +877,7 @@ func init() {
 	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
 	awsServiceNames["apigatewayv2"] = "ApiGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
-	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appflow"] = "Appflow"
 	awsServiceNames["appintegrations"] = "AppIntegrations"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
@@ -956,6 +964,7 @@ func init() {
 	awsServiceNames["elbv2"] = "ELBV2"
 	awsServiceNames["emr"] = "EMR"
 	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["emrserverless"] = "EMRServerless"
 	awsServiceNames["eventbridge"] = "EventBridge"
 	awsServiceNames["expression"] = "Expression"
 	awsServiceNames["finspace"] = "FinSpace"
@@ -1125,7 +1134,7 @@ func init() {
 	awsServiceNames["textract"] = "Textract"
 	awsServiceNames["timestreamquery"] = "TimestreamQuery"
 	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
-	awsServiceNames["transcribe"] = "Transcribe"
+	awsServiceNames["transcribeservice"] = "TranscribeService"
 	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
 	awsServiceNames["transfer"] = "Transfer"
 	awsServiceNames["translate"] = "Translate"
@@ -23,16 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
@@ -52,6 +55,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/codestarnotifications"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
@@ -80,6 +84,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
@@ -89,14 +95,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
@@ -105,11 +115,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
@@ -118,11 +131,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/proton"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -154,17 +169,22 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -406,6 +426,10 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"aws_account_alternate_contact": account.DataSourceAlternateContact(),
+			"aws_account_primary_contact":   account.DataSourcePrimaryContact(),
+			"aws_account_region":            account.DataSourceRegion(),
+
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
@@ -426,6 +450,9 @@ func Provider() *schema.Provider {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_auditmanager_control":   auditmanager.DataSourceControl(),
+			"aws_auditmanager_framework": auditmanager.DataSourceFramework(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -440,6 +467,8 @@ func Provider() *schema.Provider {
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.DataSourceSchedulingPolicy(),
 
+			"aws_ce_cost_and_usage": ce.DataSourceCostAndUsage(),
+
 			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
@@ -619,8 +648,12 @@ func Provider() *schema.Provider {
 
 			"aws_emr_release_labels": emr.DataSourceReleaseLabels(),
 
+			"aws_emrcontainers_virtual_cluster": emrcontainers.DataSourceVirtualCluster(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.DataSourceDeliveryStream(),
 
+			"aws_fms_policy_compliance": fms.DataSourcePolicyCompliance(),
+
 			"aws_globalaccelerator_accelerator": globalaccelerator.DataSourceAccelerator(),
 
 			"aws_glue_connection":                       glue.DataSourceConnection(),
@@ -645,8 +678,10 @@ func Provider() *schema.Provider {
 			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
 			"aws_iam_users":                   iam.DataSourceUsers(),
 
-			"aws_identitystore_group": identitystore.DataSourceGroup(),
-			"aws_identitystore_user":  identitystore.DataSourceUser(),
+			"aws_identitystore_group":  identitystore.DataSourceGroup(),
+			"aws_identitystore_groups": identitystore.DataSourceGroups(),
+			"aws_identitystore_user":   identitystore.DataSourceUser(),
+			"aws_identitystore_users":  identitystore.DataSourceUsers(),
 
 			"aws_imagebuilder_component":                     imagebuilder.DataSourceComponent(),
 			"aws_imagebuilder_components":                    imagebuilder.DataSourceComponents(),
@@ -673,9 +708,20 @@ func Provider() *schema.Provider {
 			"aws_mskconnect_custom_plugin":        kafkaconnect.DataSourceCustomPlugin(),
 			"aws_mskconnect_worker_configuration": kafkaconnect.DataSourceWorkerConfiguration(),
 
+			"aws_kendra_data_source":                  kendra.DataSourceDataSource(),
+			"aws_kendra_experience":                   kendra.DataSourceExperience(),
+			"aws_kendra_faq":                          kendra.DataSourceFAQ(),
+			"aws_kendra_index":                        kendra.DataSourceIndex(),
+			"aws_kendra_query_suggestions_block_list": kendra.DataSourceQuerySuggestionsBlockList(),
+			"aws_kendra_thesaurus":                    kendra.DataSourceThesaurus(),
+
 			"aws_kinesis_stream":          kinesis.DataSourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.DataSourceStreamConsumer(),
 
+			"aws_kinesisvideo_signaling_channel_endpoint":        kinesisvideo.DataSourceSignalingChannelEndpoint(),
+			"aws_kinesisvideo_stream_dash_streaming_session_url": kinesisvideo.DataSourceStreamDASHStreamingSessionURL(),
+			"aws_kinesisvideo_stream_hls_streaming_session_url":  kinesisvideo.DataSourceStreamHLSStreamingSessionURL(),
+
 			"aws_kms_alias":      kms.DataSourceAlias(),
 			"aws_kms_ciphertext": kms.DataSourceCiphertext(),
 			"aws_kms_key":        kms.DataSourceKey(),
@@ -690,6 +736,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_function_url":        lambda.DataSourceFunctionURL(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -712,6 +759,8 @@ func Provider() *schema.Provider {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
+			"aws_networkmanager_core_network_policy_document": networkmanager.DataSourceCoreNetworkPolicyDocument(),
+
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
@@ -801,13 +850,24 @@ func Provider() *schema.Provider {
 			"aws_ssm_parameters_by_path": ssm.DataSourceParametersByPath(),
 			"aws_ssm_patch_baseline":     ssm.DataSourcePatchBaseline(),
 
-			"aws_ssoadmin_instances":      ssoadmin.DataSourceInstances(),
-			"aws_ssoadmin_permission_set": ssoadmin.DataSourcePermissionSet(),
+			"aws_ssmcontacts_contact":         ssmcontacts.DataSourceContact(),
+			"aws_ssmcontacts_contact_channel": ssmcontacts.DataSourceContactChannel(),
+			"aws_ssmcontacts_plan":            ssmcontacts.DataSourcePlan(),
+			"aws_ssmcontacts_rotation":        ssmcontacts.DataSourceRotation(),
+
+			"aws_ssmincidents_replication_set": ssmincidents.DataSourceReplicationSet(),
+			"aws_ssmincidents_response_plan":   ssmincidents.DataSourceResponsePlan(),
+
+			"aws_ssoadmin_instances":       ssoadmin.DataSourceInstances(),
+			"aws_ssoadmin_permission_set":  ssoadmin.DataSourcePermissionSet(),
+			"aws_ssoadmin_permission_sets": ssoadmin.DataSourcePermissionSets(),
 
 			"aws_storagegateway_local_disk": storagegateway.DataSourceLocalDisk(),
 
 			"aws_caller_identity": sts.DataSourceCallerIdentity(),
 
+			"aws_timestreamquery_query": timestreamquery.DataSourceQuery(),
+
 			"aws_transfer_server": transfer.DataSourceServer(),
 
 			"aws_waf_ipset":           waf.DataSourceIPSet(),
@@ -835,6 +895,8 @@ func Provider() *schema.Provider {
 			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
+			"aws_account_primary_contact":   account.ResourcePrimaryContact(),
+			"aws_account_region":            account.ResourceRegion(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
@@ -898,6 +960,9 @@ func Provider() *schema.Provider {
 			"aws_appconfig_environment":                  appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version": appconfig.ResourceHostedConfigurationVersion(),
 
+			"aws_appflow_connector_profile": appflow.ResourceConnectorProfile(),
+			"aws_appflow_flow":              appflow.ResourceFlow(),
+
 			"aws_appautoscaling_policy":           appautoscaling.ResourcePolicy(),
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
 			"aws_appautoscaling_target":           appautoscaling.ResourceTarget(),
@@ -936,6 +1001,12 @@ func Provider() *schema.Provider {
 			"aws_athena_named_query": athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":   athena.ResourceWorkGroup(),
 
+			"aws_auditmanager_account_registration": auditmanager.ResourceAccountRegistration(),
+			"aws_auditmanager_assessment":           auditmanager.ResourceAssessment(),
+			"aws_auditmanager_control":              auditmanager.ResourceControl(),
+			"aws_auditmanager_framework":            auditmanager.ResourceFramework(),
+			"aws_auditmanager_framework_share":      auditmanager.ResourceFrameworkShare(),
+
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
 			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
@@ -966,6 +1037,10 @@ func Provider() *schema.Provider {
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
 
+			"aws_ce_anomaly_monitor":      ce.ResourceAnomalyMonitor(),
+			"aws_ce_anomaly_subscription": ce.ResourceAnomalySubscription(),
+			"aws_ce_cost_category":        ce.ResourceCostCategory(),
+
 			"aws_chime_voice_connector":                         chime.ResourceVoiceConnector(),
 			"aws_chime_voice_connector_group":                   chime.ResourceVoiceConnectorGroup(),
 			"aws_chime_voice_connector_logging":                 chime.ResourceVoiceConnectorLogging(),
@@ -1070,6 +1145,10 @@ func Provider() *schema.Provider {
 			"aws_cognito_user_pool_domain":           cognitoidp.ResourceUserPoolDomain(),
 			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
 
+			"aws_comprehend_document_classifier": comprehend.ResourceDocumentClassifier(),
+			"aws_comprehend_endpoint":            comprehend.ResourceEndpoint(),
+			"aws_comprehend_entity_recognizer":   comprehend.ResourceEntityRecognizer(),
+
 			"aws_config_aggregate_authorization":       configservice.ResourceAggregateAuthorization(),
 			"aws_config_config_rule":                   configservice.ResourceConfigRule(),
 			"aws_config_configuration_aggregator":      configservice.ResourceConfigurationAggregator(),
@@ -1364,6 +1443,11 @@ func Provider() *schema.Provider {
 			"aws_emr_studio":                 emr.ResourceStudio(),
 			"aws_emr_studio_session_mapping": emr.ResourceStudioSessionMapping(),
 
+			"aws_emrcontainers_managed_endpoint": emrcontainers.ResourceManagedEndpoint(),
+			"aws_emrcontainers_virtual_cluster":  emrcontainers.ResourceVirtualCluster(),
+
+			"aws_emrserverless_application": emrserverless.ResourceApplication(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
@@ -1415,6 +1499,9 @@ func Provider() *schema.Provider {
 			"aws_grafana_workspace":           grafana.ResourceWorkspace(),
 			"aws_grafana_license_association": grafana.ResourceLicenseAssociation(),
 
+			"aws_greengrassv2_component_version": greengrassv2.ResourceComponentVersion(),
+			"aws_greengrassv2_deployment":        greengrassv2.ResourceDeployment(),
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
@@ -1452,6 +1539,10 @@ func Provider() *schema.Provider {
 			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
 			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
 
+			"aws_identitystore_group":            identitystore.ResourceGroup(),
+			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_user":             identitystore.ResourceUser(),
+
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1476,6 +1567,14 @@ func Provider() *schema.Provider {
 			"aws_iot_thing_type":                 iot.ResourceThingType(),
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 
+			"aws_iotanalytics_channel":   iotanalytics.ResourceChannel(),
+			"aws_iotanalytics_dataset":   iotanalytics.ResourceDataset(),
+			"aws_iotanalytics_datastore": iotanalytics.ResourceDatastore(),
+			"aws_iotanalytics_pipeline":  iotanalytics.ResourcePipeline(),
+
+			"aws_iotevents_detector_model": iotevents.ResourceDetectorModel(),
+			"aws_iotevents_input":          iotevents.ResourceInput(),
+
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
@@ -1483,6 +1582,13 @@ func Provider() *schema.Provider {
 			"aws_mskconnect_custom_plugin":        kafkaconnect.ResourceCustomPlugin(),
 			"aws_mskconnect_worker_configuration": kafkaconnect.ResourceWorkerConfiguration(),
 
+			"aws_kendra_data_source":                  kendra.ResourceDataSource(),
+			"aws_kendra_experience":                   kendra.ResourceExperience(),
+			"aws_kendra_faq":                          kendra.ResourceFAQ(),
+			"aws_kendra_index":                        kendra.ResourceIndex(),
+			"aws_kendra_query_suggestions_block_list": kendra.ResourceQuerySuggestionsBlockList(),
+			"aws_kendra_thesaurus":                    kendra.ResourceThesaurus(),
+
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
 
@@ -1490,7 +1596,8 @@ func Provider() *schema.Provider {
 			"aws_kinesisanalyticsv2_application":          kinesisanalyticsv2.ResourceApplication(),
 			"aws_kinesisanalyticsv2_application_snapshot": kinesisanalyticsv2.ResourceApplicationSnapshot(),
 
-			"aws_kinesis_video_stream": kinesisvideo.ResourceStream(),
+			"aws_kinesis_video_stream":           kinesisvideo.ResourceStream(),
+			"aws_kinesisvideo_signaling_channel": kinesisvideo.ResourceSignalingChannel(),
 
 			"aws_kms_alias":                kms.ResourceAlias(),
 			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
@@ -1509,6 +1616,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_invocation":                     lambda.ResourceInvocation(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
@@ -1520,6 +1628,14 @@ func Provider() *schema.Provider {
 			"aws_lex_intent":    lexmodels.ResourceIntent(),
 			"aws_lex_slot_type": lexmodels.ResourceSlotType(),
 
+			"aws_lexv2models_bot":         lexv2models.ResourceBot(),
+			"aws_lexv2models_bot_alias":   lexv2models.ResourceBotAlias(),
+			"aws_lexv2models_bot_locale":  lexv2models.ResourceBotLocale(),
+			"aws_lexv2models_bot_version": lexv2models.ResourceBotVersion(),
+			"aws_lexv2models_intent":      lexv2models.ResourceIntent(),
+			"aws_lexv2models_slot":        lexv2models.ResourceSlot(),
+			"aws_lexv2models_slot_type":   lexv2models.ResourceSlotType(),
+
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
@@ -1543,6 +1659,13 @@ func Provider() *schema.Provider {
 
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
+			"aws_mediaconnect_flow": mediaconnect.ResourceFlow(),
+
+			"aws_medialive_channel":              medialive.ResourceChannel(),
+			"aws_medialive_input":                medialive.ResourceInput(),
+			"aws_medialive_input_security_group": medialive.ResourceInputSecurityGroup(),
+			"aws_medialive_multiplex":            medialive.ResourceMultiplex(),
+
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
 
 			"aws_media_store_container":        mediastore.ResourceContainer(),
@@ -1575,6 +1698,15 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
 
+			"aws_networkmanager_connection":                   networkmanager.ResourceConnection(),
+			"aws_networkmanager_core_network":                 networkmanager.ResourceCoreNetwork(),
+			"aws_networkmanager_device":                       networkmanager.ResourceDevice(),
+			"aws_networkmanager_global_network":               networkmanager.ResourceGlobalNetwork(),
+			"aws_networkmanager_link":                         networkmanager.ResourceLink(),
+			"aws_networkmanager_link_association":             networkmanager.ResourceLinkAssociation(),
+			"aws_networkmanager_site":                         networkmanager.ResourceSite(),
+			"aws_networkmanager_transit_gateway_registration": networkmanager.ResourceTransitGatewayRegistration(),
+
 			"aws_opsworks_application":       opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":      opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ecs_cluster_layer": opsworks.ResourceECSClusterLayer(),
@@ -1612,6 +1744,13 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_proton_environment_account_connection": proton.ResourceEnvironmentAccountConnection(),
+			"aws_proton_environment_template":           proton.ResourceEnvironmentTemplate(),
+			"aws_proton_environment_template_version":   proton.ResourceEnvironmentTemplateVersion(),
+			"aws_proton_repository":                     proton.ResourceRepository(),
+			"aws_proton_service_template":               proton.ResourceServiceTemplate(),
+			"aws_proton_service_template_version":       proton.ResourceServiceTemplateVersion(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
@@ -1844,10 +1983,21 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssmcontacts_contact":                    ssmcontacts.ResourceContact(),
+			"aws_ssmcontacts_contact_channel":            ssmcontacts.ResourceContactChannel(),
+			"aws_ssmcontacts_contact_channel_activation": ssmcontacts.ResourceContactChannelActivation(),
+			"aws_ssmcontacts_plan":                       ssmcontacts.ResourcePlan(),
+			"aws_ssmcontacts_rotation":                   ssmcontacts.ResourceRotation(),
+
+			"aws_ssmincidents_replication_set": ssmincidents.ResourceReplicationSet(),
+			"aws_ssmincidents_response_plan":   ssmincidents.ResourceResponsePlan(),
+
+			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                     ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":       ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_permissions_boundary_attachment":    ssoadmin.ResourcePermissionsBoundaryAttachment(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -1864,9 +2014,17 @@ func Provider() *schema.Provider {
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
+			"aws_timestreamquery_scheduled_query": timestreamquery.ResourceScheduledQuery(),
+
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
+			"aws_transcribe_call_analytics_category": transcribe.ResourceCallAnalyticsCategory(),
+			"aws_transcribe_language_model":          transcribe.ResourceLanguageModel(),
+			"aws_transcribe_medical_vocabulary":      transcribe.ResourceMedicalVocabulary(),
+			"aws_transcribe_vocabulary":              transcribe.ResourceVocabulary(),
+			"aws_transcribe_vocabulary_filter":       transcribe.ResourceVocabularyFilter(),
+
 			"aws_transfer_access":  transfer.ResourceAccess(),
 			"aws_transfer_server":  transfer.ResourceServer(),
 			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
@@ -1909,6 +2067,15 @@ func Provider() *schema.Provider {
 			"aws_worklink_fleet": worklink.ResourceFleet(),
 			"aws_worklink_website_certificate_authority_association": worklink.ResourceWebsiteCertificateAuthorityAssociation(),
 
+			"aws_workmail_domain":                    workmail.ResourceDomain(),
+			"aws_workmail_group":                     workmail.ResourceGroup(),
+			"aws_workmail_group_member":              workmail.ResourceGroupMember(),
+			"aws_workmail_mailbox_permission":        workmail.ResourceMailboxPermission(),
+			"aws_workmail_mobile_device_access_rule": workmail.ResourceMobileDeviceAccessRule(),
+			"aws_workmail_organization":              workmail.ResourceOrganization(),
+			"aws_workmail_retention_policy":          workmail.ResourceRetentionPolicy(),
+			"aws_workmail_user":                      workmail.ResourceUser(),
+
 			"aws_workspaces_directory": workspaces.ResourceDirectory(),
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
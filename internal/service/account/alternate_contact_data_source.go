@@ -0,0 +1,69 @@
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAlternateContact() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAlternateContactRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"alternate_contact_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(account.AlternateContactType_Values(), false),
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlternateContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID := d.Get("account_id").(string)
+	contactType := d.Get("alternate_contact_type").(string)
+	id := AlternateContactCreateResourceID(accountID, contactType)
+
+	output, err := FindAlternateContactByAccountIDAndContactType(ctx, conn, accountID, contactType)
+
+	if err != nil {
+		return diag.Errorf("error reading Account Alternate Contact (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("email_address", output.EmailAddress)
+	d.Set("name", output.Name)
+	d.Set("phone_number", output.PhoneNumber)
+	d.Set("title", output.Title)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccountAlternateContactDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_account_alternate_contact.test"
+	resourceName := "aws_account_alternate_contact.test"
+	domain := acctest.RandomDomainName()
+	emailAddress := acctest.RandomEmailAddress(domain)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccountAlternateContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(testAccountAlternateContactConfig(rName, emailAddress), `
+data "aws_account_alternate_contact" "test" {
+  alternate_contact_type = aws_account_alternate_contact.test.alternate_contact_type
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "email_address", resourceName, "email_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "phone_number", resourceName, "phone_number"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "title", resourceName, "title"),
+				),
+			},
+		},
+	})
+}
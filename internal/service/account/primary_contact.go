@@ -0,0 +1,234 @@
+package account
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePrimaryContact() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrimaryContactPut,
+		ReadContext:   resourcePrimaryContactRead,
+		UpdateContext: resourcePrimaryContactPut,
+		DeleteContext: resourcePrimaryContactDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"address_line_1": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"address_line_2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"address_line_3": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"city": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"company_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+			},
+			"district_or_county": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"full_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"postal_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"state_or_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"website_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourcePrimaryContactPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	input := &account.PutContactInformationInput{
+		ContactInformation: &account.ContactInformation{
+			AddressLine1: aws.String(d.Get("address_line_1").(string)),
+			City:         aws.String(d.Get("city").(string)),
+			CountryCode:  aws.String(d.Get("country_code").(string)),
+			FullName:     aws.String(d.Get("full_name").(string)),
+			PhoneNumber:  aws.String(d.Get("phone_number").(string)),
+			PostalCode:   aws.String(d.Get("postal_code").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("address_line_2"); ok {
+		input.ContactInformation.AddressLine2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("address_line_3"); ok {
+		input.ContactInformation.AddressLine3 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("company_name"); ok {
+		input.ContactInformation.CompanyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("district_or_county"); ok {
+		input.ContactInformation.DistrictOrCounty = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("state_or_region"); ok {
+		input.ContactInformation.StateOrRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("website_url"); ok {
+		input.ContactInformation.WebsiteUrl = aws.String(v.(string))
+	}
+
+	// The resource ID is the targeted account's ID, or the caller's account ID if none is configured.
+	id := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		input.AccountId = aws.String(v.(string))
+		id = v.(string)
+	}
+
+	log.Printf("[DEBUG] Putting Account Primary Contact: %s", id)
+	_, err := conn.PutContactInformationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting Account Primary Contact (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourcePrimaryContactRead(ctx, d, meta)
+}
+
+func resourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID := primaryContactAccountID(d.Id(), meta.(*conns.AWSClient).AccountID)
+
+	output, err := FindContactInformation(ctx, conn, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Primary Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Account Primary Contact (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("address_line_1", output.AddressLine1)
+	d.Set("address_line_2", output.AddressLine2)
+	d.Set("address_line_3", output.AddressLine3)
+	d.Set("city", output.City)
+	d.Set("company_name", output.CompanyName)
+	d.Set("country_code", output.CountryCode)
+	d.Set("district_or_county", output.DistrictOrCounty)
+	d.Set("full_name", output.FullName)
+	d.Set("phone_number", output.PhoneNumber)
+	d.Set("postal_code", output.PostalCode)
+	d.Set("state_or_region", output.StateOrRegion)
+	d.Set("website_url", output.WebsiteUrl)
+
+	return nil
+}
+
+func resourcePrimaryContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Account Primary Contact (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+// primaryContactAccountID returns the account ID to target for a primary contact resource ID.
+// The caller's own account is targeted by omitting the account ID.
+func primaryContactAccountID(id, callerAccountID string) string {
+	if id == callerAccountID {
+		return ""
+	}
+
+	return id
+}
+
+func FindContactInformation(ctx context.Context, conn *account.Account, accountID string) (*account.ContactInformation, error) {
+	input := &account.GetContactInformationInput{}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetContactInformationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, account.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactInformation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactInformation, nil
+}
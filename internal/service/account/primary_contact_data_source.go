@@ -0,0 +1,104 @@
+package account
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrimaryContact() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePrimaryContactRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"address_line_1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address_line_2": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address_line_3": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"city": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"district_or_county": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"postal_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_or_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID := d.Get("account_id").(string)
+
+	output, err := FindContactInformation(ctx, conn, accountID)
+
+	if err != nil {
+		return diag.Errorf("error reading Account Primary Contact (%s): %s", accountID, err)
+	}
+
+	if accountID == "" {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	} else {
+		d.SetId(accountID)
+	}
+	d.Set("address_line_1", output.AddressLine1)
+	d.Set("address_line_2", output.AddressLine2)
+	d.Set("address_line_3", output.AddressLine3)
+	d.Set("city", output.City)
+	d.Set("company_name", output.CompanyName)
+	d.Set("country_code", output.CountryCode)
+	d.Set("district_or_county", output.DistrictOrCounty)
+	d.Set("full_name", output.FullName)
+	d.Set("phone_number", output.PhoneNumber)
+	d.Set("postal_code", output.PostalCode)
+	d.Set("state_or_region", output.StateOrRegion)
+	d.Set("website_url", output.WebsiteUrl)
+
+	return nil
+}
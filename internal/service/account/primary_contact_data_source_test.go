@@ -0,0 +1,36 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccountPrimaryContactDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_account_primary_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountPrimaryContactDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "address_line_1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "city"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "country_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "full_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "phone_number"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "postal_code"),
+				),
+			},
+		},
+	})
+}
+
+const testAccountPrimaryContactDataSourceConfig = `
+data "aws_account_primary_contact" "test" {}
+`
@@ -0,0 +1,147 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+)
+
+func TestAccAccountPrimaryContact_basic(t *testing.T) {
+	resourceName := "aws_account_primary_contact.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountPrimaryContactConfig(rName1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPrimaryContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "address_line_1", "123 Any Street"),
+					resource.TestCheckResourceAttr(resourceName, "city", "Seattle"),
+					resource.TestCheckResourceAttr(resourceName, "company_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "country_code", "US"),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "phone_number", "+17031235555"),
+					resource.TestCheckResourceAttr(resourceName, "postal_code", "98101"),
+					resource.TestCheckResourceAttr(resourceName, "state_or_region", "WA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccountPrimaryContactConfig(rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPrimaryContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "company_name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccountPrimaryContact_accountID(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_account_primary_contact.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountPrimaryContactOrganizationConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountPrimaryContactExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.aws_caller_identity.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "full_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccountPrimaryContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Account Primary Contact ID is set")
+		}
+
+		ctx := context.TODO()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+
+		_, err := tfaccount.FindContactInformation(ctx, conn, rs.Primary.Attributes["account_id"])
+
+		return err
+	}
+}
+
+func testAccountPrimaryContactConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_account_primary_contact" "test" {
+  address_line_1  = "123 Any Street"
+  city            = "Seattle"
+  company_name    = %[1]q
+  country_code    = "US"
+  full_name       = %[1]q
+  phone_number    = "+17031235555"
+  postal_code     = "98101"
+  state_or_region = "WA"
+}
+`, rName)
+}
+
+func testAccountPrimaryContactOrganizationConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_account_primary_contact" "test" {
+  account_id = data.aws_caller_identity.test.account_id
+
+  address_line_1  = "123 Any Street"
+  city            = "Seattle"
+  country_code    = "US"
+  full_name       = %[1]q
+  phone_number    = "+17031235555"
+  postal_code     = "98101"
+  state_or_region = "WA"
+}
+`, rName))
+}
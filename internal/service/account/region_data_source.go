@@ -0,0 +1,62 @@
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceRegion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRegionRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"opt_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+		},
+	}
+}
+
+func dataSourceRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID := d.Get("account_id").(string)
+	regionName := d.Get("region_name").(string)
+	id := RegionCreateResourceID(accountID, regionName)
+
+	output, err := FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+	if err != nil {
+		return diag.Errorf("error reading Account Region (%s): %s", id, err)
+	}
+
+	status := aws.StringValue(output.RegionOptStatus)
+
+	d.SetId(id)
+	d.Set("enabled", status == account.RegionOptStatusEnabled || status == account.RegionOptStatusEnabledByDefault)
+	d.Set("opt_status", status)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccountRegionDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_account_region.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountRegionDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "opt_status", account.RegionOptStatusEnabledByDefault),
+				),
+			},
+		},
+	})
+}
+
+const testAccountRegionDataSourceConfig = `
+data "aws_region" "current" {}
+
+data "aws_account_region" "test" {
+  region_name = data.aws_region.current.name
+}
+`
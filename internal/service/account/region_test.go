@@ -0,0 +1,68 @@
+package account_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccountRegion_basic(t *testing.T) {
+	resourceName := "aws_account_region.test"
+	regionName := "ap-southeast-3"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountRegionConfig(regionName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "region_name", regionName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAccountRegion_enabledByDefault(t *testing.T) {
+	resourceName := "aws_account_region.test"
+	regionName := "us-east-1" //lintignore:AWSAT003
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountRegionConfig(regionName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusEnabledByDefault),
+				),
+			},
+		},
+	})
+}
+
+func testAccountRegionConfig(regionName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_account_region" "test" {
+  region_name = %[1]q
+  enabled     = %[2]t
+}
+`, regionName, enabled)
+}
@@ -0,0 +1,21 @@
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func statusRegionOptStatus(ctx context.Context, conn *account.Account, accountID, regionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RegionOptStatus), nil
+	}
+}
@@ -0,0 +1,49 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	regionOptStatusPollInterval = 30 * time.Second
+)
+
+func waitRegionEnabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{account.RegionOptStatusDisabled, account.RegionOptStatusEnabling},
+		Target:       []string{account.RegionOptStatusEnabled},
+		Refresh:      statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:      timeout,
+		PollInterval: regionOptStatusPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRegionDisabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{account.RegionOptStatusDisabling, account.RegionOptStatusEnabled},
+		Target:       []string{account.RegionOptStatusDisabled},
+		Refresh:      statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:      timeout,
+		PollInterval: regionOptStatusPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,881 @@
+package appflow
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnectorProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConnectorProfileCreate,
+		ReadWithoutTimeout:   resourceConnectorProfileRead,
+		UpdateWithoutTimeout: resourceConnectorProfileUpdate,
+		DeleteWithoutTimeout: resourceConnectorProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(appflow.ConnectionMode_Values(), false),
+			},
+			"connector_label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][\w!@#.-]+$`), "must begin with a letter or number and contain only alphanumeric characters and !@#.-_"),
+				),
+			},
+			"connector_profile_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_profile_credentials": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_connector": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"api_key": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"api_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																Sensitive:    true,
+																ValidateFunc: validation.StringLenBetween(1, 256),
+															},
+															"api_secret_key": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Sensitive:    true,
+																ValidateFunc: validation.StringLenBetween(1, 256),
+															},
+														},
+													},
+												},
+												"authentication_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(appflow.AuthenticationType_Values(), false),
+												},
+												"basic": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"password": {
+																Type:         schema.TypeString,
+																Required:     true,
+																Sensitive:    true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+															"username": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+														},
+													},
+												},
+												"custom": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"credentials_map": {
+																Type:      schema.TypeMap,
+																Optional:  true,
+																Sensitive: true,
+																Elem:      &schema.Schema{Type: schema.TypeString},
+															},
+															"custom_authentication_type": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"oauth2": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"access_token": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Sensitive:    true,
+																ValidateFunc: validation.StringLenBetween(1, 4096),
+															},
+															"client_id": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+															"client_secret": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Sensitive:    true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+															"oauth_request": connectorOAuthRequestSchema(),
+															"refresh_token": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Sensitive:    true,
+																ValidateFunc: validation.StringLenBetween(1, 4096),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"redshift": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"password": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"username": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+												"client_credentials_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"jwt_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 8000),
+												},
+												"oauth2_grant_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(appflow.OAuth2GrantType_Values(), false),
+												},
+												"oauth_request": connectorOAuthRequestSchema(),
+												"refresh_token": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+											},
+										},
+									},
+									"snowflake": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"password": {
+													Type:         schema.TypeString,
+													Required:     true,
+													Sensitive:    true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"username": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"connector_profile_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_connector": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"oauth2_properties": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"oauth2_grant_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(appflow.OAuth2GrantType_Values(), false),
+															},
+															"token_url": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.IsURLWithHTTPS,
+															},
+															"token_url_custom_properties": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"profile_properties": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"redshift": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"cluster_identifier": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"data_api_role_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"database_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"database_url": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_url": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"is_sandbox_environment": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"use_privatelink_for_metadata_and_authorization": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"snowflake": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"private_link_service_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"region": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"stage": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"warehouse": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"connector_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(appflow.ConnectorType_Values(), false),
+			},
+			"credentials_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[\w/!@#+=.-]+$`), "must contain only alphanumeric characters and /!@#+=.-_"),
+				),
+			},
+		},
+	}
+}
+
+func connectorOAuthRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auth_code": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"redirect_uri": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceConnectorProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	name := d.Get("name").(string)
+	input := &appflow.CreateConnectorProfileInput{
+		ConnectionMode:         aws.String(d.Get("connection_mode").(string)),
+		ConnectorProfileConfig: expandConnectorProfileConfig(d.Get("connector_profile_config").([]interface{})),
+		ConnectorProfileName:   aws.String(name),
+		ConnectorType:          aws.String(d.Get("connector_type").(string)),
+	}
+
+	if v, ok := d.GetOk("connector_label"); ok {
+		input.ConnectorLabel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_arn"); ok {
+		input.KmsArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating AppFlow Connector Profile: %s", name)
+	_, err := conn.CreateConnectorProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating AppFlow Connector Profile (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceConnectorProfileRead(ctx, d, meta)
+}
+
+func resourceConnectorProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	connectorProfile, err := FindConnectorProfileByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFlow Connector Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading AppFlow Connector Profile (%s): %s", d.Id(), err)
+	}
+
+	// Credentials are write-only and are never returned by the API.
+	tfMap := map[string]interface{}{
+		"connector_profile_credentials": d.Get("connector_profile_config.0.connector_profile_credentials"),
+		"connector_profile_properties":  flattenConnectorProfileProperties(connectorProfile.ConnectorProfileProperties),
+	}
+
+	d.Set("arn", connectorProfile.ConnectorProfileArn)
+	d.Set("connection_mode", connectorProfile.ConnectionMode)
+	d.Set("connector_label", connectorProfile.ConnectorLabel)
+	if err := d.Set("connector_profile_config", []interface{}{tfMap}); err != nil {
+		return diag.Errorf("error setting connector_profile_config: %s", err)
+	}
+	d.Set("connector_type", connectorProfile.ConnectorType)
+	d.Set("credentials_arn", connectorProfile.CredentialsArn)
+	d.Set("name", connectorProfile.ConnectorProfileName)
+
+	return nil
+}
+
+func resourceConnectorProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	input := &appflow.UpdateConnectorProfileInput{
+		ConnectionMode:         aws.String(d.Get("connection_mode").(string)),
+		ConnectorProfileConfig: expandConnectorProfileConfig(d.Get("connector_profile_config").([]interface{})),
+		ConnectorProfileName:   aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating AppFlow Connector Profile: %s", d.Id())
+	_, err := conn.UpdateConnectorProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating AppFlow Connector Profile (%s): %s", d.Id(), err)
+	}
+
+	return resourceConnectorProfileRead(ctx, d, meta)
+}
+
+func resourceConnectorProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	log.Printf("[DEBUG] Deleting AppFlow Connector Profile: %s", d.Id())
+	_, err := conn.DeleteConnectorProfileWithContext(ctx, &appflow.DeleteConnectorProfileInput{
+		ConnectorProfileName: aws.String(d.Id()),
+		ForceDelete:          aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting AppFlow Connector Profile (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConnectorProfileConfig(tfList []interface{}) *appflow.ConnectorProfileConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.ConnectorProfileConfig{}
+
+	if v, ok := tfMap["connector_profile_credentials"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ConnectorProfileCredentials = expandConnectorProfileCredentials(v)
+	}
+
+	if v, ok := tfMap["connector_profile_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ConnectorProfileProperties = expandConnectorProfileProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandConnectorProfileCredentials(tfList []interface{}) *appflow.ConnectorProfileCredentials {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.ConnectorProfileCredentials{}
+
+	if v, ok := tfMap["custom_connector"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomConnector = expandCustomConnectorProfileCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Redshift = &appflow.RedshiftConnectorProfileCredentials{}
+
+		if v, ok := tfMap["password"].(string); ok && v != "" {
+			apiObject.Redshift.Password = aws.String(v)
+		}
+
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			apiObject.Redshift.Username = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Salesforce = expandSalesforceConnectorProfileCredentials(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["snowflake"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Snowflake = &appflow.SnowflakeConnectorProfileCredentials{
+			Password: aws.String(tfMap["password"].(string)),
+			Username: aws.String(tfMap["username"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCustomConnectorProfileCredentials(tfMap map[string]interface{}) *appflow.CustomConnectorProfileCredentials {
+	apiObject := &appflow.CustomConnectorProfileCredentials{
+		AuthenticationType: aws.String(tfMap["authentication_type"].(string)),
+	}
+
+	if v, ok := tfMap["api_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ApiKey = &appflow.ApiKeyCredentials{
+			ApiKey: aws.String(tfMap["api_key"].(string)),
+		}
+
+		if v, ok := tfMap["api_secret_key"].(string); ok && v != "" {
+			apiObject.ApiKey.ApiSecretKey = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["basic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Basic = &appflow.BasicAuthCredentials{
+			Password: aws.String(tfMap["password"].(string)),
+			Username: aws.String(tfMap["username"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["custom"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Custom = &appflow.CustomAuthCredentials{
+			CustomAuthenticationType: aws.String(tfMap["custom_authentication_type"].(string)),
+		}
+
+		if v, ok := tfMap["credentials_map"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Custom.CredentialsMap = flex.ExpandStringMap(v)
+		}
+	}
+
+	if v, ok := tfMap["oauth2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Oauth2 = &appflow.OAuth2Credentials{}
+
+		if v, ok := tfMap["access_token"].(string); ok && v != "" {
+			apiObject.Oauth2.AccessToken = aws.String(v)
+		}
+
+		if v, ok := tfMap["client_id"].(string); ok && v != "" {
+			apiObject.Oauth2.ClientId = aws.String(v)
+		}
+
+		if v, ok := tfMap["client_secret"].(string); ok && v != "" {
+			apiObject.Oauth2.ClientSecret = aws.String(v)
+		}
+
+		if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Oauth2.OAuthRequest = expandConnectorOAuthRequest(v)
+		}
+
+		if v, ok := tfMap["refresh_token"].(string); ok && v != "" {
+			apiObject.Oauth2.RefreshToken = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandSalesforceConnectorProfileCredentials(tfMap map[string]interface{}) *appflow.SalesforceConnectorProfileCredentials {
+	apiObject := &appflow.SalesforceConnectorProfileCredentials{}
+
+	if v, ok := tfMap["access_token"].(string); ok && v != "" {
+		apiObject.AccessToken = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_credentials_arn"].(string); ok && v != "" {
+		apiObject.ClientCredentialsArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["jwt_token"].(string); ok && v != "" {
+		apiObject.JwtToken = aws.String(v)
+	}
+
+	if v, ok := tfMap["oauth2_grant_type"].(string); ok && v != "" {
+		apiObject.OAuth2GrantType = aws.String(v)
+	}
+
+	if v, ok := tfMap["oauth_request"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OAuthRequest = expandConnectorOAuthRequest(v)
+	}
+
+	if v, ok := tfMap["refresh_token"].(string); ok && v != "" {
+		apiObject.RefreshToken = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandConnectorOAuthRequest(tfList []interface{}) *appflow.ConnectorOAuthRequest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.ConnectorOAuthRequest{}
+
+	if v, ok := tfMap["auth_code"].(string); ok && v != "" {
+		apiObject.AuthCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["redirect_uri"].(string); ok && v != "" {
+		apiObject.RedirectUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandConnectorProfileProperties(tfList []interface{}) *appflow.ConnectorProfileProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &appflow.ConnectorProfileProperties{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.ConnectorProfileProperties{}
+
+	if v, ok := tfMap["custom_connector"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CustomConnector = &appflow.CustomConnectorProfileProperties{}
+
+		if v, ok := tfMap["oauth2_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.CustomConnector.OAuth2Properties = &appflow.OAuth2Properties{
+				OAuth2GrantType: aws.String(tfMap["oauth2_grant_type"].(string)),
+				TokenUrl:        aws.String(tfMap["token_url"].(string)),
+			}
+
+			if v, ok := tfMap["token_url_custom_properties"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.CustomConnector.OAuth2Properties.TokenUrlCustomProperties = flex.ExpandStringMap(v)
+			}
+		}
+
+		if v, ok := tfMap["profile_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.CustomConnector.ProfileProperties = flex.ExpandStringMap(v)
+		}
+	}
+
+	if v, ok := tfMap["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Redshift = &appflow.RedshiftConnectorProfileProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+			RoleArn:    aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Redshift.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["cluster_identifier"].(string); ok && v != "" {
+			apiObject.Redshift.ClusterIdentifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["data_api_role_arn"].(string); ok && v != "" {
+			apiObject.Redshift.DataApiRoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["database_name"].(string); ok && v != "" {
+			apiObject.Redshift.DatabaseName = aws.String(v)
+		}
+
+		if v, ok := tfMap["database_url"].(string); ok && v != "" {
+			apiObject.Redshift.DatabaseUrl = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceConnectorProfileProperties{}
+
+		if v, ok := tfMap["instance_url"].(string); ok && v != "" {
+			apiObject.Salesforce.InstanceUrl = aws.String(v)
+		}
+
+		if v, ok := tfMap["is_sandbox_environment"].(bool); ok {
+			apiObject.Salesforce.IsSandboxEnvironment = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["use_privatelink_for_metadata_and_authorization"].(bool); ok {
+			apiObject.Salesforce.UsePrivateLinkForMetadataAndAuthorization = aws.Bool(v)
+		}
+	}
+
+	if v, ok := tfMap["snowflake"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Snowflake = &appflow.SnowflakeConnectorProfileProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+			Stage:      aws.String(tfMap["stage"].(string)),
+			Warehouse:  aws.String(tfMap["warehouse"].(string)),
+		}
+
+		if v, ok := tfMap["account_name"].(string); ok && v != "" {
+			apiObject.Snowflake.AccountName = aws.String(v)
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Snowflake.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["private_link_service_name"].(string); ok && v != "" {
+			apiObject.Snowflake.PrivateLinkServiceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Snowflake.Region = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenConnectorProfileProperties(apiObject *appflow.ConnectorProfileProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomConnector; v != nil {
+		m := map[string]interface{}{
+			"profile_properties": aws.StringValueMap(v.ProfileProperties),
+		}
+
+		if v := v.OAuth2Properties; v != nil {
+			m["oauth2_properties"] = []interface{}{map[string]interface{}{
+				"oauth2_grant_type":           aws.StringValue(v.OAuth2GrantType),
+				"token_url":                   aws.StringValue(v.TokenUrl),
+				"token_url_custom_properties": aws.StringValueMap(v.TokenUrlCustomProperties),
+			}}
+		}
+
+		tfMap["custom_connector"] = []interface{}{m}
+	}
+
+	if v := apiObject.Redshift; v != nil {
+		tfMap["redshift"] = []interface{}{map[string]interface{}{
+			"bucket_name":        aws.StringValue(v.BucketName),
+			"bucket_prefix":      aws.StringValue(v.BucketPrefix),
+			"cluster_identifier": aws.StringValue(v.ClusterIdentifier),
+			"data_api_role_arn":  aws.StringValue(v.DataApiRoleArn),
+			"database_name":      aws.StringValue(v.DatabaseName),
+			"database_url":       aws.StringValue(v.DatabaseUrl),
+			"role_arn":           aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"instance_url":           aws.StringValue(v.InstanceUrl),
+			"is_sandbox_environment": aws.BoolValue(v.IsSandboxEnvironment),
+			"use_privatelink_for_metadata_and_authorization": aws.BoolValue(v.UsePrivateLinkForMetadataAndAuthorization),
+		}}
+	}
+
+	if v := apiObject.Snowflake; v != nil {
+		tfMap["snowflake"] = []interface{}{map[string]interface{}{
+			"account_name":              aws.StringValue(v.AccountName),
+			"bucket_name":               aws.StringValue(v.BucketName),
+			"bucket_prefix":             aws.StringValue(v.BucketPrefix),
+			"private_link_service_name": aws.StringValue(v.PrivateLinkServiceName),
+			"region":                    aws.StringValue(v.Region),
+			"stage":                     aws.StringValue(v.Stage),
+			"warehouse":                 aws.StringValue(v.Warehouse),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
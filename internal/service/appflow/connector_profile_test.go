@@ -0,0 +1,218 @@
+package appflow_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appflow"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppFlowConnectorProfile_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_connector_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorProfileConfig_basic(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorProfileExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appflow", regexp.MustCompile(`connectorprofile/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "connection_mode", appflow.ConnectionModePublic),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_credentials.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_credentials.0.redshift.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_properties.0.redshift.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_profile_config.0.connector_profile_properties.0.redshift.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_properties.0.redshift.0.bucket_prefix", "test"),
+					resource.TestCheckResourceAttrPair(resourceName, "connector_profile_config.0.connector_profile_properties.0.redshift.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "connector_type", appflow.ConnectorTypeRedshift),
+					resource.TestCheckResourceAttrSet(resourceName, "credentials_arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connector_profile_config.0.connector_profile_credentials"},
+			},
+		},
+	})
+}
+
+func TestAccAppFlowConnectorProfile_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_connector_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorProfileConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorProfileExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappflow.ResourceConnectorProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowConnectorProfile_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_connector_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorProfileConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_properties.0.redshift.0.bucket_prefix", "test"),
+				),
+			},
+			{
+				Config: testAccConnectorProfileConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_properties.0.redshift.0.bucket_prefix", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appflow_connector_profile" {
+			continue
+		}
+
+		_, err := tfappflow.FindConnectorProfileByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppFlow Connector Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConnectorProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppFlow Connector Profile ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+		_, err := tfappflow.FindConnectorProfileByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccConnectorProfileConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "appflow.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+
+  managed_policy_arns = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonRedshiftAllCommandsFullAccess"]
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "test"
+  master_username                     = "testuser"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "dc2.large"
+  automated_snapshot_retention_period = 0
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+  publicly_accessible                 = true
+}
+`, rName))
+}
+
+func testAccConnectorProfileConfig_basic(rName, bucketPrefix string) string {
+	return acctest.ConfigCompose(testAccConnectorProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_connector_profile" "test" {
+  name            = %[1]q
+  connector_type  = "Redshift"
+  connection_mode = "Public"
+
+  connector_profile_config {
+    connector_profile_credentials {
+      redshift {
+        password = aws_redshift_cluster.test.master_password
+        username = aws_redshift_cluster.test.master_username
+      }
+    }
+
+    connector_profile_properties {
+      redshift {
+        bucket_name   = aws_s3_bucket.test.bucket
+        bucket_prefix = %[2]q
+        database_url  = "jdbc:redshift://${aws_redshift_cluster.test.endpoint}/${aws_redshift_cluster.test.database_name}"
+        role_arn      = aws_iam_role.test.arn
+      }
+    }
+  }
+}
+`, rName, bucketPrefix))
+}
@@ -0,0 +1,85 @@
+package appflow
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindConnectorProfileByName(ctx context.Context, conn *appflow.Appflow, name string) (*appflow.ConnectorProfile, error) {
+	input := &appflow.DescribeConnectorProfilesInput{
+		ConnectorProfileNames: aws.StringSlice([]string{name}),
+	}
+	var output *appflow.ConnectorProfile
+
+	err := conn.DescribeConnectorProfilesPagesWithContext(ctx, input, func(page *appflow.DescribeConnectorProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConnectorProfileDetails {
+			if v != nil && aws.StringValue(v.ConnectorProfileName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindFlowByName(ctx context.Context, conn *appflow.Appflow, name string) (*appflow.DescribeFlowOutput, error) {
+	input := &appflow.DescribeFlowInput{
+		FlowName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.FlowStatus); status == appflow.FlowStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,1620 @@
+package appflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// connectorTypeKeys maps each supported connector type to the name of the
+// configuration block (and connector operator attribute) used for it.
+var connectorTypeKeys = map[string]string{
+	appflow.ConnectorTypeCustomConnector: "custom_connector",
+	appflow.ConnectorTypeRedshift:        "redshift",
+	appflow.ConnectorTypeS3:              "s3",
+	appflow.ConnectorTypeSalesforce:      "salesforce",
+	appflow.ConnectorTypeSnowflake:       "snowflake",
+}
+
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			checkFlowConfiguration,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"destination_flow_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appflow.ConnectorTypeCustomConnector,
+								appflow.ConnectorTypeRedshift,
+								appflow.ConnectorTypeS3,
+								appflow.ConnectorTypeSalesforce,
+								appflow.ConnectorTypeSnowflake,
+							}, false),
+						},
+						"destination_connector_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_connector": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"custom_properties": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"entity_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"error_handling_config": errorHandlingConfigSchema(),
+												"id_field_names": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"write_operation_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(appflow.WriteOperationType_Values(), false),
+												},
+											},
+										},
+									},
+									"redshift": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"error_handling_config": errorHandlingConfigSchema(),
+												"intermediate_bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"s3_output_format_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"aggregation_config": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"aggregation_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.AggregationType_Values(), false),
+																		},
+																		"target_file_size": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																			Computed: true,
+																		},
+																	},
+																},
+															},
+															"file_type": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(appflow.FileType_Values(), false),
+															},
+															"prefix_config": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Computed: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"prefix_format": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.PrefixFormat_Values(), false),
+																		},
+																		"prefix_type": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringInSlice(appflow.PrefixType_Values(), false),
+																		},
+																	},
+																},
+															},
+															"preserve_source_data_typing": {
+																Type:     schema.TypeBool,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"error_handling_config": errorHandlingConfigSchema(),
+												"id_field_names": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"write_operation_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(appflow.WriteOperationType_Values(), false),
+												},
+											},
+										},
+									},
+									"snowflake": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"error_handling_config": errorHandlingConfigSchema(),
+												"intermediate_bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"flow_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][\w!@#.-]+$`), "must begin with a letter or number and contain only alphanumeric characters and !@#.-_"),
+				),
+			},
+			"source_flow_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_profile_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"connector_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								appflow.ConnectorTypeCustomConnector,
+								appflow.ConnectorTypeS3,
+								appflow.ConnectorTypeSalesforce,
+							}, false),
+						},
+						"incremental_pull_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datetime_type_field_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+								},
+							},
+						},
+						"source_connector_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_connector": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"custom_properties": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"entity_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"bucket_prefix": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"s3_input_format_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"s3_input_file_type": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(appflow.S3InputFileType_Values(), false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"salesforce": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable_dynamic_field_update": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"include_deleted_records": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"object": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"task": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connector_operator": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_connector": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.Operator_Values(), false),
+									},
+									"s3": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.S3ConnectorOperator_Values(), false),
+									},
+									"salesforce": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(appflow.SalesforceConnectorOperator_Values(), false),
+									},
+								},
+							},
+						},
+						"destination_field": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+						},
+						"source_fields": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"task_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"task_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.TaskType_Values(), false),
+						},
+					},
+				},
+			},
+			"trigger_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scheduled": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"data_pull_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(appflow.DataPullMode_Values(), false),
+												},
+												"first_execution_from": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"schedule_end_time": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"schedule_expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"schedule_offset": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 36000),
+												},
+												"schedule_start_time": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"timezone": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringLenBetween(0, 256),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"trigger_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appflow.TriggerType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func errorHandlingConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"bucket_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"fail_on_first_destination_error": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appflow.CreateFlowInput{
+		DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
+		FlowName:                  aws.String(name),
+		SourceFlowConfig:          expandSourceFlowConfig(d.Get("source_flow_config").([]interface{})),
+		Tasks:                     expandTasks(d.Get("task").(*schema.Set).List()),
+		TriggerConfig:             expandTriggerConfig(d.Get("trigger_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_arn"); ok {
+		input.KmsArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AppFlow Flow: %s", input)
+	_, err := conn.CreateFlowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating AppFlow Flow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	flow, err := FindFlowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppFlow Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading AppFlow Flow (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(flow.FlowArn)
+	d.Set("arn", arn)
+	d.Set("description", flow.Description)
+	if err := d.Set("destination_flow_config", flattenDestinationFlowConfigs(flow.DestinationFlowConfigList)); err != nil {
+		return diag.Errorf("error setting destination_flow_config: %s", err)
+	}
+	d.Set("flow_status", flow.FlowStatus)
+	d.Set("kms_arn", flow.KmsArn)
+	d.Set("name", flow.FlowName)
+	if err := d.Set("source_flow_config", flattenSourceFlowConfig(flow.SourceFlowConfig)); err != nil {
+		return diag.Errorf("error setting source_flow_config: %s", err)
+	}
+	if err := d.Set("task", flattenTasks(flow.Tasks)); err != nil {
+		return diag.Errorf("error setting task: %s", err)
+	}
+	if err := d.Set("trigger_config", flattenTriggerConfig(flow.TriggerConfig)); err != nil {
+		return diag.Errorf("error setting trigger_config: %s", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for AppFlow Flow (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appflow.UpdateFlowInput{
+			Description:               aws.String(d.Get("description").(string)),
+			DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
+			FlowName:                  aws.String(d.Id()),
+			SourceFlowConfig:          expandSourceFlowConfig(d.Get("source_flow_config").([]interface{})),
+			Tasks:                     expandTasks(d.Get("task").(*schema.Set).List()),
+			TriggerConfig:             expandTriggerConfig(d.Get("trigger_config").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating AppFlow Flow: %s", input)
+		_, err := conn.UpdateFlowWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating AppFlow Flow (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating AppFlow Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppFlowConn
+
+	log.Printf("[DEBUG] Deleting AppFlow Flow: %s", d.Id())
+	_, err := conn.DeleteFlowWithContext(ctx, &appflow.DeleteFlowInput{
+		FlowName:    aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appflow.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting AppFlow Flow (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkFlowConfiguration validates the flow configuration in the plan.
+// The configuration is read from the raw config so that values which are not
+// yet known, e.g. references to other resources, are not mistaken for unset.
+func checkFlowConfiguration(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	configList := func(name string) []interface{} {
+		v, _ := configValue(config.GetAttr(name)).([]interface{})
+
+		return v
+	}
+
+	return ValidateFlowConfiguration(
+		configList("source_flow_config"),
+		configList("destination_flow_config"),
+		configList("task"),
+		configList("trigger_config"),
+	)
+}
+
+// configValue converts a raw config value to the form returned by
+// schema.ResourceData.Get, except that values which are not yet known are nil.
+func configValue(v cty.Value) interface{} {
+	if !v.IsKnown() {
+		return nil
+	}
+
+	ty := v.Type()
+
+	switch {
+	case ty == cty.String:
+		if v.IsNull() {
+			return ""
+		}
+
+		return v.AsString()
+	case ty == cty.Bool:
+		return !v.IsNull() && v.True()
+	case ty == cty.Number:
+		if v.IsNull() {
+			return 0
+		}
+
+		i, _ := v.AsBigFloat().Int64()
+
+		return int(i)
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		tfList := []interface{}{}
+
+		if v.IsNull() {
+			return tfList
+		}
+
+		for it := v.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			tfList = append(tfList, configValue(v))
+		}
+
+		return tfList
+	case ty.IsObjectType() || ty.IsMapType():
+		if v.IsNull() {
+			return nil
+		}
+
+		tfMap := map[string]interface{}{}
+
+		for it := v.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			tfMap[k.AsString()] = configValue(v)
+		}
+
+		return tfMap
+	}
+
+	return nil
+}
+
+// ValidateFlowConfiguration validates that the connector properties blocks match
+// the source and destination connector types, that every task's connector
+// operator is the one for the source connector type, and that the trigger
+// configuration is valid for the trigger type and source connector type.
+// A nil value is not yet known and is not validated.
+func ValidateFlowConfiguration(source, destinations, tasks, trigger []interface{}) error {
+	var sourceType string
+	var sourceProperties []interface{}
+
+	if len(source) > 0 && source[0] != nil {
+		tfMap := source[0].(map[string]interface{})
+		sourceType, _ = tfMap["connector_type"].(string)
+		sourceProperties, _ = tfMap["source_connector_properties"].([]interface{})
+	}
+
+	if err := checkConnectorProperties("source_flow_config.0.source_connector_properties.0", sourceType, sourceProperties); err != nil {
+		return err
+	}
+
+	for i, tfMapRaw := range destinations {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		prefix := fmt.Sprintf("destination_flow_config.%d.destination_connector_properties.0", i)
+		destinationType, _ := tfMap["connector_type"].(string)
+		tfList, _ := tfMap["destination_connector_properties"].([]interface{})
+
+		if err := checkConnectorProperties(prefix, destinationType, tfList); err != nil {
+			return err
+		}
+	}
+
+	if err := checkTriggerConfig(sourceType, trigger); err != nil {
+		return err
+	}
+
+	sourceKey, ok := connectorTypeKeys[sourceType]
+
+	if !ok {
+		return nil
+	}
+
+	for _, tfMapRaw := range tasks {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tfList, ok := tfMap["connector_operator"].([]interface{})
+
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			continue
+		}
+
+		operators := tfList[0].(map[string]interface{})
+
+		for _, key := range []string{"custom_connector", "s3", "salesforce"} {
+			if v, ok := operators[key].(string); ok && v != "" && key != sourceKey {
+				return fmt.Errorf("task connector_operator %s (%s) is not valid for source connector_type %s, use connector_operator.0.%s", key, v, sourceType, sourceKey)
+			}
+		}
+
+		if v, ok := operators[sourceKey]; ok && v == nil {
+			continue
+		}
+
+		if v, ok := operators[sourceKey].(string); !ok || v == "" {
+			return fmt.Errorf("task connector_operator.0.%s must be set when source connector_type is %s", sourceKey, sourceType)
+		}
+	}
+
+	return nil
+}
+
+// checkTriggerConfig validates that scheduled trigger properties are set only
+// for Scheduled flows and that Event flows have a source that can emit events.
+func checkTriggerConfig(sourceType string, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	triggerType, ok := tfMap["trigger_type"].(string)
+
+	if !ok {
+		return nil
+	}
+
+	if triggerType == appflow.TriggerTypeEvent && sourceType == appflow.ConnectorTypeS3 {
+		return fmt.Errorf("trigger_type %s is not valid for source connector_type %s", triggerType, sourceType)
+	}
+
+	hasScheduled := false
+
+	if v, ok := tfMap["trigger_properties"]; ok && v == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["trigger_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["scheduled"]; ok && v == nil {
+			return nil
+		}
+
+		if v, ok := tfMap["scheduled"].([]interface{}); ok && len(v) > 0 {
+			hasScheduled = true
+		}
+	}
+
+	switch triggerType {
+	case appflow.TriggerTypeScheduled:
+		if !hasScheduled {
+			return fmt.Errorf("trigger_config.0.trigger_properties.0.scheduled must be set when trigger_type is %s", triggerType)
+		}
+	default:
+		if hasScheduled {
+			return fmt.Errorf("trigger_config.0.trigger_properties.0.scheduled must not be set when trigger_type is %s", triggerType)
+		}
+	}
+
+	return nil
+}
+
+func checkConnectorProperties(prefix, connectorType string, tfList []interface{}) error {
+	key, ok := connectorTypeKeys[connectorType]
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for _, v := range []string{"custom_connector", "redshift", "s3", "salesforce", "snowflake"} {
+		if v == key {
+			continue
+		}
+
+		if tfList, ok := tfMap[v].([]interface{}); ok && len(tfList) > 0 {
+			return fmt.Errorf("%s.%s must not be set when connector_type is %s", prefix, v, connectorType)
+		}
+	}
+
+	if v, ok := tfMap[key]; ok && v == nil {
+		return nil
+	}
+
+	if tfList, ok := tfMap[key].([]interface{}); !ok || len(tfList) == 0 {
+		return fmt.Errorf("%s.%s must be set when connector_type is %s", prefix, key, connectorType)
+	}
+
+	return nil
+}
+
+func expandSourceFlowConfig(tfList []interface{}) *appflow.SourceFlowConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.SourceFlowConfig{
+		ConnectorType: aws.String(tfMap["connector_type"].(string)),
+	}
+
+	if v, ok := tfMap["api_version"].(string); ok && v != "" {
+		apiObject.ApiVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+		apiObject.ConnectorProfileName = aws.String(v)
+	}
+
+	if v, ok := tfMap["incremental_pull_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.IncrementalPullConfig = &appflow.IncrementalPullConfig{}
+
+		if v, ok := tfMap["datetime_type_field_name"].(string); ok && v != "" {
+			apiObject.IncrementalPullConfig.DatetimeTypeFieldName = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["source_connector_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SourceConnectorProperties = expandSourceConnectorProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceConnectorProperties(tfList []interface{}) *appflow.SourceConnectorProperties {
+	apiObject := &appflow.SourceConnectorProperties{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["custom_connector"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CustomConnector = &appflow.CustomConnectorSourceProperties{
+			EntityName: aws.String(tfMap["entity_name"].(string)),
+		}
+
+		if v, ok := tfMap["custom_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.CustomConnector.CustomProperties = flex.ExpandStringMap(v)
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &appflow.S3SourceProperties{
+			BucketName:   aws.String(tfMap["bucket_name"].(string)),
+			BucketPrefix: aws.String(tfMap["bucket_prefix"].(string)),
+		}
+
+		if v, ok := tfMap["s3_input_format_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.S3.S3InputFormatConfig = &appflow.S3InputFormatConfig{}
+
+			if v, ok := tfMap["s3_input_file_type"].(string); ok && v != "" {
+				apiObject.S3.S3InputFormatConfig.S3InputFileType = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceSourceProperties{
+			Object: aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["enable_dynamic_field_update"].(bool); ok {
+			apiObject.Salesforce.EnableDynamicFieldUpdate = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["include_deleted_records"].(bool); ok {
+			apiObject.Salesforce.IncludeDeletedRecords = aws.Bool(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandDestinationFlowConfigs(tfList []interface{}) []*appflow.DestinationFlowConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appflow.DestinationFlowConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appflow.DestinationFlowConfig{
+			ConnectorType: aws.String(tfMap["connector_type"].(string)),
+		}
+
+		if v, ok := tfMap["api_version"].(string); ok && v != "" {
+			apiObject.ApiVersion = aws.String(v)
+		}
+
+		if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+			apiObject.ConnectorProfileName = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination_connector_properties"].([]interface{}); ok {
+			apiObject.DestinationConnectorProperties = expandDestinationConnectorProperties(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDestinationConnectorProperties(tfList []interface{}) *appflow.DestinationConnectorProperties {
+	apiObject := &appflow.DestinationConnectorProperties{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["custom_connector"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CustomConnector = &appflow.CustomConnectorDestinationProperties{
+			EntityName: aws.String(tfMap["entity_name"].(string)),
+		}
+
+		if v, ok := tfMap["custom_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.CustomConnector.CustomProperties = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CustomConnector.ErrorHandlingConfig = expandErrorHandlingConfig(v)
+		}
+
+		if v, ok := tfMap["id_field_names"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CustomConnector.IdFieldNames = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["write_operation_type"].(string); ok && v != "" {
+			apiObject.CustomConnector.WriteOperationType = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["redshift"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Redshift = &appflow.RedshiftDestinationProperties{
+			IntermediateBucketName: aws.String(tfMap["intermediate_bucket_name"].(string)),
+			Object:                 aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Redshift.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Redshift.ErrorHandlingConfig = expandErrorHandlingConfig(v)
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &appflow.S3DestinationProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.S3.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["s3_output_format_config"].([]interface{}); ok && len(v) > 0 {
+			apiObject.S3.S3OutputFormatConfig = expandS3OutputFormatConfig(v)
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &appflow.SalesforceDestinationProperties{
+			Object: aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Salesforce.ErrorHandlingConfig = expandErrorHandlingConfig(v)
+		}
+
+		if v, ok := tfMap["id_field_names"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Salesforce.IdFieldNames = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["write_operation_type"].(string); ok && v != "" {
+			apiObject.Salesforce.WriteOperationType = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["snowflake"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Snowflake = &appflow.SnowflakeDestinationProperties{
+			IntermediateBucketName: aws.String(tfMap["intermediate_bucket_name"].(string)),
+			Object:                 aws.String(tfMap["object"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.Snowflake.BucketPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["error_handling_config"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Snowflake.ErrorHandlingConfig = expandErrorHandlingConfig(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandErrorHandlingConfig(tfList []interface{}) *appflow.ErrorHandlingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.ErrorHandlingConfig{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+		apiObject.BucketPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["fail_on_first_destination_error"].(bool); ok {
+		apiObject.FailOnFirstDestinationError = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandS3OutputFormatConfig(tfList []interface{}) *appflow.S3OutputFormatConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.S3OutputFormatConfig{}
+
+	if v, ok := tfMap["aggregation_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AggregationConfig = &appflow.AggregationConfig{}
+
+		if v, ok := tfMap["aggregation_type"].(string); ok && v != "" {
+			apiObject.AggregationConfig.AggregationType = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_file_size"].(int); ok && v != 0 {
+			apiObject.AggregationConfig.TargetFileSize = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["file_type"].(string); ok && v != "" {
+		apiObject.FileType = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.PrefixConfig = &appflow.PrefixConfig{}
+
+		if v, ok := tfMap["prefix_format"].(string); ok && v != "" {
+			apiObject.PrefixConfig.PrefixFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix_type"].(string); ok && v != "" {
+			apiObject.PrefixConfig.PrefixType = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["preserve_source_data_typing"].(bool); ok {
+		apiObject.PreserveSourceDataTyping = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTasks(tfList []interface{}) []*appflow.Task {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*appflow.Task
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &appflow.Task{
+			SourceFields: flex.ExpandStringList(tfMap["source_fields"].([]interface{})),
+			TaskType:     aws.String(tfMap["task_type"].(string)),
+		}
+
+		if v, ok := tfMap["connector_operator"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.ConnectorOperator = &appflow.ConnectorOperator{}
+
+			if v, ok := tfMap["custom_connector"].(string); ok && v != "" {
+				apiObject.ConnectorOperator.CustomConnector = aws.String(v)
+			}
+
+			if v, ok := tfMap["s3"].(string); ok && v != "" {
+				apiObject.ConnectorOperator.S3 = aws.String(v)
+			}
+
+			if v, ok := tfMap["salesforce"].(string); ok && v != "" {
+				apiObject.ConnectorOperator.Salesforce = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["destination_field"].(string); ok && v != "" {
+			apiObject.DestinationField = aws.String(v)
+		}
+
+		if v, ok := tfMap["task_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.TaskProperties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTriggerConfig(tfList []interface{}) *appflow.TriggerConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appflow.TriggerConfig{
+		TriggerType: aws.String(tfMap["trigger_type"].(string)),
+	}
+
+	if v, ok := tfMap["trigger_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TriggerProperties = &appflow.TriggerProperties{}
+
+		if v, ok := tfMap["scheduled"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TriggerProperties.Scheduled = expandScheduledTriggerProperties(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduledTriggerProperties(tfMap map[string]interface{}) *appflow.ScheduledTriggerProperties {
+	apiObject := &appflow.ScheduledTriggerProperties{
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+	}
+
+	if v, ok := tfMap["data_pull_mode"].(string); ok && v != "" {
+		apiObject.DataPullMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.FirstExecutionFrom = aws.Time(v)
+	}
+
+	if v, ok := tfMap["schedule_end_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ScheduleEndTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["schedule_offset"].(int); ok && v != 0 {
+		apiObject.ScheduleOffset = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["schedule_start_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ScheduleStartTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceFlowConfig(apiObject *appflow.SourceFlowConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"api_version":                 aws.StringValue(apiObject.ApiVersion),
+		"connector_profile_name":      aws.StringValue(apiObject.ConnectorProfileName),
+		"connector_type":              aws.StringValue(apiObject.ConnectorType),
+		"source_connector_properties": flattenSourceConnectorProperties(apiObject.SourceConnectorProperties),
+	}
+
+	if v := apiObject.IncrementalPullConfig; v != nil {
+		tfMap["incremental_pull_config"] = []interface{}{map[string]interface{}{
+			"datetime_type_field_name": aws.StringValue(v.DatetimeTypeFieldName),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSourceConnectorProperties(apiObject *appflow.SourceConnectorProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomConnector; v != nil {
+		tfMap["custom_connector"] = []interface{}{map[string]interface{}{
+			"custom_properties": aws.StringValueMap(v.CustomProperties),
+			"entity_name":       aws.StringValue(v.EntityName),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil {
+		m := map[string]interface{}{
+			"bucket_name":   aws.StringValue(v.BucketName),
+			"bucket_prefix": aws.StringValue(v.BucketPrefix),
+		}
+
+		if v := v.S3InputFormatConfig; v != nil {
+			m["s3_input_format_config"] = []interface{}{map[string]interface{}{
+				"s3_input_file_type": aws.StringValue(v.S3InputFileType),
+			}}
+		}
+
+		tfMap["s3"] = []interface{}{m}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"enable_dynamic_field_update": aws.BoolValue(v.EnableDynamicFieldUpdate),
+			"include_deleted_records":     aws.BoolValue(v.IncludeDeletedRecords),
+			"object":                      aws.StringValue(v.Object),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDestinationFlowConfigs(apiObjects []*appflow.DestinationFlowConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"api_version":                      aws.StringValue(apiObject.ApiVersion),
+			"connector_profile_name":           aws.StringValue(apiObject.ConnectorProfileName),
+			"connector_type":                   aws.StringValue(apiObject.ConnectorType),
+			"destination_connector_properties": flattenDestinationConnectorProperties(apiObject.DestinationConnectorProperties),
+		})
+	}
+
+	return tfList
+}
+
+func flattenDestinationConnectorProperties(apiObject *appflow.DestinationConnectorProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomConnector; v != nil {
+		tfMap["custom_connector"] = []interface{}{map[string]interface{}{
+			"custom_properties":     aws.StringValueMap(v.CustomProperties),
+			"entity_name":           aws.StringValue(v.EntityName),
+			"error_handling_config": flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"id_field_names":        aws.StringValueSlice(v.IdFieldNames),
+			"write_operation_type":  aws.StringValue(v.WriteOperationType),
+		}}
+	}
+
+	if v := apiObject.Redshift; v != nil {
+		tfMap["redshift"] = []interface{}{map[string]interface{}{
+			"bucket_prefix":            aws.StringValue(v.BucketPrefix),
+			"error_handling_config":    flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"intermediate_bucket_name": aws.StringValue(v.IntermediateBucketName),
+			"object":                   aws.StringValue(v.Object),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket_name":             aws.StringValue(v.BucketName),
+			"bucket_prefix":           aws.StringValue(v.BucketPrefix),
+			"s3_output_format_config": flattenS3OutputFormatConfig(v.S3OutputFormatConfig),
+		}}
+	}
+
+	if v := apiObject.Salesforce; v != nil {
+		tfMap["salesforce"] = []interface{}{map[string]interface{}{
+			"error_handling_config": flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"id_field_names":        aws.StringValueSlice(v.IdFieldNames),
+			"object":                aws.StringValue(v.Object),
+			"write_operation_type":  aws.StringValue(v.WriteOperationType),
+		}}
+	}
+
+	if v := apiObject.Snowflake; v != nil {
+		tfMap["snowflake"] = []interface{}{map[string]interface{}{
+			"bucket_prefix":            aws.StringValue(v.BucketPrefix),
+			"error_handling_config":    flattenErrorHandlingConfig(v.ErrorHandlingConfig),
+			"intermediate_bucket_name": aws.StringValue(v.IntermediateBucketName),
+			"object":                   aws.StringValue(v.Object),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenErrorHandlingConfig(apiObject *appflow.ErrorHandlingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name":                     aws.StringValue(apiObject.BucketName),
+		"bucket_prefix":                   aws.StringValue(apiObject.BucketPrefix),
+		"fail_on_first_destination_error": aws.BoolValue(apiObject.FailOnFirstDestinationError),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3OutputFormatConfig(apiObject *appflow.S3OutputFormatConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"file_type":                   aws.StringValue(apiObject.FileType),
+		"preserve_source_data_typing": aws.BoolValue(apiObject.PreserveSourceDataTyping),
+	}
+
+	if v := apiObject.AggregationConfig; v != nil {
+		tfMap["aggregation_config"] = []interface{}{map[string]interface{}{
+			"aggregation_type": aws.StringValue(v.AggregationType),
+			"target_file_size": aws.Int64Value(v.TargetFileSize),
+		}}
+	}
+
+	if v := apiObject.PrefixConfig; v != nil {
+		tfMap["prefix_config"] = []interface{}{map[string]interface{}{
+			"prefix_format": aws.StringValue(v.PrefixFormat),
+			"prefix_type":   aws.StringValue(v.PrefixType),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTasks(apiObjects []*appflow.Task) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination_field": aws.StringValue(apiObject.DestinationField),
+			"source_fields":     aws.StringValueSlice(apiObject.SourceFields),
+			"task_properties":   aws.StringValueMap(apiObject.TaskProperties),
+			"task_type":         aws.StringValue(apiObject.TaskType),
+		}
+
+		if v := apiObject.ConnectorOperator; v != nil {
+			tfMap["connector_operator"] = []interface{}{map[string]interface{}{
+				"custom_connector": aws.StringValue(v.CustomConnector),
+				"s3":               aws.StringValue(v.S3),
+				"salesforce":       aws.StringValue(v.Salesforce),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTriggerConfig(apiObject *appflow.TriggerConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"trigger_type": aws.StringValue(apiObject.TriggerType),
+	}
+
+	if v := apiObject.TriggerProperties; v != nil && v.Scheduled != nil {
+		tfMap["trigger_properties"] = []interface{}{map[string]interface{}{
+			"scheduled": []interface{}{flattenScheduledTriggerProperties(v.Scheduled)},
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScheduledTriggerProperties(apiObject *appflow.ScheduledTriggerProperties) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"data_pull_mode":      aws.StringValue(apiObject.DataPullMode),
+		"schedule_expression": aws.StringValue(apiObject.ScheduleExpression),
+		"schedule_offset":     aws.Int64Value(apiObject.ScheduleOffset),
+		"timezone":            aws.StringValue(apiObject.Timezone),
+	}
+
+	if v := apiObject.FirstExecutionFrom; v != nil {
+		tfMap["first_execution_from"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ScheduleEndTime; v != nil {
+		tfMap["schedule_end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.ScheduleStartTime; v != nil {
+		tfMap["schedule_start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,797 @@
+package appflow_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appflow"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAppFlowFlow_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appflow", regexp.MustCompile(`flow/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.connector_type", appflow.ConnectorTypeS3),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.bucket_name", "aws_s3_bucket.destination", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "flow_status", appflow.FlowStatusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.connector_type", appflow.ConnectorTypeS3),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.source_connector_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.source_connector_properties.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_flow_config.0.source_connector_properties.0.s3.0.bucket_name", "aws_s3_bucket.source", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "source_flow_config.0.source_connector_properties.0.s3.0.bucket_prefix", "flow"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task.*", map[string]string{
+						"connector_operator.#":    "1",
+						"connector_operator.0.s3": appflow.S3ConnectorOperatorProjection,
+						"source_fields.#":         "2",
+						"task_type":               appflow.TaskTypeFilter,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task.*", map[string]string{
+						"connector_operator.#":    "1",
+						"connector_operator.0.s3": appflow.S3ConnectorOperatorNoOp,
+						"destination_field":       "testField",
+						"source_fields.#":         "1",
+						"source_fields.0":         "testField",
+						"task_type":               appflow.TaskTypeMap,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeOnDemand),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfappflow.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appflow_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeOnDemand),
+				),
+			},
+			{
+				Config: testAccFlowConfig_scheduled(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "destination_flow_config.0.destination_connector_properties.0.s3.0.s3_output_format_config.0.file_type", appflow.FileTypeParquet),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_type", appflow.TriggerTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.data_pull_mode", appflow.DataPullModeIncremental),
+					resource.TestCheckResourceAttr(resourceName, "trigger_config.0.trigger_properties.0.scheduled.0.schedule_expression", "rate(1hours)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppFlowFlow_configurationMismatch(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appflow.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appflow.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFlowConfig_taskConnectorOperator(rName, `salesforce = "PROJECTION"`),
+				ExpectError: regexp.MustCompile(`task connector_operator salesforce \(PROJECTION\) is not valid for source connector_type S3`),
+			},
+			{
+				Config:      testAccFlowConfig_taskConnectorOperator(rName, ""),
+				ExpectError: regexp.MustCompile(`task connector_operator.0.s3 must be set when source connector_type is S3`),
+			},
+			{
+				Config:      testAccFlowConfig_sourceConnectorProperties(rName),
+				ExpectError: regexp.MustCompile(`source_flow_config.0.source_connector_properties.0.salesforce must not be set when connector_type is S3`),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_appflow_flow" {
+			continue
+		}
+
+		_, err := tfappflow.FindFlowByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("AppFlow Flow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFlowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppFlow Flow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFlowConn
+
+		_, err := tfappflow.FindFlowByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccFlowConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "source" {
+  bucket = aws_s3_bucket.source.id
+  policy = <<EOF
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appflow.amazonaws.com"
+      },
+      "Action": [
+        "s3:ListBucket",
+        "s3:GetObject"
+      ],
+      "Resource": [
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s-source",
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s-source/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.source.id
+  key     = "flow/test.csv"
+  content = "testField,otherField\nvalue1,value2\n"
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket        = "%[1]s-destination"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "destination" {
+  bucket = aws_s3_bucket.destination.id
+  policy = <<EOF
+{
+  "Version": "2008-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "appflow.amazonaws.com"
+      },
+      "Action": [
+        "s3:PutObject",
+        "s3:AbortMultipartUpload",
+        "s3:ListMultipartUploadParts",
+        "s3:ListBucketMultipartUploads",
+        "s3:GetBucketAcl",
+        "s3:PutObjectAcl"
+      ],
+      "Resource": [
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s-destination",
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s-destination/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields = ["testField", "otherField"]
+    task_type     = "Filter"
+
+    connector_operator {
+      s3 = "PROJECTION"
+    }
+  }
+
+  task {
+    destination_field = "testField"
+    source_fields     = ["testField"]
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName))
+}
+
+func testAccFlowConfig_scheduled(rName, description string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+
+        s3_output_format_config {
+          file_type = "PARQUET"
+
+          prefix_config {
+            prefix_type = "PATH"
+          }
+        }
+      }
+    }
+  }
+
+  task {
+    source_fields = ["testField", "otherField"]
+    task_type     = "Filter"
+
+    connector_operator {
+      s3 = "PROJECTION"
+    }
+  }
+
+  task {
+    destination_field = "testField"
+    source_fields     = ["testField"]
+    task_type         = "Map"
+
+    connector_operator {
+      s3 = "NO_OP"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "Scheduled"
+
+    trigger_properties {
+      scheduled {
+        data_pull_mode      = "Incremental"
+        schedule_expression = "rate(1hours)"
+      }
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, description))
+}
+
+func testAccFlowConfig_taskConnectorOperator(rName, connectorOperator string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields = ["testField"]
+    task_type     = "Filter"
+
+    connector_operator {
+      %[2]s
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+}
+`, rName, connectorOperator))
+}
+
+func testAccFlowConfig_sourceConnectorProperties(rName string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      salesforce {
+        object = "Account"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields = ["testField"]
+    task_type     = "Filter"
+
+    connector_operator {
+      s3 = "PROJECTION"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+}
+`, rName))
+}
+
+func testAccFlowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields = ["testField"]
+    task_type     = "Filter"
+
+    connector_operator {
+      s3 = "PROJECTION"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFlowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFlowConfig_base(rName), fmt.Sprintf(`
+resource "aws_appflow_flow" "test" {
+  name = %[1]q
+
+  source_flow_config {
+    connector_type = "S3"
+
+    source_connector_properties {
+      s3 {
+        bucket_name   = aws_s3_bucket_policy.source.bucket
+        bucket_prefix = "flow"
+      }
+    }
+  }
+
+  destination_flow_config {
+    connector_type = "S3"
+
+    destination_connector_properties {
+      s3 {
+        bucket_name = aws_s3_bucket_policy.destination.bucket
+      }
+    }
+  }
+
+  task {
+    source_fields = ["testField"]
+    task_type     = "Filter"
+
+    connector_operator {
+      s3 = "PROJECTION"
+    }
+  }
+
+  trigger_config {
+    trigger_type = "OnDemand"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func TestValidateFlowConfiguration(t *testing.T) {
+	s3Source := []interface{}{map[string]interface{}{
+		"connector_type": appflow.ConnectorTypeS3,
+		"source_connector_properties": []interface{}{map[string]interface{}{
+			"s3": []interface{}{map[string]interface{}{"bucket_name": "source"}},
+		}},
+	}}
+	salesforceSource := []interface{}{map[string]interface{}{
+		"connector_type": appflow.ConnectorTypeSalesforce,
+		"source_connector_properties": []interface{}{map[string]interface{}{
+			"salesforce": []interface{}{map[string]interface{}{"object": "Account"}},
+		}},
+	}}
+	s3Destinations := []interface{}{map[string]interface{}{
+		"connector_type": appflow.ConnectorTypeS3,
+		"destination_connector_properties": []interface{}{map[string]interface{}{
+			"s3": []interface{}{map[string]interface{}{"bucket_name": "destination"}},
+		}},
+	}}
+	task := func(key string) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"connector_operator": []interface{}{map[string]interface{}{key: "PROJECTION"}},
+		}}
+	}
+	trigger := func(triggerType string, scheduled bool) []interface{} {
+		tfMap := map[string]interface{}{"trigger_type": triggerType}
+
+		if scheduled {
+			tfMap["trigger_properties"] = []interface{}{map[string]interface{}{
+				"scheduled": []interface{}{map[string]interface{}{"schedule_expression": "rate(1hours)"}},
+			}}
+		}
+
+		return []interface{}{tfMap}
+	}
+
+	testCases := []struct {
+		TestName     string
+		Source       []interface{}
+		Destinations []interface{}
+		Tasks        []interface{}
+		Trigger      []interface{}
+		ExpectError  bool
+	}{
+		{
+			TestName:     "s3 on demand",
+			Source:       s3Source,
+			Destinations: s3Destinations,
+			Tasks:        task("s3"),
+			Trigger:      trigger(appflow.TriggerTypeOnDemand, false),
+		},
+		{
+			TestName:     "s3 scheduled",
+			Source:       s3Source,
+			Destinations: s3Destinations,
+			Tasks:        task("s3"),
+			Trigger:      trigger(appflow.TriggerTypeScheduled, true),
+		},
+		{
+			TestName:     "salesforce event",
+			Source:       salesforceSource,
+			Destinations: s3Destinations,
+			Tasks:        task("salesforce"),
+			Trigger:      trigger(appflow.TriggerTypeEvent, false),
+		},
+		{
+			TestName:     "s3 event",
+			Source:       s3Source,
+			Destinations: s3Destinations,
+			Tasks:        task("s3"),
+			Trigger:      trigger(appflow.TriggerTypeEvent, false),
+			ExpectError:  true,
+		},
+		{
+			TestName:     "scheduled without schedule",
+			Source:       s3Source,
+			Destinations: s3Destinations,
+			Tasks:        task("s3"),
+			Trigger:      trigger(appflow.TriggerTypeScheduled, false),
+			ExpectError:  true,
+		},
+		{
+			TestName:     "on demand with schedule",
+			Source:       salesforceSource,
+			Destinations: s3Destinations,
+			Tasks:        task("salesforce"),
+			Trigger:      trigger(appflow.TriggerTypeOnDemand, true),
+			ExpectError:  true,
+		},
+		{
+			TestName:     "task operator for another connector",
+			Source:       salesforceSource,
+			Destinations: s3Destinations,
+			Tasks:        task("s3"),
+			Trigger:      trigger(appflow.TriggerTypeOnDemand, false),
+			ExpectError:  true,
+		},
+		{
+			TestName: "source properties for another connector",
+			Source: []interface{}{map[string]interface{}{
+				"connector_type": appflow.ConnectorTypeSalesforce,
+				"source_connector_properties": []interface{}{map[string]interface{}{
+					"s3": []interface{}{map[string]interface{}{"bucket_name": "source"}},
+				}},
+			}},
+			Destinations: s3Destinations,
+			Tasks:        task("salesforce"),
+			Trigger:      trigger(appflow.TriggerTypeOnDemand, false),
+			ExpectError:  true,
+		},
+		{
+			TestName:     "unknown task operator",
+			Source:       salesforceSource,
+			Destinations: s3Destinations,
+			Tasks: []interface{}{map[string]interface{}{
+				"connector_operator": []interface{}{map[string]interface{}{"s3": "", "salesforce": nil}},
+			}},
+			Trigger: trigger(appflow.TriggerTypeOnDemand, false),
+		},
+		{
+			TestName: "unknown source properties",
+			Source: []interface{}{map[string]interface{}{
+				"connector_type": appflow.ConnectorTypeSalesforce,
+				"source_connector_properties": []interface{}{map[string]interface{}{
+					"s3":         []interface{}{},
+					"salesforce": nil,
+				}},
+			}},
+			Destinations: s3Destinations,
+			Tasks:        task("salesforce"),
+			Trigger:      trigger(appflow.TriggerTypeOnDemand, false),
+		},
+		{
+			TestName:     "unknown trigger properties",
+			Source:       s3Source,
+			Destinations: s3Destinations,
+			Tasks:        task("s3"),
+			Trigger: []interface{}{map[string]interface{}{
+				"trigger_type":       appflow.TriggerTypeScheduled,
+				"trigger_properties": nil,
+			}},
+		},
+		{
+			TestName: "destination properties missing",
+			Source:   s3Source,
+			Destinations: []interface{}{map[string]interface{}{
+				"connector_type": appflow.ConnectorTypeRedshift,
+				"destination_connector_properties": []interface{}{map[string]interface{}{
+					"s3": []interface{}{map[string]interface{}{"bucket_name": "destination"}},
+				}},
+			}},
+			Tasks:       task("s3"),
+			Trigger:     trigger(appflow.TriggerTypeOnDemand, false),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := tfappflow.ValidateFlowConfiguration(testCase.Source, testCase.Destinations, testCase.Tasks, testCase.Trigger)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
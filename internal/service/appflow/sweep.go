@@ -0,0 +1,132 @@
+//go:build sweep
+// +build sweep
+
+package appflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appflow"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_appflow_connector_profile", &resource.Sweeper{
+		Name: "aws_appflow_connector_profile",
+		F:    sweepConnectorProfiles,
+		Dependencies: []string{
+			"aws_appflow_flow",
+		},
+	})
+
+	resource.AddTestSweepers("aws_appflow_flow", &resource.Sweeper{
+		Name: "aws_appflow_flow",
+		F:    sweepFlows,
+	})
+}
+
+func sweepConnectorProfiles(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppFlowConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &appflow.DescribeConnectorProfilesInput{}
+
+	err = conn.DescribeConnectorProfilesPagesWithContext(ctx, input, func(page *appflow.DescribeConnectorProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, connectorProfile := range page.ConnectorProfileDetails {
+			if connectorProfile == nil {
+				continue
+			}
+
+			r := ResourceConnectorProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(connectorProfile.ConnectorProfileName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppFlow Connector Profiles sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing AppFlow Connector Profiles (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping AppFlow Connector Profiles (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepFlows(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AppFlowConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &appflow.ListFlowsInput{}
+
+	err = conn.ListFlowsPagesWithContext(ctx, input, func(page *appflow.ListFlowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, flow := range page.Flows {
+			if flow == nil {
+				continue
+			}
+
+			r := ResourceFlow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(flow.FlowName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppFlow Flows sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing AppFlow Flows (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping AppFlow Flows (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,146 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccountRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountRegistrationCreate,
+		ReadWithoutTimeout:   resourceAccountRegistrationRead,
+		UpdateWithoutTimeout: resourceAccountRegistrationUpdate,
+		DeleteWithoutTimeout: resourceAccountRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delegated_admin_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"deregister_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccountRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	input := &auditmanager.RegisterAccountInput{}
+
+	if v, ok := d.GetOk("delegated_admin_account"); ok {
+		input.DelegatedAdminAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KmsKey = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering Audit Manager Account: %s", input)
+	_, err := conn.RegisterAccountWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error registering Audit Manager Account: %s", err)
+	}
+
+	// Registration is per account and Region.
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return resourceAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceAccountRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	status, err := FindAccountStatus(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Account Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Account Registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("status", status)
+
+	settings, err := FindSettings(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("kms_key", settings.KmsKey)
+
+	return nil
+}
+
+func resourceAccountRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChanges("delegated_admin_account", "kms_key") {
+		input := &auditmanager.RegisterAccountInput{}
+
+		if v, ok := d.GetOk("delegated_admin_account"); ok {
+			input.DelegatedAdminAccount = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("kms_key"); ok {
+			input.KmsKey = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Account Registration: %s", input)
+		_, err := conn.RegisterAccountWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Audit Manager Account Registration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceAccountRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("deregister_on_destroy").(bool) {
+		log.Printf("[DEBUG] Removing Audit Manager Account Registration (%s) from state without deregistering", d.Id())
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deregistering Audit Manager Account: %s", d.Id())
+	_, err := conn.DeregisterAccountWithContext(ctx, &auditmanager.DeregisterAccountInput{})
+
+	if err != nil {
+		return diag.Errorf("error deregistering Audit Manager Account (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
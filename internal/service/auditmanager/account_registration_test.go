@@ -0,0 +1,157 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAccountRegistration_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deregister_on_destroy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deregister_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccAccountRegistration_disappears(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceAccountRegistration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccountRegistration_kmsKey(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountRegistrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAccountRegistrationKMSKeyConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Account Registration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		_, err := tfauditmanager.FindAccountStatus(context.Background(), conn)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountRegistrationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_account_registration" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindAccountStatus(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Account Registration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAccountRegistrationConfig() string {
+	return `
+resource "aws_auditmanager_account_registration" "test" {
+  deregister_on_destroy = true
+}
+`
+}
+
+func testAccAccountRegistrationKMSKeyConfig() string {
+	return `
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_auditmanager_account_registration" "test" {
+  deregister_on_destroy = true
+  kms_key               = aws_kms_key.test.arn
+}
+`
+}
@@ -0,0 +1,498 @@
+package auditmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssessment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentCreate,
+		ReadWithoutTimeout:   resourceAssessmentRead,
+		UpdateWithoutTimeout: resourceAssessmentUpdate,
+		DeleteWithoutTimeout: resourceAssessmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_reports_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"destination_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.AssessmentReportDestinationType_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"framework_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"role_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+						},
+					},
+				},
+			},
+			"roles_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"aws_services": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.AssessmentStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAssessmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentInput{
+		FrameworkId: aws.String(d.Get("framework_id").(string)),
+		Name:        aws.String(name),
+		Roles:       expandRoles(d.Get("roles").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("assessment_reports_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AssessmentReportsDestination = expandAssessmentReportsDestination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Scope = expandScope(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		input.Scope = &auditmanager.Scope{}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Assessment: %s", input)
+	output, err := conn.CreateAssessmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Audit Manager Assessment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assessment.Metadata.Id))
+
+	// New assessments are always active.
+	if v, ok := d.GetOk("status"); ok && v.(string) != auditmanager.AssessmentStatusActive {
+		if err := updateAssessmentStatus(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAssessmentRead(ctx, d, meta)
+}
+
+func resourceAssessmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	assessment, err := FindAssessmentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Assessment (%s): %s", d.Id(), err)
+	}
+
+	metadata := assessment.Metadata
+
+	d.Set("arn", assessment.Arn)
+	if metadata.AssessmentReportsDestination != nil {
+		if err := d.Set("assessment_reports_destination", []interface{}{flattenAssessmentReportsDestination(metadata.AssessmentReportsDestination)}); err != nil {
+			return diag.Errorf("error setting assessment_reports_destination: %s", err)
+		}
+	} else {
+		d.Set("assessment_reports_destination", nil)
+	}
+	d.Set("description", metadata.Description)
+	if assessment.Framework != nil {
+		d.Set("framework_id", assessment.Framework.Id)
+	}
+	d.Set("name", metadata.Name)
+	// The assessment owner is always added as a process owner, so only
+	// the full set of roles is read back.
+	if err := d.Set("roles_all", flattenRoles(metadata.Roles)); err != nil {
+		return diag.Errorf("error setting roles_all: %s", err)
+	}
+	if metadata.Scope != nil {
+		if err := d.Set("scope", []interface{}{flattenScope(metadata.Scope)}); err != nil {
+			return diag.Errorf("error setting scope: %s", err)
+		}
+	} else {
+		d.Set("scope", nil)
+	}
+	d.Set("status", metadata.Status)
+
+	tags := KeyValueTags(assessment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAssessmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChanges("assessment_reports_destination", "description", "name", "roles", "scope") {
+		input := &auditmanager.UpdateAssessmentInput{
+			AssessmentId:   aws.String(d.Id()),
+			AssessmentName: aws.String(d.Get("name").(string)),
+			Roles:          expandRoles(d.Get("roles").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("assessment_reports_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AssessmentReportsDestination = expandAssessmentReportsDestination(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.AssessmentDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("scope"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Scope = expandScope(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Scope = &auditmanager.Scope{}
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Assessment: %s", input)
+		_, err := conn.UpdateAssessmentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Audit Manager Assessment (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		if err := updateAssessmentStatus(ctx, conn, d.Id(), d.Get("status").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Audit Manager Assessment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAssessmentRead(ctx, d, meta)
+}
+
+func resourceAssessmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Assessment: %s", d.Id())
+	_, err := conn.DeleteAssessmentWithContext(ctx, &auditmanager.DeleteAssessmentInput{
+		AssessmentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Audit Manager Assessment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateAssessmentStatus(ctx context.Context, conn *auditmanager.AuditManager, id, status string) error {
+	input := &auditmanager.UpdateAssessmentStatusInput{
+		AssessmentId: aws.String(id),
+		Status:       aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Audit Manager Assessment status: %s", input)
+	_, err := conn.UpdateAssessmentStatusWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Audit Manager Assessment (%s) status: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandAssessmentReportsDestination(tfMap map[string]interface{}) *auditmanager.AssessmentReportsDestination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.AssessmentReportsDestination{}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_type"].(string); ok && v != "" {
+		apiObject.DestinationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRoles(tfList []interface{}) []*auditmanager.Role {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.Role
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &auditmanager.Role{
+			RoleArn:  aws.String(tfMap["role_arn"].(string)),
+			RoleType: aws.String(tfMap["role_type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandScope(tfMap map[string]interface{}) *auditmanager.Scope {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &auditmanager.Scope{}
+
+	if v, ok := tfMap["aws_accounts"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AwsAccounts = append(apiObject.AwsAccounts, &auditmanager.AWSAccount{
+				Id: aws.String(tfMap["id"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["aws_services"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.AwsServices = append(apiObject.AwsServices, &auditmanager.AWSService{
+				ServiceName: aws.String(tfMap["service_name"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAssessmentReportsDestination(apiObject *auditmanager.AssessmentReportsDestination) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Destination; v != nil {
+		tfMap["destination"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DestinationType; v != nil {
+		tfMap["destination_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenRoles(apiObjects []*auditmanager.Role) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"role_arn":  aws.StringValue(apiObject.RoleArn),
+			"role_type": aws.StringValue(apiObject.RoleType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenScope(apiObject *auditmanager.Scope) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	var accounts []interface{}
+
+	for _, v := range apiObject.AwsAccounts {
+		if v == nil {
+			continue
+		}
+
+		accounts = append(accounts, map[string]interface{}{
+			"id": aws.StringValue(v.Id),
+		})
+	}
+
+	tfMap["aws_accounts"] = accounts
+
+	var services []interface{}
+
+	for _, v := range apiObject.AwsServices {
+		if v == nil {
+			continue
+		}
+
+		services = append(services, map[string]interface{}{
+			"service_name": aws.StringValue(v.ServiceName),
+		})
+	}
+
+	tfMap["aws_services"] = services
+
+	return tfMap
+}
@@ -0,0 +1,366 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAuditManagerAssessment_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "auditmanager", regexp.MustCompile(`assessment/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "assessment_reports_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "assessment_reports_destination.0.destination_type", "S3"),
+					resource.TestCheckResourceAttrPair(resourceName, "framework_id", "aws_auditmanager_framework.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "roles.*.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "roles_all.*.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_services.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scope.0.aws_services.*", map[string]string{
+						"service_name": "S3",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"roles"},
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessment_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfauditmanager.ResourceAssessment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessment_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_services.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccAssessmentUpdatedConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.aws_services.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"roles"},
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAssessment_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_auditmanager_assessment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssessmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssessmentTagsConfig1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"roles"},
+			},
+			{
+				Config: testAccAssessmentTagsConfig2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAssessmentTagsConfig1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssessmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssessmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager Assessment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		_, err := tfauditmanager.FindAssessmentByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssessmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_auditmanager_assessment" {
+			continue
+		}
+
+		_, err := tfauditmanager.FindAssessmentByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Audit Manager Assessment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAssessmentBaseConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFrameworkConfig(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "auditmanager.amazonaws.com" }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccAssessmentConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAssessmentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name         = %[1]q
+  framework_id = aws_auditmanager_framework.test.id
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAssessmentUpdatedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAssessmentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name         = %[1]q
+  framework_id = aws_auditmanager_framework.test.id
+  description  = "updated"
+  status       = "INACTIVE"
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+
+    aws_services {
+      service_name = "EC2"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAssessmentTagsConfig1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAssessmentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name         = %[1]q
+  framework_id = aws_auditmanager_framework.test.id
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAssessmentTagsConfig2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAssessmentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_auditmanager_assessment" "test" {
+  name         = %[1]q
+  framework_id = aws_auditmanager_framework.test.id
+
+  assessment_reports_destination {
+    destination      = "s3://${aws_s3_bucket.test.id}"
+    destination_type = "S3"
+  }
+
+  roles {
+    role_arn  = aws_iam_role.test.arn
+    role_type = "PROCESS_OWNER"
+  }
+
+  scope {
+    aws_accounts {
+      id = data.aws_caller_identity.current.account_id
+    }
+
+    aws_services {
+      service_name = "S3"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
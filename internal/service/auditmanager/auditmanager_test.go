@@ -0,0 +1,29 @@
+package auditmanager_test
+
+import (
+	"testing"
+)
+
+// Account registration affects every other Audit Manager resource in the
+// account and Region, so its tests must not run in parallel.
+func TestAccAuditManager_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AccountRegistration": {
+			"basic":      testAccAccountRegistration_basic,
+			"disappears": testAccAccountRegistration_disappears,
+			"kmsKey":     testAccAccountRegistration_kmsKey,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceControl() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceControlRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_plan_instructions": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"action_plan_title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_mapping_sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_keyword": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keyword_input_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"keyword_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"source_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_set_up_option": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"troubleshooting_text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"testing_information": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.ControlType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceControlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	control, err := FindControlByName(ctx, conn, name, d.Get("type").(string))
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Control (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(control.Id))
+	d.Set("action_plan_instructions", control.ActionPlanInstructions)
+	d.Set("action_plan_title", control.ActionPlanTitle)
+	d.Set("arn", control.Arn)
+	if err := d.Set("control_mapping_sources", flattenControlMappingSources(control.ControlMappingSources)); err != nil {
+		return diag.Errorf("error setting control_mapping_sources: %s", err)
+	}
+	d.Set("description", control.Description)
+	d.Set("name", control.Name)
+	d.Set("testing_information", control.TestingInformation)
+	d.Set("type", control.Type)
+
+	if err := d.Set("tags", KeyValueTags(control.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
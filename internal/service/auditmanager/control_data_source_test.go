@@ -0,0 +1,76 @@
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAuditManagerControlDataSource_standard(t *testing.T) {
+	dataSourceName := "data.aws_auditmanager_control.test"
+	// A standard control that's available in all Audit Manager Regions.
+	name := "1. Risk Management"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlDataSourceStandardConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "Standard"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerControlDataSource_custom(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_auditmanager_control.test"
+	resourceName := "aws_auditmanager_control.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlDataSourceCustomConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_mapping_sources.#", resourceName, "control_mapping_sources.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_mapping_sources.0.source_id", resourceName, "control_mapping_sources.0.source_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "Custom"),
+				),
+			},
+		},
+	})
+}
+
+func testAccControlDataSourceStandardConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_auditmanager_control" "test" {
+  name = %[1]q
+  type = "Standard"
+}
+`, name)
+}
+
+func testAccControlDataSourceCustomConfig(rName string) string {
+	return acctest.ConfigCompose(testAccControlConfig(rName), `
+data "aws_auditmanager_control" "test" {
+  name = aws_auditmanager_control.test.name
+  type = "Custom"
+}
+`)
+}
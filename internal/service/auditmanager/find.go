@@ -0,0 +1,238 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccountStatus(ctx context.Context, conn *auditmanager.AuditManager) (string, error) {
+	input := &auditmanager.GetAccountStatusInput{}
+
+	output, err := conn.GetAccountStatusWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	status := aws.StringValue(output.Status)
+
+	if status == auditmanager.AccountStatusInactive {
+		return "", &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return status, nil
+}
+
+func FindSettings(ctx context.Context, conn *auditmanager.AuditManager) (*auditmanager.Settings, error) {
+	input := &auditmanager.GetSettingsInput{
+		Attribute: aws.String(auditmanager.SettingAttributeAll),
+	}
+
+	output, err := conn.GetSettingsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Settings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Settings, nil
+}
+
+func FindAssessmentByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Assessment, error) {
+	input := &auditmanager.GetAssessmentInput{
+		AssessmentId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil || output.Assessment.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func FindControlByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Control, error) {
+	input := &auditmanager.GetControlInput{
+		ControlId: aws.String(id),
+	}
+
+	output, err := conn.GetControlWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Control == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Control, nil
+}
+
+func FindControlByName(ctx context.Context, conn *auditmanager.AuditManager, name, controlType string) (*auditmanager.Control, error) {
+	input := &auditmanager.ListControlsInput{
+		ControlType: aws.String(controlType),
+	}
+	var controlID string
+
+	err := conn.ListControlsPagesWithContext(ctx, input, func(page *auditmanager.ListControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ControlMetadataList {
+			if v != nil && aws.StringValue(v.Name) == name {
+				controlID = aws.StringValue(v.Id)
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if controlID == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return FindControlByID(ctx, conn, controlID)
+}
+
+func FindFrameworkByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Framework, error) {
+	input := &auditmanager.GetAssessmentFrameworkInput{
+		FrameworkId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentFrameworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Framework == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Framework, nil
+}
+
+func FindFrameworkByName(ctx context.Context, conn *auditmanager.AuditManager, name, frameworkType string) (*auditmanager.Framework, error) {
+	input := &auditmanager.ListAssessmentFrameworksInput{
+		FrameworkType: aws.String(frameworkType),
+	}
+	var frameworkID string
+
+	err := conn.ListAssessmentFrameworksPagesWithContext(ctx, input, func(page *auditmanager.ListAssessmentFrameworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FrameworkMetadataList {
+			if v != nil && aws.StringValue(v.Name) == name {
+				frameworkID = aws.StringValue(v.Id)
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if frameworkID == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return FindFrameworkByID(ctx, conn, frameworkID)
+}
+
+func FindFrameworkShareByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.AssessmentFrameworkShareRequest, error) {
+	input := &auditmanager.ListAssessmentFrameworkShareRequestsInput{
+		RequestType: aws.String(auditmanager.ShareRequestTypeSent),
+	}
+	var output *auditmanager.AssessmentFrameworkShareRequest
+
+	err := conn.ListAssessmentFrameworkShareRequestsPagesWithContext(ctx, input, func(page *auditmanager.ListAssessmentFrameworkShareRequestsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssessmentFrameworkShareRequests {
+			if v != nil && aws.StringValue(v.Id) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	// Declined, expired and revoked share requests can't be reactivated.
+	if status := aws.StringValue(output.Status); status == auditmanager.ShareRequestStatusDeclined || status == auditmanager.ShareRequestStatusExpired || status == auditmanager.ShareRequestStatusRevoked {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
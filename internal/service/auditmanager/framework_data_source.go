@@ -0,0 +1,100 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceFramework() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFrameworkRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controls": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"framework_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.FrameworkType_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceFrameworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	framework, err := FindFrameworkByName(ctx, conn, name, d.Get("framework_type").(string))
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Framework (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(framework.Id))
+	d.Set("arn", framework.Arn)
+	d.Set("compliance_type", framework.ComplianceType)
+	if err := d.Set("control_sets", flattenFrameworkControlSets(framework.ControlSets)); err != nil {
+		return diag.Errorf("error setting control_sets: %s", err)
+	}
+	d.Set("description", framework.Description)
+	d.Set("framework_type", framework.Type)
+	d.Set("name", framework.Name)
+
+	if err := d.Set("tags", KeyValueTags(framework.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
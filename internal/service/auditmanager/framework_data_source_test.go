@@ -0,0 +1,77 @@
+package auditmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAuditManagerFrameworkDataSource_standard(t *testing.T) {
+	dataSourceName := "data.aws_auditmanager_framework.test"
+	// A standard framework that's available in all Audit Manager Regions.
+	name := "Essential Eight"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkDataSourceStandardConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "control_sets.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "framework_type", "Standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerFrameworkDataSource_custom(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_auditmanager_framework.test"
+	resourceName := "aws_auditmanager_framework.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFrameworkDataSourceCustomConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_sets.#", resourceName, "control_sets.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "control_sets.0.id", resourceName, "control_sets.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "framework_type", "Custom"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFrameworkDataSourceStandardConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_auditmanager_framework" "test" {
+  name           = %[1]q
+  framework_type = "Standard"
+}
+`, name)
+}
+
+func testAccFrameworkDataSourceCustomConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFrameworkConfig(rName), `
+data "aws_auditmanager_framework" "test" {
+  name           = aws_auditmanager_framework.test.name
+  framework_type = "Custom"
+}
+`)
+}
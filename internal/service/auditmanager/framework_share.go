@@ -0,0 +1,147 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFrameworkShare() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFrameworkShareCreate,
+		ReadWithoutTimeout:   resourceFrameworkShareRead,
+		DeleteWithoutTimeout: resourceFrameworkShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"destination_account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"destination_region": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"framework_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFrameworkShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	frameworkID := d.Get("framework_id").(string)
+	input := &auditmanager.StartAssessmentFrameworkShareInput{
+		DestinationAccount: aws.String(d.Get("destination_account").(string)),
+		DestinationRegion:  aws.String(d.Get("destination_region").(string)),
+		FrameworkId:        aws.String(frameworkID),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Framework Share: %s", input)
+	output, err := conn.StartAssessmentFrameworkShareWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Audit Manager Framework Share (%s): %s", frameworkID, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssessmentFrameworkShareRequest.Id))
+
+	return resourceFrameworkShareRead(ctx, d, meta)
+}
+
+func resourceFrameworkShareRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	share, err := FindFrameworkShareByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Framework Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Framework Share (%s): %s", d.Id(), err)
+	}
+
+	d.Set("comment", share.Comment)
+	d.Set("destination_account", share.DestinationAccount)
+	d.Set("destination_region", share.DestinationRegion)
+	d.Set("framework_id", share.FrameworkId)
+	d.Set("status", share.Status)
+
+	return nil
+}
+
+func resourceFrameworkShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	// Share requests that are still in progress must be revoked before they can be deleted.
+	switch d.Get("status").(string) {
+	case auditmanager.ShareRequestStatusActive, auditmanager.ShareRequestStatusReplicating, auditmanager.ShareRequestStatusShared:
+		log.Printf("[DEBUG] Revoking Audit Manager Framework Share: %s", d.Id())
+		_, err := conn.UpdateAssessmentFrameworkShareWithContext(ctx, &auditmanager.UpdateAssessmentFrameworkShareInput{
+			Action:      aws.String(auditmanager.ShareRequestActionRevoke),
+			RequestId:   aws.String(d.Id()),
+			RequestType: aws.String(auditmanager.ShareRequestTypeSent),
+		})
+
+		if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("error revoking Audit Manager Framework Share (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Audit Manager Framework Share: %s", d.Id())
+	_, err := conn.DeleteAssessmentFrameworkShareWithContext(ctx, &auditmanager.DeleteAssessmentFrameworkShareInput{
+		RequestId:   aws.String(d.Id()),
+		RequestType: aws.String(auditmanager.ShareRequestTypeSent),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Audit Manager Framework Share (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
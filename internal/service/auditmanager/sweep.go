@@ -0,0 +1,252 @@
+//go:build sweep
+// +build sweep
+
+package auditmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+		Name: "aws_auditmanager_assessment",
+		F:    sweepAssessments,
+	})
+
+	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+		Name: "aws_auditmanager_control",
+		F:    sweepControls,
+		Dependencies: []string{
+			"aws_auditmanager_framework",
+		},
+	})
+
+	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+		Name: "aws_auditmanager_framework",
+		F:    sweepFrameworks,
+		Dependencies: []string{
+			"aws_auditmanager_assessment",
+			"aws_auditmanager_framework_share",
+		},
+	})
+
+	resource.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
+		Name: "aws_auditmanager_framework_share",
+		F:    sweepFrameworkShares,
+	})
+}
+
+func sweepAssessments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListAssessmentsPagesWithContext(context.Background(), &auditmanager.ListAssessmentsInput{}, func(page *auditmanager.ListAssessmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssessmentMetadata {
+			// Only sweep assessments created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.Name), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceAssessment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Assessment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Assessments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Assessments (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepControls(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	input := &auditmanager.ListControlsInput{
+		ControlType: aws.String(auditmanager.ControlTypeCustom),
+	}
+
+	err = conn.ListControlsPagesWithContext(context.Background(), input, func(page *auditmanager.ListControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ControlMetadataList {
+			// Only sweep controls created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.Name), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceControl()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Control sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Controls (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Controls (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFrameworks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	input := &auditmanager.ListAssessmentFrameworksInput{
+		FrameworkType: aws.String(auditmanager.FrameworkTypeCustom),
+	}
+
+	err = conn.ListAssessmentFrameworksPagesWithContext(context.Background(), input, func(page *auditmanager.ListAssessmentFrameworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FrameworkMetadataList {
+			// Only sweep frameworks created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.Name), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceFramework()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Framework sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Frameworks (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Frameworks (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFrameworkShares(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	input := &auditmanager.ListAssessmentFrameworkShareRequestsInput{
+		RequestType: aws.String(auditmanager.ShareRequestTypeSent),
+	}
+
+	err = conn.ListAssessmentFrameworkShareRequestsPagesWithContext(context.Background(), input, func(page *auditmanager.ListAssessmentFrameworkShareRequestsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssessmentFrameworkShareRequests {
+			// Only sweep shares of frameworks created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.FrameworkName), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceFrameworkShare()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+			d.Set("status", v.Status)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Framework Share sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Framework Shares (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Framework Shares (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package ce
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalyMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyMonitorCreate,
+		ReadWithoutTimeout:   resourceAnomalyMonitorRead,
+		UpdateWithoutTimeout: resourceAnomalyMonitorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyMonitorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_dimension": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(costexplorer.MonitorDimension_Values(), false),
+				ConflictsWith: []string{"monitor_specification"},
+			},
+			"monitor_specification": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				Elem:          expressionSchema(),
+				ConflictsWith: []string{"monitor_dimension"},
+			},
+			"monitor_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.MonitorType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	monitorType := d.Get("monitor_type").(string)
+	input := &costexplorer.CreateAnomalyMonitorInput{
+		AnomalyMonitor: &costexplorer.AnomalyMonitor{
+			MonitorName: aws.String(name),
+			MonitorType: aws.String(monitorType),
+		},
+	}
+
+	switch monitorType {
+	case costexplorer.MonitorTypeDimensional:
+		if v, ok := d.GetOk("monitor_dimension"); ok {
+			input.AnomalyMonitor.MonitorDimension = aws.String(v.(string))
+		} else {
+			return diag.Errorf("monitor_dimension must be set when monitor_type is %s", monitorType)
+		}
+	case costexplorer.MonitorTypeCustom:
+		if v, ok := d.GetOk("monitor_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AnomalyMonitor.MonitorSpecification = expandExpression(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			return diag.Errorf("monitor_specification must be set when monitor_type is %s", monitorType)
+		}
+	}
+
+	if len(tags) > 0 {
+		input.ResourceTags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Monitor: %s", input)
+	output, err := conn.CreateAnomalyMonitorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Cost Explorer Anomaly Monitor (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MonitorArn))
+
+	return resourceAnomalyMonitorRead(ctx, d, meta)
+}
+
+func resourceAnomalyMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	monitor, err := FindAnomalyMonitorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", monitor.MonitorArn)
+	d.Set("monitor_dimension", monitor.MonitorDimension)
+	if monitor.MonitorSpecification != nil {
+		if err := d.Set("monitor_specification", []interface{}{flattenExpression(monitor.MonitorSpecification)}); err != nil {
+			return diag.Errorf("error setting monitor_specification: %s", err)
+		}
+	} else {
+		d.Set("monitor_specification", nil)
+	}
+	d.Set("monitor_type", monitor.MonitorType)
+	d.Set("name", monitor.MonitorName)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAnomalyMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	if d.HasChange("name") {
+		input := &costexplorer.UpdateAnomalyMonitorInput{
+			MonitorArn:  aws.String(d.Id()),
+			MonitorName: aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Cost Explorer Anomaly Monitor: %s", input)
+		_, err := conn.UpdateAnomalyMonitorWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Cost Explorer Anomaly Monitor (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyMonitorRead(ctx, d, meta)
+}
+
+func resourceAnomalyMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Monitor: %s", d.Id())
+	_, err := conn.DeleteAnomalyMonitorWithContext(ctx, &costexplorer.DeleteAnomalyMonitorInput{
+		MonitorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownMonitorException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Cost Explorer Anomaly Monitor (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package ce_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCEAnomalyMonitor_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalymonitor/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "monitor_dimension", "SERVICE"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", "DIMENSIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceAnomalyMonitor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_name(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorConfig(rName1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName1),
+				),
+			},
+			{
+				Config: testAccAnomalyMonitorConfig(rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_custom(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorCustomConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor_dimension", ""),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification.0.tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification.0.tags.0.key", "CostCenter"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_specification.0.tags.0.values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "monitor_specification.0.tags.0.values.*", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_type", "CUSTOM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalyMonitor_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyMonitorTagsConfig1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyMonitorTagsConfig2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAnomalyMonitorTagsConfig1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyMonitorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyMonitorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Monitor ARN is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		_, err := tfce.FindAnomalyMonitorByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalyMonitorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_monitor" {
+			continue
+		}
+
+		_, err := tfce.FindAnomalyMonitorByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Monitor %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnomalyMonitorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name              = %[1]q
+  monitor_type      = "DIMENSIONAL"
+  monitor_dimension = "SERVICE"
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorCustomConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = "CUSTOM"
+
+  monitor_specification {
+    tags {
+      key           = "CostCenter"
+      match_options = ["EQUALS"]
+      values        = ["10000"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAnomalyMonitorTagsConfig1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name              = %[1]q
+  monitor_type      = "DIMENSIONAL"
+  monitor_dimension = "SERVICE"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAnomalyMonitorTagsConfig2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name              = %[1]q
+  monitor_type      = "DIMENSIONAL"
+  monitor_dimension = "SERVICE"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,303 @@
+package ce
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalySubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalySubscriptionCreate,
+		ReadWithoutTimeout:   resourceAnomalySubscriptionRead,
+		UpdateWithoutTimeout: resourceAnomalySubscriptionUpdate,
+		DeleteWithoutTimeout: resourceAnomalySubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.AnomalySubscriptionFrequency_Values(), false),
+			},
+			"monitor_arn_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"subscriber": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(6, 302),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.SubscriberType_Values(), false),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"threshold_expression": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     expressionSchema(),
+			},
+		},
+	}
+}
+
+func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &costexplorer.CreateAnomalySubscriptionInput{
+		AnomalySubscription: &costexplorer.AnomalySubscription{
+			Frequency:        aws.String(d.Get("frequency").(string)),
+			MonitorArnList:   flex.ExpandStringList(d.Get("monitor_arn_list").([]interface{})),
+			Subscribers:      expandSubscribers(d.Get("subscriber").(*schema.Set).List()),
+			SubscriptionName: aws.String(name),
+		},
+	}
+
+	if v, ok := d.GetOk("account_id"); ok {
+		input.AnomalySubscription.AccountId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AnomalySubscription.ThresholdExpression = expandExpression(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.ResourceTags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Anomaly Subscription: %s", input)
+	output, err := conn.CreateAnomalySubscriptionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Cost Explorer Anomaly Subscription (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SubscriptionArn))
+
+	return resourceAnomalySubscriptionRead(ctx, d, meta)
+}
+
+func resourceAnomalySubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	subscription, err := FindAnomalySubscriptionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Anomaly Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", subscription.AccountId)
+	d.Set("arn", subscription.SubscriptionArn)
+	d.Set("frequency", subscription.Frequency)
+	d.Set("monitor_arn_list", aws.StringValueSlice(subscription.MonitorArnList))
+	d.Set("name", subscription.SubscriptionName)
+	if err := d.Set("subscriber", flattenSubscribers(subscription.Subscribers)); err != nil {
+		return diag.Errorf("error setting subscriber: %s", err)
+	}
+	if subscription.ThresholdExpression != nil {
+		if err := d.Set("threshold_expression", []interface{}{flattenExpression(subscription.ThresholdExpression)}); err != nil {
+			return diag.Errorf("error setting threshold_expression: %s", err)
+		}
+	} else {
+		d.Set("threshold_expression", nil)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAnomalySubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &costexplorer.UpdateAnomalySubscriptionInput{
+			SubscriptionArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("frequency") {
+			input.Frequency = aws.String(d.Get("frequency").(string))
+		}
+
+		if d.HasChange("monitor_arn_list") {
+			input.MonitorArnList = flex.ExpandStringList(d.Get("monitor_arn_list").([]interface{}))
+		}
+
+		if d.HasChange("name") {
+			input.SubscriptionName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("subscriber") {
+			input.Subscribers = expandSubscribers(d.Get("subscriber").(*schema.Set).List())
+		}
+
+		if d.HasChange("threshold_expression") {
+			if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ThresholdExpression = expandExpression(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Cost Explorer Anomaly Subscription: %s", input)
+		_, err := conn.UpdateAnomalySubscriptionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Cost Explorer Anomaly Subscription (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalySubscriptionRead(ctx, d, meta)
+}
+
+func resourceAnomalySubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Anomaly Subscription: %s", d.Id())
+	_, err := conn.DeleteAnomalySubscriptionWithContext(ctx, &costexplorer.DeleteAnomalySubscriptionInput{
+		SubscriptionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeUnknownSubscriptionException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Cost Explorer Anomaly Subscription (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSubscribers(tfList []interface{}) []*costexplorer.Subscriber {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.Subscriber
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.Subscriber{}
+
+		if v, ok := tfMap["address"].(string); ok && v != "" {
+			apiObject.Address = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSubscribers(apiObjects []*costexplorer.Subscriber) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"address": aws.StringValue(apiObject.Address),
+			"type":    aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,373 @@
+package ce_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCEAnomalySubscription_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "DAILY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalysubscription/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_arn_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_arn_list.0", "aws_ce_anomaly_monitor.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address,
+						"type":    "EMAIL",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.dimension.0.key", "ANOMALY_TOTAL_IMPACT_ABSOLUTE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "DAILY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceAnomalySubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_frequency(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "DAILY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "DAILY"),
+				),
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "WEEKLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "WEEKLY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_sns(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionSNSConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "IMMEDIATE"),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subscriber.*.address", "aws_sns_topic.test", "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"type": "SNS",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_anomaly_subscription.test"
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionTagsConfig1(rName, address, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalySubscriptionTagsConfig2(rName, address, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAnomalySubscriptionTagsConfig1(rName, address, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalySubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Subscription ARN is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		_, err := tfce.FindAnomalySubscriptionByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalySubscriptionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_subscription" {
+			continue
+		}
+
+		_, err := tfce.FindAnomalySubscriptionByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Subscription %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnomalySubscriptionBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name              = %[1]q
+  monitor_type      = "DIMENSIONAL"
+  monitor_dimension = "SERVICE"
+}
+`, rName)
+}
+
+func testAccAnomalySubscriptionConfig(rName, address, frequency string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = %[3]q
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+      values        = ["100"]
+    }
+  }
+}
+`, rName, address, frequency))
+}
+
+func testAccAnomalySubscriptionSNSConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_topic_policy" "test" {
+  arn = aws_sns_topic.test.arn
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AWSAnomalyDetectionSNSPublishingPermissions"
+      Effect = "Allow"
+      Principal = {
+        Service = "costalerts.${data.aws_partition.current.dns_suffix}"
+      }
+      Action   = "SNS:Publish"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "IMMEDIATE"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "SNS"
+    address = aws_sns_topic.test.arn
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+      values        = ["100"]
+    }
+  }
+
+  depends_on = [aws_sns_topic_policy.test]
+}
+`, rName))
+}
+
+func testAccAnomalySubscriptionTagsConfig1(rName, address, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "DAILY"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+      values        = ["100"]
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, address, tagKey1, tagValue1))
+}
+
+func testAccAnomalySubscriptionTagsConfig2(rName, address, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = "DAILY"
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    dimension {
+      key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+      match_options = ["GREATER_THAN_OR_EQUAL"]
+      values        = ["100"]
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, address, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,369 @@
+package ce
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceCostAndUsage() *schema.Resource {
+	metricValueSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"amount": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"metric": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"unit": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCostAndUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     expressionSchema(),
+			},
+			"granularity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.Granularity_Values(), false),
+			},
+			"group_by": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.GroupDefinitionType_Values(), false),
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(costAndUsageMetricValues(), false),
+				},
+			},
+			"results_by_time": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"estimated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"group": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric": metricValueSchema(),
+								},
+							},
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total": metricValueSchema(),
+					},
+				},
+			},
+			"time_period": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"total": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCostAndUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	timePeriod := d.Get("time_period").([]interface{})[0].(map[string]interface{})
+	start := timePeriod["start"].(string)
+	end := timePeriod["end"].(string)
+	granularity := d.Get("granularity").(string)
+
+	input := &costexplorer.GetCostAndUsageInput{
+		Granularity: aws.String(granularity),
+		Metrics:     flex.ExpandStringList(d.Get("metrics").([]interface{})),
+		TimePeriod: &costexplorer.DateInterval{
+			End:   aws.String(end),
+			Start: aws.String(start),
+		},
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Filter = expandExpression(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("group_by"); ok && len(v.([]interface{})) > 0 {
+		input.GroupBy = expandGroupDefinitions(v.([]interface{}))
+	}
+
+	results, err := findCostAndUsage(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Cost and Usage: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{start, end, granularity}, ","))
+
+	if err := d.Set("results_by_time", flattenResultsByTime(results)); err != nil {
+		return diag.Errorf("error setting results_by_time: %s", err)
+	}
+
+	if err := d.Set("total", totalMetricAmounts(results)); err != nil {
+		return diag.Errorf("error setting total: %s", err)
+	}
+
+	return nil
+}
+
+func findCostAndUsage(ctx context.Context, conn *costexplorer.CostExplorer, input *costexplorer.GetCostAndUsageInput) ([]*costexplorer.ResultByTime, error) {
+	var output []*costexplorer.ResultByTime
+
+	for {
+		page, err := conn.GetCostAndUsageWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.ResultsByTime {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = page.NextPageToken
+	}
+
+	return output, nil
+}
+
+// costAndUsageMetricValues returns the metric names accepted by GetCostAndUsage.
+// These differ from the costexplorer.Metric enum values.
+func costAndUsageMetricValues() []string {
+	return []string{
+		"AmortizedCost",
+		"BlendedCost",
+		"NetAmortizedCost",
+		"NetUnblendedCost",
+		"NormalizedUsageAmount",
+		"UnblendedCost",
+		"UsageQuantity",
+	}
+}
+
+func expandGroupDefinitions(tfList []interface{}) []*costexplorer.GroupDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.GroupDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &costexplorer.GroupDefinition{
+			Key:  aws.String(tfMap["key"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenResultsByTime(apiObjects []*costexplorer.ResultByTime) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"estimated": aws.BoolValue(apiObject.Estimated),
+			"total":     flattenMetricValues(apiObject.Total),
+		}
+
+		if v := apiObject.TimePeriod; v != nil {
+			tfMap["end"] = aws.StringValue(v.End)
+			tfMap["start"] = aws.StringValue(v.Start)
+		}
+
+		var groups []interface{}
+
+		for _, group := range apiObject.Groups {
+			if group == nil {
+				continue
+			}
+
+			groups = append(groups, map[string]interface{}{
+				"keys":   aws.StringValueSlice(group.Keys),
+				"metric": flattenMetricValues(group.Metrics),
+			})
+		}
+
+		tfMap["group"] = groups
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMetricValues(apiObjects map[string]*costexplorer.MetricValue) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	metrics := make([]string, 0, len(apiObjects))
+
+	for metric := range apiObjects {
+		metrics = append(metrics, metric)
+	}
+
+	sort.Strings(metrics)
+
+	var tfList []interface{}
+
+	for _, metric := range metrics {
+		apiObject := apiObjects[metric]
+
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"amount": aws.StringValue(apiObject.Amount),
+			"metric": metric,
+			"unit":   aws.StringValue(apiObject.Unit),
+		})
+	}
+
+	return tfList
+}
+
+// totalMetricAmounts sums each metric over all time periods.
+// When results are grouped, the per-period totals are empty and the group amounts are summed instead.
+func totalMetricAmounts(apiObjects []*costexplorer.ResultByTime) map[string]string {
+	totals := map[string]float64{}
+
+	add := func(metrics map[string]*costexplorer.MetricValue) {
+		for metric, value := range metrics {
+			if value == nil {
+				continue
+			}
+
+			amount, err := strconv.ParseFloat(aws.StringValue(value.Amount), 64)
+
+			if err != nil {
+				continue
+			}
+
+			totals[metric] += amount
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if len(apiObject.Total) > 0 {
+			add(apiObject.Total)
+
+			continue
+		}
+
+		for _, group := range apiObject.Groups {
+			if group != nil {
+				add(group.Metrics)
+			}
+		}
+	}
+
+	tfMap := make(map[string]string, len(totals))
+
+	for metric, amount := range totals {
+		tfMap[metric] = strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,101 @@
+package ce_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCECostAndUsageDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ce_cost_and_usage.test"
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, -1, 0)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostAndUsageDataSourceConfig(start.Format("2006-01-02"), end.Format("2006-01-02")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.start", start.Format("2006-01-02")),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.end", end.Format("2006-01-02")),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.total.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.0.total.0.metric", "UnblendedCost"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total.UnblendedCost"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCECostAndUsageDataSource_groupBy(t *testing.T) {
+	dataSourceName := "data.aws_ce_cost_and_usage.test"
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, -1, 0)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostAndUsageDataSourceGroupByConfig(start.Format("2006-01-02"), end.Format("2006-01-02")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "results_by_time.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results_by_time.0.group.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCostAndUsageDataSourceConfig(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_cost_and_usage" "test" {
+  granularity = "MONTHLY"
+  metrics     = ["UnblendedCost"]
+
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+}
+`, start, end)
+}
+
+func testAccCostAndUsageDataSourceGroupByConfig(start, end string) string {
+	return fmt.Sprintf(`
+data "aws_ce_cost_and_usage" "test" {
+  granularity = "MONTHLY"
+  metrics     = ["UnblendedCost", "UsageQuantity"]
+
+  time_period {
+    start = %[1]q
+    end   = %[2]q
+  }
+
+  filter {
+    not {
+      dimension {
+        key    = "RECORD_TYPE"
+        values = ["Credit", "Refund"]
+      }
+    }
+  }
+
+  group_by {
+    type = "DIMENSION"
+    key  = "SERVICE"
+  }
+}
+`, start, end)
+}
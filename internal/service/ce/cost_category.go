@@ -0,0 +1,590 @@
+package ce
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCostCategory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCostCategoryCreate,
+		ReadWithoutTimeout:   resourceCostCategoryRead,
+		UpdateWithoutTimeout: resourceCostCategoryUpdate,
+		DeleteWithoutTimeout: resourceCostCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"effective_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(20, 25),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherited_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dimension_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryInheritedValueDimensionName_Values(), false),
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     expressionSchema(),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"rule_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.CostCategoryRuleVersion_Values(), false),
+			},
+			"split_charge_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeMethod_Values(), false),
+						},
+						"parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(costexplorer.CostCategorySplitChargeRuleParameterType_Values(), false),
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+									},
+								},
+							},
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"targets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceCostCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &costexplorer.CreateCostCategoryDefinitionInput{
+		Name:        aws.String(name),
+		Rules:       expandCostCategoryRules(d.Get("rule").([]interface{})),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	if v, ok := d.GetOk("default_value"); ok {
+		input.DefaultValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("effective_start"); ok {
+		input.EffectiveStart = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+		input.SplitChargeRules = expandCostCategorySplitChargeRules(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.ResourceTags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Cost Explorer Cost Category: %s", input)
+	output, err := conn.CreateCostCategoryDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Cost Explorer Cost Category (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CostCategoryArn))
+
+	return resourceCostCategoryRead(ctx, d, meta)
+}
+
+func resourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	costCategory, err := FindCostCategoryByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cost Explorer Cost Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Cost Explorer Cost Category (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", costCategory.CostCategoryArn)
+	d.Set("default_value", costCategory.DefaultValue)
+	d.Set("effective_end", costCategory.EffectiveEnd)
+	d.Set("effective_start", costCategory.EffectiveStart)
+	d.Set("name", costCategory.Name)
+	if err := d.Set("rule", flattenCostCategoryRules(costCategory.Rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+	d.Set("rule_version", costCategory.RuleVersion)
+	if err := d.Set("split_charge_rule", flattenCostCategorySplitChargeRules(costCategory.SplitChargeRules)); err != nil {
+		return diag.Errorf("error setting split_charge_rule: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Cost Explorer Cost Category (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &costexplorer.UpdateCostCategoryDefinitionInput{
+			CostCategoryArn: aws.String(d.Id()),
+			Rules:           expandCostCategoryRules(d.Get("rule").([]interface{})),
+			RuleVersion:     aws.String(d.Get("rule_version").(string)),
+		}
+
+		if v, ok := d.GetOk("default_value"); ok {
+			input.DefaultValue = aws.String(v.(string))
+		}
+
+		if d.HasChange("effective_start") {
+			input.EffectiveStart = aws.String(d.Get("effective_start").(string))
+		}
+
+		if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+			input.SplitChargeRules = expandCostCategorySplitChargeRules(v.(*schema.Set).List())
+		}
+
+		log.Printf("[DEBUG] Updating Cost Explorer Cost Category: %s", input)
+		_, err := conn.UpdateCostCategoryDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Cost Explorer Cost Category (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Cost Explorer Cost Category (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCostCategoryRead(ctx, d, meta)
+}
+
+func resourceCostCategoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CostExplorerConn
+
+	log.Printf("[DEBUG] Deleting Cost Explorer Cost Category: %s", d.Id())
+	_, err := conn.DeleteCostCategoryDefinitionWithContext(ctx, &costexplorer.DeleteCostCategoryDefinitionInput{
+		CostCategoryArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, costexplorer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Cost Explorer Cost Category (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCostCategoryRule(tfMap map[string]interface{}) *costexplorer.CostCategoryRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryRule{}
+
+	if v, ok := tfMap["inherited_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InheritedValue = expandCostCategoryInheritedValueDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Rule = expandExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCostCategoryRules(tfList []interface{}) []*costexplorer.CostCategoryRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategoryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCostCategoryRule(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCostCategoryInheritedValueDimension(tfMap map[string]interface{}) *costexplorer.CostCategoryInheritedValueDimension {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryInheritedValueDimension{}
+
+	if v, ok := tfMap["dimension_key"].(string); ok && v != "" {
+		apiObject.DimensionKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["dimension_name"].(string); ok && v != "" {
+		apiObject.DimensionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCostCategorySplitChargeRule(tfMap map[string]interface{}) *costexplorer.CostCategorySplitChargeRule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategorySplitChargeRule{}
+
+	if v, ok := tfMap["method"].(string); ok && v != "" {
+		apiObject.Method = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Parameters = expandCostCategorySplitChargeRuleParameters(v.List())
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["targets"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Targets = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandCostCategorySplitChargeRules(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategorySplitChargeRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCostCategorySplitChargeRule(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCostCategorySplitChargeRuleParameters(tfList []interface{}) []*costexplorer.CostCategorySplitChargeRuleParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &costexplorer.CostCategorySplitChargeRuleParameter{}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			apiObject.Type = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Values = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCostCategoryRule(apiObject *costexplorer.CostCategoryRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InheritedValue; v != nil {
+		tfMap["inherited_value"] = []interface{}{flattenCostCategoryInheritedValueDimension(v)}
+	}
+
+	if v := apiObject.Rule; v != nil {
+		tfMap["rule"] = []interface{}{flattenExpression(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCostCategoryRules(apiObjects []*costexplorer.CostCategoryRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCostCategoryRule(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCostCategoryInheritedValueDimension(apiObject *costexplorer.CostCategoryInheritedValueDimension) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DimensionKey; v != nil {
+		tfMap["dimension_key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DimensionName; v != nil {
+		tfMap["dimension_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCostCategorySplitChargeRule(apiObject *costexplorer.CostCategorySplitChargeRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Method; v != nil {
+		tfMap["method"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Parameters; v != nil {
+		tfMap["parameter"] = flattenCostCategorySplitChargeRuleParameters(v)
+	}
+
+	if v := apiObject.Source; v != nil {
+		tfMap["source"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Targets; v != nil {
+		tfMap["targets"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCostCategorySplitChargeRules(apiObjects []*costexplorer.CostCategorySplitChargeRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCostCategorySplitChargeRule(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCostCategorySplitChargeRuleParameters(apiObjects []*costexplorer.CostCategorySplitChargeRuleParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"type":   aws.StringValue(apiObject.Type),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
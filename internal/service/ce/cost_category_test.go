@@ -0,0 +1,357 @@
+package ce_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCECostCategory_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`costcategory/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_value", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "effective_start"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.value", "production"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.dimension.0.key", "LINKED_ACCOUNT_NAME"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.type", "REGULAR"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.value", "staging"),
+					resource.TestCheckResourceAttr(resourceName, "rule_version", "CostCategoryExpression.v1"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCECostCategory_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceCostCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCECostCategory_complete(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccCostCategoryCompleteConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "other"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule.0.or.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.type", "INHERITED_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.inherited_value.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.inherited_value.0.dimension_name", "TAG"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.inherited_value.0.dimension_key", "CostCenter"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "split_charge_rule.*", map[string]string{
+						"method":    "PROPORTIONAL",
+						"source":    "shared",
+						"targets.#": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCECostCategory_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ce_cost_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCostCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryTagsConfig1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCostCategoryTagsConfig2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCostCategoryTagsConfig1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCostCategoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCostCategoryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Cost Category ARN is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		_, err := tfce.FindCostCategoryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCostCategoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_cost_category" {
+			continue
+		}
+
+		_, err := tfce.FindCostCategoryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Cost Category %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCostCategoryConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  rule {
+    value = "staging"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCostCategoryCompleteConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  rule_version  = "CostCategoryExpression.v1"
+  default_value = "other"
+
+  rule {
+    value = "production"
+
+    rule {
+      or {
+        dimension {
+          key           = "LINKED_ACCOUNT_NAME"
+          values        = ["-prod"]
+          match_options = ["ENDS_WITH"]
+        }
+      }
+
+      or {
+        tags {
+          key           = "Environment"
+          values        = ["production"]
+          match_options = ["EQUALS"]
+        }
+      }
+    }
+  }
+
+  rule {
+    value = "shared"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-shared"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  rule {
+    type = "INHERITED_VALUE"
+
+    inherited_value {
+      dimension_name = "TAG"
+      dimension_key  = "CostCenter"
+    }
+  }
+
+  split_charge_rule {
+    method  = "PROPORTIONAL"
+    source  = "shared"
+    targets = ["production", "other"]
+  }
+}
+`, rName)
+}
+
+func testAccCostCategoryTagsConfig1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCostCategoryTagsConfig2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name         = %[1]q
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,358 @@
+package ce
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// expressionSchema returns the schema of a Cost Explorer expression.
+// Expressions are recursive in the API; here the logical operators
+// (and, or, not) accept one level of nested, non-logical expressions.
+func expressionSchema() *schema.Resource {
+	s := expressionElemSchema()
+
+	nested := &schema.Resource{
+		Schema: expressionElemSchema(),
+	}
+
+	s["and"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     nested,
+	}
+	s["not"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     nested,
+	}
+	s["or"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     nested,
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+func expressionElemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cost_category": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 50),
+					},
+					"match_options": matchOptionsSchema(),
+					"values":        valuesSchema(),
+				},
+			},
+		},
+		"dimension": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(costexplorer.Dimension_Values(), false),
+					},
+					"match_options": matchOptionsSchema(),
+					"values":        valuesSchema(),
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"match_options": matchOptionsSchema(),
+					"values":        valuesSchema(),
+				},
+			},
+		},
+	}
+}
+
+func matchOptionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(costexplorer.MatchOption_Values(), false),
+		},
+	}
+}
+
+func valuesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func expandExpression(tfMap map[string]interface{}) *costexplorer.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.Expression{}
+
+	if v, ok := tfMap["and"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.And = expandExpressions(v.List())
+	}
+
+	if v, ok := tfMap["cost_category"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CostCategories = expandCostCategoryValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Dimensions = expandDimensionValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Or = expandExpressions(v.List())
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Tags = expandTagValues(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandExpressions(tfList []interface{}) []*costexplorer.Expression {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*costexplorer.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandExpression(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCostCategoryValues(tfMap map[string]interface{}) *costexplorer.CostCategoryValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.CostCategoryValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandDimensionValues(tfMap map[string]interface{}) *costexplorer.DimensionValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.DimensionValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandTagValues(tfMap map[string]interface{}) *costexplorer.TagValues {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &costexplorer.TagValues{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MatchOptions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenExpression(apiObject *costexplorer.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = flattenExpressions(v)
+	}
+
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_category"] = []interface{}{flattenCostCategoryValues(v)}
+	}
+
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimension"] = []interface{}{flattenDimensionValues(v)}
+	}
+
+	if v := apiObject.Not; v != nil {
+		tfMap["not"] = []interface{}{flattenExpression(v)}
+	}
+
+	if v := apiObject.Or; v != nil {
+		tfMap["or"] = flattenExpressions(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tfMap["tags"] = []interface{}{flattenTagValues(v)}
+	}
+
+	return tfMap
+}
+
+func flattenExpressions(apiObjects []*costexplorer.Expression) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenExpression(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCostCategoryValues(apiObject *costexplorer.CostCategoryValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MatchOptions; v != nil {
+		tfMap["match_options"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenDimensionValues(apiObject *costexplorer.DimensionValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MatchOptions; v != nil {
+		tfMap["match_options"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenTagValues(apiObject *costexplorer.TagValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Key; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MatchOptions; v != nil {
+		tfMap["match_options"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Values; v != nil {
+		tfMap["values"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
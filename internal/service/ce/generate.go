@@ -0,0 +1,5 @@
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceArn -ListTagsOutTagsElem=ResourceTags -ServiceTagsSlice -TagInIDElem=ResourceArn -TagInTagsElem=ResourceTags -TagType=ResourceTag -UntagInTagsElem=ResourceTagKeys -UpdateTags
+
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package ce
@@ -0,0 +1,193 @@
+//go:build sweep
+// +build sweep
+
+package ce
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ce_anomaly_monitor", &resource.Sweeper{
+		Name: "aws_ce_anomaly_monitor",
+		F:    sweepAnomalyMonitors,
+		Dependencies: []string{
+			"aws_ce_anomaly_subscription",
+		},
+	})
+
+	resource.AddTestSweepers("aws_ce_anomaly_subscription", &resource.Sweeper{
+		Name: "aws_ce_anomaly_subscription",
+		F:    sweepAnomalySubscriptions,
+	})
+
+	resource.AddTestSweepers("aws_ce_cost_category", &resource.Sweeper{
+		Name: "aws_ce_cost_category",
+		F:    sweepCostCategories,
+	})
+}
+
+func sweepAnomalyMonitors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).CostExplorerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &costexplorer.GetAnomalyMonitorsInput{}
+
+	for {
+		output, err := conn.GetAnomalyMonitorsWithContext(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Cost Explorer Anomaly Monitors sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Cost Explorer Anomaly Monitors (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range output.AnomalyMonitors {
+			// Only sweep monitors created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.MonitorName), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceAnomalyMonitor()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MonitorArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = output.NextPageToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Cost Explorer Anomaly Monitors (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepAnomalySubscriptions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).CostExplorerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &costexplorer.GetAnomalySubscriptionsInput{}
+
+	for {
+		output, err := conn.GetAnomalySubscriptionsWithContext(context.Background(), input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Cost Explorer Anomaly Subscriptions sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Cost Explorer Anomaly Subscriptions (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range output.AnomalySubscriptions {
+			// Only sweep subscriptions created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.SubscriptionName), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceAnomalySubscription()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SubscriptionArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.NextPageToken = output.NextPageToken
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Cost Explorer Anomaly Subscriptions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepCostCategories(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).CostExplorerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCostCategoryDefinitionsPagesWithContext(context.Background(), &costexplorer.ListCostCategoryDefinitionsInput{}, func(page *costexplorer.ListCostCategoryDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CostCategoryReferences {
+			// Only sweep cost categories created by acceptance tests.
+			if !strings.HasPrefix(aws.StringValue(v.Name), "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceCostCategory()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CostCategoryArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Cost Explorer Cost Categories sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Cost Explorer Cost Categories (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cost Explorer Cost Categories (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,506 @@
+package comprehend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDocumentClassifier() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDocumentClassifierCreate,
+		ReadWithoutTimeout:   resourceDocumentClassifierRead,
+		UpdateWithoutTimeout: resourceDocumentClassifierUpdate,
+		DeleteWithoutTimeout: resourceDocumentClassifierDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffModelVersion(
+				"data_access_role_arn",
+				"input_data_config",
+				"language_code",
+				"mode",
+				"model_kms_key_id",
+				"output_data_config",
+				"version_name",
+				"version_name_prefix",
+				"volume_kms_key_id",
+				"vpc_config",
+			),
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"augmented_manifests": augmentedManifestsSchema(),
+						"data_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      comprehend.DocumentClassifierDataFormatComprehendCsv,
+							ValidateFunc: validation.StringInSlice(comprehend.DocumentClassifierDataFormat_Values(), false),
+						},
+						"label_delimiter": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1),
+						},
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"test_s3_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(comprehend.LanguageCode_Values(), false),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      comprehend.DocumentClassifierModeMultiClass,
+				ValidateFunc: validation.StringInSlice(comprehend.DocumentClassifierMode_Values(), false),
+			},
+			"model_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validModelName,
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"output_s3_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"retained_version_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_name_prefix"},
+				ValidateFunc:  validModelVersionName,
+			},
+			"version_name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_name"},
+				ValidateFunc:  validModelVersionNamePrefix,
+			},
+			"volume_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_config": vpcConfigSchema(),
+		},
+	}
+}
+
+func resourceDocumentClassifierCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var versionName *string
+
+	if v, ok := d.GetOk("version_name"); ok {
+		versionName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("version_name_prefix"); ok {
+		versionName = aws.String(create.Name("", v.(string)))
+	}
+
+	arn, err := documentClassifierPublishVersion(ctx, d, meta, versionName, d.Timeout(schema.TimeoutCreate))
+
+	if arn != "" {
+		d.SetId(arn)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDocumentClassifierRead(ctx, d, meta)
+}
+
+func resourceDocumentClassifierRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	classifier, err := FindDocumentClassifierByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Document Classifier (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Comprehend Document Classifier (%s): %s", d.Id(), err)
+	}
+
+	name, err := nameFromARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	arn := aws.StringValue(classifier.DocumentClassifierArn)
+	d.Set("arn", arn)
+	d.Set("data_access_role_arn", classifier.DataAccessRoleArn)
+	if err := d.Set("input_data_config", flattenDocumentClassifierInputDataConfig(classifier.InputDataConfig)); err != nil {
+		return diag.Errorf("error setting input_data_config: %s", err)
+	}
+	d.Set("language_code", classifier.LanguageCode)
+	d.Set("mode", classifier.Mode)
+	d.Set("model_kms_key_id", classifier.ModelKmsKeyId)
+	d.Set("name", name)
+	if err := d.Set("output_data_config", flattenDocumentClassifierOutputDataConfig(classifier.OutputDataConfig, d.Get("output_data_config").([]interface{}))); err != nil {
+		return diag.Errorf("error setting output_data_config: %s", err)
+	}
+	d.Set("version_name", classifier.VersionName)
+	d.Set("version_name_prefix", create.NamePrefixFromName(aws.StringValue(classifier.VersionName)))
+	d.Set("volume_kms_key_id", classifier.VolumeKmsKeyId)
+	if err := d.Set("vpc_config", flattenVPCConfig(classifier.VpcConfig)); err != nil {
+		return diag.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Comprehend Document Classifier (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDocumentClassifierUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		oldARN := d.Id()
+		versionName := aws.String(create.Name(d.Get("version_name").(string), d.Get("version_name_prefix").(string)))
+
+		arn, err := documentClassifierPublishVersion(ctx, d, meta, versionName, d.Timeout(schema.TimeoutUpdate))
+
+		if arn != "" {
+			d.SetId(arn)
+		}
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The previous version is retired once its replacement has trained.
+		// A version still in use, e.g. by an endpoint, is retained and deleted with the resource.
+		if err := documentClassifierDeleteVersion(ctx, conn, oldARN, d.Timeout(schema.TimeoutUpdate)); tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceInUseException) {
+			log.Printf("[WARN] Comprehend Document Classifier version (%s) is in use and was not deleted: %s", oldARN, err)
+
+			retained := d.Get("retained_version_arns").(*schema.Set)
+			retained.Add(oldARN)
+			d.Set("retained_version_arns", retained)
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Comprehend Document Classifier (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDocumentClassifierRead(ctx, d, meta)
+}
+
+func resourceDocumentClassifierDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	// Only the current version and any versions retained by updates are deleted.
+	// Other versions sharing the model name were not created by this resource.
+	arns := []string{d.Id()}
+
+	if v, ok := d.GetOk("retained_version_arns"); ok {
+		arns = append(arns, aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))...)
+	}
+
+	for _, arn := range arns {
+		if err := documentClassifierDeleteVersion(ctx, conn, arn, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func documentClassifierPublishVersion(ctx context.Context, d *schema.ResourceData, meta interface{}, versionName *string, timeout time.Duration) (string, error) {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateDocumentClassifierInput{
+		DataAccessRoleArn:      aws.String(d.Get("data_access_role_arn").(string)),
+		DocumentClassifierName: aws.String(name),
+		InputDataConfig:        expandDocumentClassifierInputDataConfig(d.Get("input_data_config").([]interface{})),
+		LanguageCode:           aws.String(d.Get("language_code").(string)),
+		Mode:                   aws.String(d.Get("mode").(string)),
+		VersionName:            versionName,
+	}
+
+	if v, ok := d.GetOk("model_kms_key_id"); ok {
+		input.ModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_data_config"); ok {
+		input.OutputDataConfig = expandDocumentClassifierOutputDataConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("volume_kms_key_id"); ok {
+		input.VolumeKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Comprehend Document Classifier: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateDocumentClassifierWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, comprehend.ErrCodeInvalidRequestException, "Access denied") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("error creating Comprehend Document Classifier (%s): %w", name, err)
+	}
+
+	arn := aws.StringValue(outputRaw.(*comprehend.CreateDocumentClassifierOutput).DocumentClassifierArn)
+
+	if _, err := waitDocumentClassifierTrained(ctx, conn, arn, timeout); err != nil {
+		return arn, fmt.Errorf("error waiting for Comprehend Document Classifier (%s) training: %w", arn, err)
+	}
+
+	return arn, nil
+}
+
+func documentClassifierDeleteVersion(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) error {
+	classifier, err := FindDocumentClassifierByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Comprehend Document Classifier (%s): %w", arn, err)
+	}
+
+	if isModelTraining(aws.StringValue(classifier.Status)) {
+		log.Printf("[DEBUG] Stopping Comprehend Document Classifier training: %s", arn)
+		_, err := conn.StopTrainingDocumentClassifierWithContext(ctx, &comprehend.StopTrainingDocumentClassifierInput{
+			DocumentClassifierArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping Comprehend Document Classifier (%s) training: %w", arn, err)
+		}
+
+		if _, err := waitDocumentClassifierStopped(ctx, conn, arn, timeout); err != nil {
+			return fmt.Errorf("error waiting for Comprehend Document Classifier (%s) training to stop: %w", arn, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Comprehend Document Classifier: %s", arn)
+	_, err = conn.DeleteDocumentClassifierWithContext(ctx, &comprehend.DeleteDocumentClassifierInput{
+		DocumentClassifierArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Comprehend Document Classifier (%s): %w", arn, err)
+	}
+
+	if _, err := waitDocumentClassifierDeleted(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("error waiting for Comprehend Document Classifier (%s) delete: %w", arn, err)
+	}
+
+	return nil
+}
+
+func expandDocumentClassifierInputDataConfig(tfList []interface{}) *comprehend.DocumentClassifierInputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &comprehend.DocumentClassifierInputDataConfig{}
+
+	if v, ok := tfMap["augmented_manifests"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AugmentedManifests = expandAugmentedManifests(v.List())
+	}
+
+	if v, ok := tfMap["data_format"].(string); ok && v != "" {
+		apiObject.DataFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["label_delimiter"].(string); ok && v != "" {
+		apiObject.LabelDelimiter = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_uri"].(string); ok && v != "" {
+		apiObject.S3Uri = aws.String(v)
+	}
+
+	if v, ok := tfMap["test_s3_uri"].(string); ok && v != "" {
+		apiObject.TestS3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDocumentClassifierOutputDataConfig(tfList []interface{}) *comprehend.DocumentClassifierOutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &comprehend.DocumentClassifierOutputDataConfig{
+		S3Uri: aws.String(tfMap["s3_uri"].(string)),
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDocumentClassifierInputDataConfig(apiObject *comprehend.DocumentClassifierInputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"augmented_manifests": flattenAugmentedManifests(apiObject.AugmentedManifests),
+		"data_format":         aws.StringValue(apiObject.DataFormat),
+		"label_delimiter":     aws.StringValue(apiObject.LabelDelimiter),
+		"s3_uri":              aws.StringValue(apiObject.S3Uri),
+		"test_s3_uri":         aws.StringValue(apiObject.TestS3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+// flattenDocumentClassifierOutputDataConfig keeps the configured S3 location, as
+// the API returns the full URI of the training output under it.
+func flattenDocumentClassifierOutputDataConfig(apiObject *comprehend.DocumentClassifierOutputDataConfig, configured []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id":    aws.StringValue(apiObject.KmsKeyId),
+		"output_s3_uri": aws.StringValue(apiObject.S3Uri),
+		"s3_uri":        aws.StringValue(apiObject.S3Uri),
+	}
+
+	if len(configured) > 0 && configured[0] != nil {
+		if v, ok := configured[0].(map[string]interface{})["s3_uri"].(string); ok && v != "" {
+			tfMap["s3_uri"] = v
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,430 @@
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccComprehendDocumentClassifier_basic(t *testing.T) {
+	var v comprehend.DocumentClassifierProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`document-classifier/%s$`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.data_format", comprehend.DocumentClassifierDataFormatComprehendCsv),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "mode", comprehend.DocumentClassifierModeMultiClass),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendDocumentClassifier_disappears(t *testing.T) {
+	var v comprehend.DocumentClassifierProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcomprehend.ResourceDocumentClassifier(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendDocumentClassifier_versionName(t *testing.T) {
+	var v1, v2 comprehend.DocumentClassifierProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierVersionNameConfig(rName, "v1", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`document-classifier/%s/version/v1$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name_prefix", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccDocumentClassifierVersionNameConfig(rName, "v1", "b"),
+				ExpectError: regexp.MustCompile(`version_name must be changed`),
+			},
+			{
+				Config: testAccDocumentClassifierVersionNameConfig(rName, "v2", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v2),
+					testAccCheckDocumentClassifierVersionRetired(&v1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`document-classifier/%s/version/v2$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComprehendDocumentClassifier_versionNamePrefix(t *testing.T) {
+	var v comprehend.DocumentClassifierProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierVersionNamePrefixConfig(rName, "tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v),
+					create.TestCheckResourceAttrNameFromPrefix(resourceName, "version_name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "version_name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendDocumentClassifier_tags(t *testing.T) {
+	var v comprehend.DocumentClassifierProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_document_classifier.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDocumentClassifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentClassifierTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDocumentClassifierTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDocumentClassifierTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentClassifierExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDocumentClassifierExists(n string, v *comprehend.DocumentClassifierProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Comprehend Document Classifier ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+		output, err := tfcomprehend.FindDocumentClassifierByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDocumentClassifierVersionRetired(v *comprehend.DocumentClassifierProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+		arn := aws.StringValue(v.DocumentClassifierArn)
+
+		_, err := tfcomprehend.FindDocumentClassifierByARN(context.Background(), conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Comprehend Document Classifier version %s still exists", arn)
+	}
+}
+
+func testAccCheckDocumentClassifierDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_comprehend_document_classifier" {
+			continue
+		}
+
+		_, err := tfcomprehend.FindDocumentClassifierByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Comprehend Document Classifier %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+	input := &comprehend.ListDocumentClassifiersInput{}
+
+	_, err := conn.ListDocumentClassifiers(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccModelConfigBase creates the bucket and role used to train custom models.
+func testAccModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "comprehend.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:PutObject",
+      ]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      }, {
+      Action   = "s3:ListBucket"
+      Effect   = "Allow"
+      Resource = aws_s3_bucket.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDocumentClassifierConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfigBase(rName), `
+resource "aws_s3_object" "documents" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "documents.csv"
+  source = "test-fixtures/document_classifier/documents.csv"
+}
+`)
+}
+
+func testAccDocumentClassifierConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDocumentClassifierVersionNameConfig(rName, versionName, outputPrefix string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+  version_name         = %[2]q
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+  }
+
+  output_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.bucket}/outputs/%[3]s"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, versionName, outputPrefix))
+}
+
+func testAccDocumentClassifierVersionNamePrefixConfig(rName, versionNamePrefix string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+  version_name_prefix  = %[2]q
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, versionNamePrefix))
+}
+
+func testAccDocumentClassifierTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDocumentClassifierTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_document_classifier" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,241 @@
+package comprehend
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEndpointCreate,
+		ReadWithoutTimeout:   resourceEndpointRead,
+		UpdateWithoutTimeout: resourceEndpointUpdate,
+		DeleteWithoutTimeout: resourceEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_inference_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"desired_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "must contain only alphanumeric characters and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateEndpointInput{
+		DesiredInferenceUnits: aws.Int64(int64(d.Get("desired_inference_units").(int))),
+		EndpointName:          aws.String(name),
+		ModelArn:              aws.String(d.Get("model_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("data_access_role_arn"); ok {
+		input.DataAccessRoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Comprehend Endpoint: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateEndpointWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, comprehend.ErrCodeInvalidRequestException, "Access denied") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Comprehend Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*comprehend.CreateEndpointOutput).EndpointArn))
+
+	if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Comprehend Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	endpoint, err := FindEndpointByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	name, err := nameFromARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	arn := aws.StringValue(endpoint.EndpointArn)
+	d.Set("arn", arn)
+	d.Set("current_inference_units", endpoint.CurrentInferenceUnits)
+	d.Set("data_access_role_arn", endpoint.DataAccessRoleArn)
+	d.Set("desired_inference_units", endpoint.DesiredInferenceUnits)
+	d.Set("model_arn", endpoint.ModelArn)
+	d.Set("name", name)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Comprehend Endpoint (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &comprehend.UpdateEndpointInput{
+			EndpointArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			input.DesiredDataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("desired_inference_units") {
+			input.DesiredInferenceUnits = aws.Int64(int64(d.Get("desired_inference_units").(int)))
+		}
+
+		if d.HasChange("model_arn") {
+			input.DesiredModelArn = aws.String(d.Get("model_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Comprehend Endpoint: %s", input)
+		_, err := conn.UpdateEndpointWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Comprehend Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Comprehend Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Comprehend Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	log.Printf("[DEBUG] Deleting Comprehend Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpointWithContext(ctx, &comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Comprehend Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccComprehendEndpoint_basic(t *testing.T) {
+	var v comprehend.EndpointProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`document-classifier-endpoint/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "current_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_access_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "desired_inference_units", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "model_arn", "aws_comprehend_document_classifier.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "current_inference_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "desired_inference_units", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComprehendEndpoint_disappears(t *testing.T) {
+	var v comprehend.EndpointProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcomprehend.ResourceEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendEndpoint_tags(t *testing.T) {
+	var v comprehend.EndpointProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEndpointTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEndpointExists(n string, v *comprehend.EndpointProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Comprehend Endpoint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+		output, err := tfcomprehend.FindEndpointByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_comprehend_endpoint" {
+			continue
+		}
+
+		_, err := tfcomprehend.FindEndpointByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Comprehend Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEndpointConfig(rName string, desiredInferenceUnits int) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfig(rName), fmt.Sprintf(`
+resource "aws_comprehend_endpoint" "test" {
+  name                    = %[1]q
+  model_arn               = aws_comprehend_document_classifier.test.arn
+  desired_inference_units = %[2]d
+}
+`, rName, desiredInferenceUnits))
+}
+
+func testAccEndpointTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfig(rName), fmt.Sprintf(`
+resource "aws_comprehend_endpoint" "test" {
+  name                    = %[1]q
+  model_arn               = aws_comprehend_document_classifier.test.arn
+  desired_inference_units = 1
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccEndpointTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfig(rName), fmt.Sprintf(`
+resource "aws_comprehend_endpoint" "test" {
+  name                    = %[1]q
+  model_arn               = aws_comprehend_document_classifier.test.arn
+  desired_inference_units = 1
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
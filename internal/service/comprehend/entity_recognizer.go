@@ -0,0 +1,544 @@
+package comprehend
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEntityRecognizer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEntityRecognizerCreate,
+		ReadWithoutTimeout:   resourceEntityRecognizerRead,
+		UpdateWithoutTimeout: resourceEntityRecognizerUpdate,
+		DeleteWithoutTimeout: resourceEntityRecognizerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffModelVersion(
+				"data_access_role_arn",
+				"input_data_config",
+				"language_code",
+				"model_kms_key_id",
+				"version_name",
+				"version_name_prefix",
+				"volume_kms_key_id",
+				"vpc_config",
+			),
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"annotations": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"test_s3_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"augmented_manifests": augmentedManifestsSchema(),
+						"data_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      comprehend.EntityRecognizerDataFormatComprehendCsv,
+							ValidateFunc: validation.StringInSlice(comprehend.EntityRecognizerDataFormat_Values(), false),
+						},
+						"documents": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_format": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      comprehend.InputFormatOneDocPerLine,
+										ValidateFunc: validation.StringInSlice(comprehend.InputFormat_Values(), false),
+									},
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"test_s3_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"entity_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"entity_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 64),
+											validation.StringDoesNotContainAny("\n\r\t,"),
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(comprehend.LanguageCode_Values(), false),
+			},
+			"model_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validModelName,
+			},
+			"retained_version_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_name_prefix"},
+				ValidateFunc:  validModelVersionName,
+			},
+			"version_name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_name"},
+				ValidateFunc:  validModelVersionNamePrefix,
+			},
+			"volume_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_config": vpcConfigSchema(),
+		},
+	}
+}
+
+func resourceEntityRecognizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var versionName *string
+
+	if v, ok := d.GetOk("version_name"); ok {
+		versionName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("version_name_prefix"); ok {
+		versionName = aws.String(create.Name("", v.(string)))
+	}
+
+	arn, err := entityRecognizerPublishVersion(ctx, d, meta, versionName, d.Timeout(schema.TimeoutCreate))
+
+	if arn != "" {
+		d.SetId(arn)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceEntityRecognizerRead(ctx, d, meta)
+}
+
+func resourceEntityRecognizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	recognizer, err := FindEntityRecognizerByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Entity Recognizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Comprehend Entity Recognizer (%s): %s", d.Id(), err)
+	}
+
+	name, err := nameFromARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	arn := aws.StringValue(recognizer.EntityRecognizerArn)
+	d.Set("arn", arn)
+	d.Set("data_access_role_arn", recognizer.DataAccessRoleArn)
+	if err := d.Set("input_data_config", flattenEntityRecognizerInputDataConfig(recognizer.InputDataConfig)); err != nil {
+		return diag.Errorf("error setting input_data_config: %s", err)
+	}
+	d.Set("language_code", recognizer.LanguageCode)
+	d.Set("model_kms_key_id", recognizer.ModelKmsKeyId)
+	d.Set("name", name)
+	d.Set("version_name", recognizer.VersionName)
+	d.Set("version_name_prefix", create.NamePrefixFromName(aws.StringValue(recognizer.VersionName)))
+	d.Set("volume_kms_key_id", recognizer.VolumeKmsKeyId)
+	if err := d.Set("vpc_config", flattenVPCConfig(recognizer.VpcConfig)); err != nil {
+		return diag.Errorf("error setting vpc_config: %s", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Comprehend Entity Recognizer (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEntityRecognizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		oldARN := d.Id()
+		versionName := aws.String(create.Name(d.Get("version_name").(string), d.Get("version_name_prefix").(string)))
+
+		arn, err := entityRecognizerPublishVersion(ctx, d, meta, versionName, d.Timeout(schema.TimeoutUpdate))
+
+		if arn != "" {
+			d.SetId(arn)
+		}
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The previous version is retired once its replacement has trained.
+		// A version still in use, e.g. by an endpoint, is retained and deleted with the resource.
+		if err := entityRecognizerDeleteVersion(ctx, conn, oldARN, d.Timeout(schema.TimeoutUpdate)); tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceInUseException) {
+			log.Printf("[WARN] Comprehend Entity Recognizer version (%s) is in use and was not deleted: %s", oldARN, err)
+
+			retained := d.Get("retained_version_arns").(*schema.Set)
+			retained.Add(oldARN)
+			d.Set("retained_version_arns", retained)
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Comprehend Entity Recognizer (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEntityRecognizerRead(ctx, d, meta)
+}
+
+func resourceEntityRecognizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+
+	// Only the current version and any versions retained by updates are deleted.
+	// Other versions sharing the model name were not created by this resource.
+	arns := []string{d.Id()}
+
+	if v, ok := d.GetOk("retained_version_arns"); ok {
+		arns = append(arns, aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))...)
+	}
+
+	for _, arn := range arns {
+		if err := entityRecognizerDeleteVersion(ctx, conn, arn, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func entityRecognizerPublishVersion(ctx context.Context, d *schema.ResourceData, meta interface{}, versionName *string, timeout time.Duration) (string, error) {
+	conn := meta.(*conns.AWSClient).ComprehendConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateEntityRecognizerInput{
+		DataAccessRoleArn: aws.String(d.Get("data_access_role_arn").(string)),
+		InputDataConfig:   expandEntityRecognizerInputDataConfig(d.Get("input_data_config").([]interface{})),
+		LanguageCode:      aws.String(d.Get("language_code").(string)),
+		RecognizerName:    aws.String(name),
+		VersionName:       versionName,
+	}
+
+	if v, ok := d.GetOk("model_kms_key_id"); ok {
+		input.ModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("volume_kms_key_id"); ok {
+		input.VolumeKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Comprehend Entity Recognizer: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateEntityRecognizerWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, comprehend.ErrCodeInvalidRequestException, "Access denied") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("error creating Comprehend Entity Recognizer (%s): %w", name, err)
+	}
+
+	arn := aws.StringValue(outputRaw.(*comprehend.CreateEntityRecognizerOutput).EntityRecognizerArn)
+
+	if _, err := waitEntityRecognizerTrained(ctx, conn, arn, timeout); err != nil {
+		return arn, fmt.Errorf("error waiting for Comprehend Entity Recognizer (%s) training: %w", arn, err)
+	}
+
+	return arn, nil
+}
+
+func entityRecognizerDeleteVersion(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) error {
+	recognizer, err := FindEntityRecognizerByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Comprehend Entity Recognizer (%s): %w", arn, err)
+	}
+
+	if isModelTraining(aws.StringValue(recognizer.Status)) {
+		log.Printf("[DEBUG] Stopping Comprehend Entity Recognizer training: %s", arn)
+		_, err := conn.StopTrainingEntityRecognizerWithContext(ctx, &comprehend.StopTrainingEntityRecognizerInput{
+			EntityRecognizerArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping Comprehend Entity Recognizer (%s) training: %w", arn, err)
+		}
+
+		if _, err := waitEntityRecognizerStopped(ctx, conn, arn, timeout); err != nil {
+			return fmt.Errorf("error waiting for Comprehend Entity Recognizer (%s) training to stop: %w", arn, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Comprehend Entity Recognizer: %s", arn)
+	_, err = conn.DeleteEntityRecognizerWithContext(ctx, &comprehend.DeleteEntityRecognizerInput{
+		EntityRecognizerArn: aws.String(arn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Comprehend Entity Recognizer (%s): %w", arn, err)
+	}
+
+	if _, err := waitEntityRecognizerDeleted(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("error waiting for Comprehend Entity Recognizer (%s) delete: %w", arn, err)
+	}
+
+	return nil
+}
+
+func expandEntityRecognizerInputDataConfig(tfList []interface{}) *comprehend.EntityRecognizerInputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &comprehend.EntityRecognizerInputDataConfig{}
+
+	if v, ok := tfMap["annotations"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Annotations = &comprehend.EntityRecognizerAnnotations{
+			S3Uri: aws.String(tfMap["s3_uri"].(string)),
+		}
+
+		if v, ok := tfMap["test_s3_uri"].(string); ok && v != "" {
+			apiObject.Annotations.TestS3Uri = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["augmented_manifests"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AugmentedManifests = expandAugmentedManifests(v.List())
+	}
+
+	if v, ok := tfMap["data_format"].(string); ok && v != "" {
+		apiObject.DataFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["documents"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Documents = &comprehend.EntityRecognizerDocuments{
+			S3Uri: aws.String(tfMap["s3_uri"].(string)),
+		}
+
+		if v, ok := tfMap["input_format"].(string); ok && v != "" {
+			apiObject.Documents.InputFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["test_s3_uri"].(string); ok && v != "" {
+			apiObject.Documents.TestS3Uri = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["entity_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EntityList = &comprehend.EntityRecognizerEntityList{
+			S3Uri: aws.String(v[0].(map[string]interface{})["s3_uri"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["entity_types"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			apiObject.EntityTypes = append(apiObject.EntityTypes, &comprehend.EntityTypesListItem{
+				Type: aws.String(tfMapRaw.(map[string]interface{})["type"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenEntityRecognizerInputDataConfig(apiObject *comprehend.EntityRecognizerInputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"augmented_manifests": flattenAugmentedManifests(apiObject.AugmentedManifests),
+		"data_format":         aws.StringValue(apiObject.DataFormat),
+	}
+
+	if v := apiObject.Annotations; v != nil {
+		tfMap["annotations"] = []interface{}{map[string]interface{}{
+			"s3_uri":      aws.StringValue(v.S3Uri),
+			"test_s3_uri": aws.StringValue(v.TestS3Uri),
+		}}
+	}
+
+	if v := apiObject.Documents; v != nil {
+		tfMap["documents"] = []interface{}{map[string]interface{}{
+			"input_format": aws.StringValue(v.InputFormat),
+			"s3_uri":       aws.StringValue(v.S3Uri),
+			"test_s3_uri":  aws.StringValue(v.TestS3Uri),
+		}}
+	}
+
+	if v := apiObject.EntityList; v != nil {
+		tfMap["entity_list"] = []interface{}{map[string]interface{}{
+			"s3_uri": aws.StringValue(v.S3Uri),
+		}}
+	}
+
+	var entityTypes []interface{}
+
+	for _, v := range apiObject.EntityTypes {
+		if v == nil {
+			continue
+		}
+
+		entityTypes = append(entityTypes, map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		})
+	}
+
+	tfMap["entity_types"] = entityTypes
+
+	return []interface{}{tfMap}
+}
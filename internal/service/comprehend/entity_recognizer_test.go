@@ -0,0 +1,371 @@
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccComprehendEntityRecognizer_basic(t *testing.T) {
+	var v comprehend.EntityRecognizerProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_entity_recognizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEntityRecognizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityRecognizerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`entity-recognizer/%s$`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.data_format", comprehend.EntityRecognizerDataFormatComprehendCsv),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.documents.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.documents.0.input_format", comprehend.InputFormatOneDocPerLine),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.entity_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.entity_types.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "input_data_config.0.entity_types.*", map[string]string{
+						"type": "ENGINEER",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "input_data_config.0.entity_types.*", map[string]string{
+						"type": "MANAGER",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendEntityRecognizer_disappears(t *testing.T) {
+	var v comprehend.EntityRecognizerProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_entity_recognizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEntityRecognizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityRecognizerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcomprehend.ResourceEntityRecognizer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendEntityRecognizer_versionName(t *testing.T) {
+	var v1, v2 comprehend.EntityRecognizerProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_entity_recognizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEntityRecognizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityRecognizerVersionNameConfig(rName, "v1", "ENGINEER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`entity-recognizer/%s/version/v1$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.entity_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityRecognizerVersionNameConfig(rName, "v2", "MANAGER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v2),
+					testAccCheckEntityRecognizerVersionRetired(&v1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "comprehend", regexp.MustCompile(fmt.Sprintf(`entity-recognizer/%s/version/v2$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_name", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComprehendEntityRecognizer_tags(t *testing.T) {
+	var v comprehend.EntityRecognizerProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_entity_recognizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, comprehend.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEntityRecognizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityRecognizerTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityRecognizerTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEntityRecognizerTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityRecognizerExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEntityRecognizerExists(n string, v *comprehend.EntityRecognizerProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Comprehend Entity Recognizer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+		output, err := tfcomprehend.FindEntityRecognizerByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEntityRecognizerVersionRetired(v *comprehend.EntityRecognizerProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+		arn := aws.StringValue(v.EntityRecognizerArn)
+
+		_, err := tfcomprehend.FindEntityRecognizerByARN(context.Background(), conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Comprehend Entity Recognizer version %s still exists", arn)
+	}
+}
+
+func testAccCheckEntityRecognizerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_comprehend_entity_recognizer" {
+			continue
+		}
+
+		_, err := tfcomprehend.FindEntityRecognizerByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Comprehend Entity Recognizer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccEntityRecognizerConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfigBase(rName), `
+resource "aws_s3_object" "documents" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "documents.txt"
+  source = "test-fixtures/entity_recognizer/documents.txt"
+}
+
+resource "aws_s3_object" "entities" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "entitylist.csv"
+  source = "test-fixtures/entity_recognizer/entitylist.csv"
+}
+`)
+}
+
+func testAccEntityRecognizerConfig(rName string) string {
+	return acctest.ConfigCompose(testAccEntityRecognizerConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_entity_recognizer" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    entity_types {
+      type = "ENGINEER"
+    }
+
+    entity_types {
+      type = "MANAGER"
+    }
+
+    documents {
+      s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+    }
+
+    entity_list {
+      s3_uri = "s3://${aws_s3_object.entities.bucket}/${aws_s3_object.entities.key}"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccEntityRecognizerVersionNameConfig(rName, versionName, entityType string) string {
+	return acctest.ConfigCompose(testAccEntityRecognizerConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_entity_recognizer" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+  version_name         = %[2]q
+
+  input_data_config {
+    entity_types {
+      type = %[3]q
+    }
+
+    documents {
+      s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+    }
+
+    entity_list {
+      s3_uri = "s3://${aws_s3_object.entities.bucket}/${aws_s3_object.entities.key}"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, versionName, entityType))
+}
+
+func testAccEntityRecognizerTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccEntityRecognizerConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_entity_recognizer" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    entity_types {
+      type = "ENGINEER"
+    }
+
+    documents {
+      s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+    }
+
+    entity_list {
+      s3_uri = "s3://${aws_s3_object.entities.bucket}/${aws_s3_object.entities.key}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccEntityRecognizerTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccEntityRecognizerConfigBase(rName), fmt.Sprintf(`
+resource "aws_comprehend_entity_recognizer" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  language_code        = "en"
+
+  input_data_config {
+    entity_types {
+      type = "ENGINEER"
+    }
+
+    documents {
+      s3_uri = "s3://${aws_s3_object.documents.bucket}/${aws_s3_object.documents.key}"
+    }
+
+    entity_list {
+      s3_uri = "s3://${aws_s3_object.entities.bucket}/${aws_s3_object.entities.key}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,86 @@
+package comprehend
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDocumentClassifierByARN(ctx context.Context, conn *comprehend.Comprehend, arn string) (*comprehend.DocumentClassifierProperties, error) {
+	input := &comprehend.DescribeDocumentClassifierInput{
+		DocumentClassifierArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDocumentClassifierWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DocumentClassifierProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DocumentClassifierProperties, nil
+}
+
+func FindEntityRecognizerByARN(ctx context.Context, conn *comprehend.Comprehend, arn string) (*comprehend.EntityRecognizerProperties, error) {
+	input := &comprehend.DescribeEntityRecognizerInput{
+		EntityRecognizerArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeEntityRecognizerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EntityRecognizerProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EntityRecognizerProperties, nil
+}
+
+func FindEndpointByARN(ctx context.Context, conn *comprehend.Comprehend, arn string) (*comprehend.EndpointProperties, error) {
+	input := &comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, comprehend.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EndpointProperties, nil
+}
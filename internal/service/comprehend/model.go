@@ -0,0 +1,246 @@
+package comprehend
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// Document classifiers and entity recognizers are both custom models and share
+// their training data, network and versioning configuration.
+
+func augmentedManifestsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"annotation_data_s3_uri": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"attribute_names": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"document_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      comprehend.AugmentedManifestsDocumentTypeFormatPlainTextDocument,
+					ValidateFunc: validation.StringInSlice(comprehend.AugmentedManifestsDocumentTypeFormat_Values(), false),
+				},
+				"s3_uri": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"source_documents_s3_uri": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"split": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      comprehend.SplitTrain,
+					ValidateFunc: validation.StringInSlice(comprehend.Split_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func vpcConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"security_group_ids": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"subnets": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+var validModelName = validation.All(
+	validation.StringLenBetween(1, 63),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "must contain only alphanumeric characters and hyphens"),
+)
+
+var validModelVersionName = validModelName
+
+var validModelVersionNamePrefix = validation.All(
+	validation.StringLenBetween(1, 63-resource.UniqueIDSuffixLength),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*-?$`), "must contain only alphanumeric characters and hyphens"),
+)
+
+// customizeDiffModelVersion marks the ARN as unknown when a change to any of the
+// given attributes will publish a new model version. A new version needs a new
+// name, so an unchanged explicit version_name is rejected and an implicit one is
+// regenerated.
+func customizeDiffModelVersion(keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		var changed bool
+
+		for _, key := range keys {
+			if d.HasChange(key) {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+
+		if err := d.SetNewComputed("arn"); err != nil {
+			return err
+		}
+
+		if d.HasChange("version_name") {
+			return nil
+		}
+
+		if !d.GetRawConfig().GetAttr("version_name").IsNull() {
+			return fmt.Errorf("version_name must be changed when the model configuration changes")
+		}
+
+		return d.SetNewComputed("version_name")
+	}
+}
+
+// nameFromARN returns the name from a model or endpoint ARN, e.g.
+// arn:aws:comprehend:us-west-2:123456789012:document-classifier/name/version/v1.
+func nameFromARN(v string) (string, error) {
+	arn, err := arn.Parse(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(arn.Resource, "/")
+
+	if len(parts) < 2 || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for ARN resource (%s)", arn.Resource)
+	}
+
+	return parts[1], nil
+}
+
+func isModelTraining(status string) bool {
+	return status == comprehend.ModelStatusSubmitted || status == comprehend.ModelStatusTraining
+}
+
+func expandAugmentedManifests(tfList []interface{}) []*comprehend.AugmentedManifestsListItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*comprehend.AugmentedManifestsListItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &comprehend.AugmentedManifestsListItem{
+			AttributeNames: flex.ExpandStringList(tfMap["attribute_names"].([]interface{})),
+			S3Uri:          aws.String(tfMap["s3_uri"].(string)),
+		}
+
+		if v, ok := tfMap["annotation_data_s3_uri"].(string); ok && v != "" {
+			apiObject.AnnotationDataS3Uri = aws.String(v)
+		}
+
+		if v, ok := tfMap["document_type"].(string); ok && v != "" {
+			apiObject.DocumentType = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_documents_s3_uri"].(string); ok && v != "" {
+			apiObject.SourceDocumentsS3Uri = aws.String(v)
+		}
+
+		if v, ok := tfMap["split"].(string); ok && v != "" {
+			apiObject.Split = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVPCConfig(tfList []interface{}) *comprehend.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &comprehend.VpcConfig{
+		SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+		Subnets:          flex.ExpandStringSet(tfMap["subnets"].(*schema.Set)),
+	}
+}
+
+func flattenAugmentedManifests(apiObjects []*comprehend.AugmentedManifestsListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"annotation_data_s3_uri":  aws.StringValue(apiObject.AnnotationDataS3Uri),
+			"attribute_names":         aws.StringValueSlice(apiObject.AttributeNames),
+			"document_type":           aws.StringValue(apiObject.DocumentType),
+			"s3_uri":                  aws.StringValue(apiObject.S3Uri),
+			"source_documents_s3_uri": aws.StringValue(apiObject.SourceDocumentsS3Uri),
+			"split":                   aws.StringValue(apiObject.Split),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVPCConfig(apiObject *comprehend.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flex.FlattenStringSet(apiObject.SecurityGroupIds),
+		"subnets":            flex.FlattenStringSet(apiObject.Subnets),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,203 @@
+//go:build sweep
+// +build sweep
+
+package comprehend
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_comprehend_endpoint", &resource.Sweeper{
+		Name: "aws_comprehend_endpoint",
+		F:    sweepEndpoints,
+	})
+
+	resource.AddTestSweepers("aws_comprehend_document_classifier", &resource.Sweeper{
+		Name: "aws_comprehend_document_classifier",
+		F:    sweepDocumentClassifiers,
+		Dependencies: []string{
+			"aws_comprehend_endpoint",
+		},
+	})
+
+	resource.AddTestSweepers("aws_comprehend_entity_recognizer", &resource.Sweeper{
+		Name: "aws_comprehend_entity_recognizer",
+		F:    sweepEntityRecognizers,
+		Dependencies: []string{
+			"aws_comprehend_endpoint",
+		},
+	})
+}
+
+func sweepEndpoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ComprehendConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListEndpointsPagesWithContext(context.Background(), &comprehend.ListEndpointsInput{}, func(page *comprehend.ListEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EndpointPropertiesList {
+			r := ResourceEndpoint()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.EndpointArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Comprehend Endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Comprehend Endpoints (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Comprehend Endpoints (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDocumentClassifiers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ComprehendConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	names := make(map[string]struct{})
+
+	err = conn.ListDocumentClassifiersPagesWithContext(context.Background(), &comprehend.ListDocumentClassifiersInput{}, func(page *comprehend.ListDocumentClassifiersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DocumentClassifierPropertiesList {
+			arn := aws.StringValue(v.DocumentClassifierArn)
+			name, err := nameFromARN(arn)
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			// Resource deletion removes every version of the model.
+			if _, ok := names[name]; ok {
+				continue
+			}
+
+			names[name] = struct{}{}
+
+			r := ResourceDocumentClassifier()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Comprehend Document Classifier sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Comprehend Document Classifiers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Comprehend Document Classifiers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepEntityRecognizers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ComprehendConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	names := make(map[string]struct{})
+
+	err = conn.ListEntityRecognizersPagesWithContext(context.Background(), &comprehend.ListEntityRecognizersInput{}, func(page *comprehend.ListEntityRecognizersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EntityRecognizerPropertiesList {
+			arn := aws.StringValue(v.EntityRecognizerArn)
+			name, err := nameFromARN(arn)
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			// Resource deletion removes every version of the model.
+			if _, ok := names[name]; ok {
+				continue
+			}
+
+			names[name] = struct{}{}
+
+			r := ResourceEntityRecognizer()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Comprehend Entity Recognizer sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Comprehend Entity Recognizers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Comprehend Entity Recognizers (%s): %w", region, err)
+	}
+
+	return nil
+}
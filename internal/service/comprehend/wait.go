@@ -0,0 +1,172 @@
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/comprehend"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for IAM role changes to propagate to Comprehend
+	propagationTimeout = 2 * time.Minute
+
+	// Training polls are spaced out as models take a long time to train
+	trainingPollInterval = 1 * time.Minute
+)
+
+func waitDocumentClassifierTrained(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.DocumentClassifierProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{comprehend.ModelStatusSubmitted, comprehend.ModelStatusTraining},
+		Target:       []string{comprehend.ModelStatusTrained, comprehend.ModelStatusTrainedWithWarning},
+		Refresh:      statusDocumentClassifier(ctx, conn, arn),
+		Timeout:      timeout,
+		Delay:        trainingPollInterval,
+		PollInterval: trainingPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.DocumentClassifierProperties); ok {
+		if status := aws.StringValue(output.Status); status == comprehend.ModelStatusInError {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDocumentClassifierStopped(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.DocumentClassifierProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.ModelStatusSubmitted, comprehend.ModelStatusTraining, comprehend.ModelStatusStopRequested},
+		Target:  []string{comprehend.ModelStatusStopped, comprehend.ModelStatusTrained, comprehend.ModelStatusTrainedWithWarning, comprehend.ModelStatusInError},
+		Refresh: statusDocumentClassifier(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.DocumentClassifierProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDocumentClassifierDeleted(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.DocumentClassifierProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.ModelStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDocumentClassifier(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.DocumentClassifierProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEntityRecognizerTrained(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EntityRecognizerProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{comprehend.ModelStatusSubmitted, comprehend.ModelStatusTraining},
+		Target:       []string{comprehend.ModelStatusTrained, comprehend.ModelStatusTrainedWithWarning},
+		Refresh:      statusEntityRecognizer(ctx, conn, arn),
+		Timeout:      timeout,
+		Delay:        trainingPollInterval,
+		PollInterval: trainingPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.EntityRecognizerProperties); ok {
+		if status := aws.StringValue(output.Status); status == comprehend.ModelStatusInError {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEntityRecognizerStopped(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EntityRecognizerProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.ModelStatusSubmitted, comprehend.ModelStatusTraining, comprehend.ModelStatusStopRequested},
+		Target:  []string{comprehend.ModelStatusStopped, comprehend.ModelStatusTrained, comprehend.ModelStatusTrainedWithWarning, comprehend.ModelStatusInError},
+		Refresh: statusEntityRecognizer(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.EntityRecognizerProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEntityRecognizerDeleted(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EntityRecognizerProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.ModelStatusDeleting},
+		Target:  []string{},
+		Refresh: statusEntityRecognizer(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.EntityRecognizerProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointInService(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.EndpointStatusCreating, comprehend.EndpointStatusUpdating},
+		Target:  []string{comprehend.EndpointStatusInService},
+		Refresh: statusEndpoint(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.EndpointProperties); ok {
+		if status := aws.StringValue(output.Status); status == comprehend.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *comprehend.Comprehend, arn string, timeout time.Duration) (*comprehend.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{comprehend.EndpointStatusDeleting},
+		Target:  []string{},
+		Refresh: statusEndpoint(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*comprehend.EndpointProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
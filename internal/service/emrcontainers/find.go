@@ -0,0 +1,86 @@
+package emrcontainers
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindVirtualCluster(ctx context.Context, conn *emrcontainers.EMRContainers, input *emrcontainers.DescribeVirtualClusterInput) (*emrcontainers.VirtualCluster, error) {
+	output, err := conn.DescribeVirtualClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VirtualCluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VirtualCluster, nil
+}
+
+func FindVirtualClusterByID(ctx context.Context, conn *emrcontainers.EMRContainers, id string) (*emrcontainers.VirtualCluster, error) {
+	input := &emrcontainers.DescribeVirtualClusterInput{
+		Id: aws.String(id),
+	}
+
+	output, err := FindVirtualCluster(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == emrcontainers.VirtualClusterStateTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindManagedEndpointByTwoPartKey(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string) (*emrcontainers.Endpoint, error) {
+	input := &emrcontainers.DescribeManagedEndpointInput{
+		Id:               aws.String(endpointID),
+		VirtualClusterId: aws.String(virtualClusterID),
+	}
+
+	output, err := conn.DescribeManagedEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Endpoint == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.Endpoint.State); state == emrcontainers.EndpointStateTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.Endpoint, nil
+}
@@ -0,0 +1,25 @@
+package emrcontainers
+
+import (
+	"fmt"
+	"strings"
+)
+
+const managedEndpointResourceIDSeparator = "/"
+
+func ManagedEndpointCreateResourceID(virtualClusterID, endpointID string) string {
+	parts := []string{virtualClusterID, endpointID}
+	id := strings.Join(parts, managedEndpointResourceIDSeparator)
+
+	return id
+}
+
+func ManagedEndpointParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, managedEndpointResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected virtual-cluster-id%[2]sendpoint-id", id, managedEndpointResourceIDSeparator)
+}
@@ -0,0 +1,181 @@
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEMRContainersManagedEndpoint_basic(t *testing.T) {
+	var v emrcontainers.Endpoint
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_managed_endpoint.test"
+	virtualClusterResourceName := "aws_emrcontainers_virtual_cluster.test"
+	clusterName := testAccPreCheckEKSClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckManagedEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedEndpointConfig(rName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedEndpointExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.0.classification", "spark-defaults"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.0.properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_overrides.0.application_configuration.0.properties.spark.driver.memory", "2G"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "release_label", "emr-6.3.0-latest"),
+					resource.TestCheckResourceAttrSet(resourceName, "server_url"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "JUPYTER_ENTERPRISE_GATEWAY"),
+					resource.TestCheckResourceAttrPair(resourceName, "virtual_cluster_id", virtualClusterResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersManagedEndpoint_disappears(t *testing.T) {
+	var v emrcontainers.Endpoint
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_managed_endpoint.test"
+	clusterName := testAccPreCheckEKSClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckManagedEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedEndpointConfig(rName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckManagedEndpointExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfemrcontainers.ResourceManagedEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckManagedEndpointExists(n string, v *emrcontainers.Endpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Managed Endpoint ID is set")
+		}
+
+		virtualClusterID, endpointID, err := tfemrcontainers.ManagedEndpointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+		output, err := tfemrcontainers.FindManagedEndpointByTwoPartKey(context.Background(), conn, virtualClusterID, endpointID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckManagedEndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrcontainers_managed_endpoint" {
+			continue
+		}
+
+		virtualClusterID, endpointID, err := tfemrcontainers.ManagedEndpointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfemrcontainers.FindManagedEndpointByTwoPartKey(context.Background(), conn, virtualClusterID, endpointID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR Containers Managed Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccManagedEndpointConfig(rName, clusterName string) string {
+	return acctest.ConfigCompose(testAccVirtualClusterConfig(rName, clusterName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_emrcontainers_managed_endpoint" "test" {
+  name               = %[1]q
+  virtual_cluster_id = aws_emrcontainers_virtual_cluster.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  release_label      = "emr-6.3.0-latest"
+
+  configuration_overrides {
+    application_configuration {
+      classification = "spark-defaults"
+
+      properties = {
+        "spark.driver.memory" = "2G"
+      }
+    }
+  }
+}
+`, rName))
+}
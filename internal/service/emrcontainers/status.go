@@ -0,0 +1,42 @@
+package emrcontainers
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusVirtualCluster(ctx context.Context, conn *emrcontainers.EMRContainers, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVirtualClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusManagedEndpoint(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindManagedEndpointByTwoPartKey(ctx, conn, virtualClusterID, endpointID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
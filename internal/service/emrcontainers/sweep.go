@@ -0,0 +1,145 @@
+//go:build sweep
+// +build sweep
+
+package emrcontainers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_emrcontainers_managed_endpoint", &resource.Sweeper{
+		Name: "aws_emrcontainers_managed_endpoint",
+		F:    sweepManagedEndpoints,
+	})
+
+	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+		Name: "aws_emrcontainers_virtual_cluster",
+		F:    sweepVirtualClusters,
+		Dependencies: []string{
+			"aws_emrcontainers_managed_endpoint",
+		},
+	})
+}
+
+func sweepManagedEndpoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).EMRContainersConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	input := &emrcontainers.ListVirtualClustersInput{
+		States: aws.StringSlice([]string{emrcontainers.VirtualClusterStateRunning}),
+	}
+
+	err = conn.ListVirtualClustersPagesWithContext(context.Background(), input, func(page *emrcontainers.ListVirtualClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VirtualClusters {
+			virtualClusterID := aws.StringValue(v.Id)
+			input := &emrcontainers.ListManagedEndpointsInput{
+				States:           aws.StringSlice([]string{emrcontainers.EndpointStateActive}),
+				VirtualClusterId: aws.String(virtualClusterID),
+			}
+
+			err := conn.ListManagedEndpointsPagesWithContext(context.Background(), input, func(page *emrcontainers.ListManagedEndpointsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Endpoints {
+					r := ResourceManagedEndpoint()
+					d := r.Data(nil)
+					d.SetId(ManagedEndpointCreateResourceID(virtualClusterID, aws.StringValue(v.Id)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				log.Printf("[WARN] Error listing EMR Containers Managed Endpoints (%s): %s", virtualClusterID, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EMR Containers Managed Endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EMR Containers Virtual Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EMR Containers Managed Endpoints (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepVirtualClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).EMRContainersConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	input := &emrcontainers.ListVirtualClustersInput{
+		States: aws.StringSlice([]string{emrcontainers.VirtualClusterStateRunning}),
+	}
+
+	err = conn.ListVirtualClustersPagesWithContext(context.Background(), input, func(page *emrcontainers.ListVirtualClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VirtualClusters {
+			r := ResourceVirtualCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EMR Containers Virtual Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EMR Containers Virtual Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EMR Containers Virtual Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
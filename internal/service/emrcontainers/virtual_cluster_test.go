@@ -0,0 +1,268 @@
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEMRContainersVirtualCluster_basic(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	clusterName := testAccPreCheckEKSClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterConfig(rName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "emr-containers", regexp.MustCompile(`/virtualclusters/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.id", clusterName),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.type", "EKS"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersVirtualCluster_disappears(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	clusterName := testAccPreCheckEKSClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterConfig(rName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfemrcontainers.ResourceVirtualCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersVirtualCluster_tags(t *testing.T) {
+	var v emrcontainers.VirtualCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+	clusterName := testAccPreCheckEKSClusterName(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterTags1Config(rName, clusterName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVirtualClusterTags2Config(rName, clusterName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVirtualClusterTags1Config(rName, clusterName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualClusterExists(n string, v *emrcontainers.VirtualCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Virtual Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+		output, err := tfemrcontainers.FindVirtualClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVirtualClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrcontainers_virtual_cluster" {
+			continue
+		}
+
+		_, err := tfemrcontainers.FindVirtualClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR Containers Virtual Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+	input := &emrcontainers.ListVirtualClustersInput{}
+
+	_, err := conn.ListVirtualClusters(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccPreCheckEKSClusterName returns the name of an existing EKS cluster that
+// has been enabled for EMR on EKS (namespace RBAC and identity mapping), skipping
+// the test if none has been configured.
+func testAccPreCheckEKSClusterName(t *testing.T) string {
+	v := os.Getenv("AWS_EMRCONTAINERS_EKS_CLUSTER_NAME")
+
+	if v == "" {
+		t.Skip("Environment variable AWS_EMRCONTAINERS_EKS_CLUSTER_NAME is not set")
+	}
+
+	return v
+}
+
+func testAccVirtualClusterConfig(rName, clusterName string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+}
+`, rName, clusterName)
+}
+
+func testAccVirtualClusterTags1Config(rName, clusterName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, clusterName, tagKey1, tagValue1)
+}
+
+func testAccVirtualClusterTags2Config(rName, clusterName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, clusterName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
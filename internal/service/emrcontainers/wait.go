@@ -0,0 +1,71 @@
+package emrcontainers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitVirtualClusterDeleted(ctx context.Context, conn *emrcontainers.EMRContainers, id string, timeout time.Duration) (*emrcontainers.VirtualCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.VirtualClusterStateArrested, emrcontainers.VirtualClusterStateRunning, emrcontainers.VirtualClusterStateTerminating},
+		Target:  []string{},
+		Refresh: statusVirtualCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrcontainers.VirtualCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitManagedEndpointCreated(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string, timeout time.Duration) (*emrcontainers.Endpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.EndpointStateCreating},
+		Target:  []string{emrcontainers.EndpointStateActive},
+		Refresh: statusManagedEndpoint(ctx, conn, virtualClusterID, endpointID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrcontainers.Endpoint); ok {
+		if state := aws.StringValue(output.State); state == emrcontainers.EndpointStateTerminatedWithErrors {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitManagedEndpointDeleted(ctx context.Context, conn *emrcontainers.EMRContainers, virtualClusterID, endpointID string, timeout time.Duration) (*emrcontainers.Endpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrcontainers.EndpointStateActive, emrcontainers.EndpointStateTerminating},
+		Target:  []string{},
+		Refresh: statusManagedEndpoint(ctx, conn, virtualClusterID, endpointID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrcontainers.Endpoint); ok {
+		if state := aws.StringValue(output.State); state == emrcontainers.EndpointStateTerminatedWithErrors {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,690 @@
+package emrserverless
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      emrserverless.ArchitectureX8664,
+				ValidateFunc: validation.StringInSlice(emrserverless.Architecture_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_start_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"auto_stop_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"idle_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      15,
+							ValidateFunc: validation.IntBetween(1, 10080),
+						},
+					},
+				},
+			},
+			"image_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"initial_capacity": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_capacity_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"worker_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"disk": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"memory": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000000),
+									},
+								},
+							},
+						},
+						"initial_capacity_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"maximum_capacity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"disk": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"memory": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"release_label": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrserverless.CreateApplicationInput{
+		Architecture: aws.String(d.Get("architecture").(string)),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Name:         aws.String(name),
+		ReleaseLabel: aws.String(d.Get("release_label").(string)),
+		Type:         aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("auto_start_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoStartConfiguration = expandAutoStartConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("auto_stop_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoStopConfiguration = expandAutoStopConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("image_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ImageConfiguration = expandImageConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("initial_capacity"); ok && v.(*schema.Set).Len() > 0 {
+		input.InitialCapacity = expandInitialCapacity(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("maximum_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating EMR Serverless Application: %s", input)
+	output, err := conn.CreateApplicationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating EMR Serverless Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationId))
+
+	if _, err := waitApplicationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for EMR Serverless Application (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	application, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Serverless Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EMR Serverless Application (%s): %s", d.Id(), err)
+	}
+
+	d.Set("architecture", application.Architecture)
+	d.Set("arn", application.Arn)
+	if err := d.Set("auto_start_configuration", flattenAutoStartConfig(application.AutoStartConfiguration)); err != nil {
+		return diag.Errorf("error setting auto_start_configuration: %s", err)
+	}
+	if err := d.Set("auto_stop_configuration", flattenAutoStopConfig(application.AutoStopConfiguration)); err != nil {
+		return diag.Errorf("error setting auto_stop_configuration: %s", err)
+	}
+	if err := d.Set("image_configuration", flattenImageConfiguration(application.ImageConfiguration)); err != nil {
+		return diag.Errorf("error setting image_configuration: %s", err)
+	}
+	if err := d.Set("initial_capacity", flattenInitialCapacity(application.InitialCapacity)); err != nil {
+		return diag.Errorf("error setting initial_capacity: %s", err)
+	}
+	if err := d.Set("maximum_capacity", flattenMaximumCapacity(application.MaximumCapacity)); err != nil {
+		return diag.Errorf("error setting maximum_capacity: %s", err)
+	}
+	d.Set("name", application.Name)
+	if err := d.Set("network_configuration", flattenNetworkConfiguration(application.NetworkConfiguration)); err != nil {
+		return diag.Errorf("error setting network_configuration: %s", err)
+	}
+	d.Set("release_label", application.ReleaseLabel)
+	d.Set("type", application.Type)
+
+	tags := KeyValueTags(application.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &emrserverless.UpdateApplicationInput{
+			ApplicationId: aws.String(d.Id()),
+			ClientToken:   aws.String(resource.UniqueId()),
+		}
+
+		if d.HasChange("architecture") {
+			input.Architecture = aws.String(d.Get("architecture").(string))
+		}
+
+		if v, ok := d.GetOk("auto_start_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AutoStartConfiguration = expandAutoStartConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("auto_stop_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AutoStopConfiguration = expandAutoStopConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("image_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ImageConfiguration = expandImageConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("initial_capacity"); ok && v.(*schema.Set).Len() > 0 {
+			input.InitialCapacity = expandInitialCapacity(v.(*schema.Set).List())
+		} else if d.HasChange("initial_capacity") {
+			input.InitialCapacity = map[string]*emrserverless.InitialCapacityConfig{}
+		}
+
+		if v, ok := d.GetOk("maximum_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("network_configuration") {
+			input.NetworkConfiguration = &emrserverless.NetworkConfiguration{}
+		}
+
+		if d.HasChange("release_label") {
+			input.ReleaseLabel = aws.String(d.Get("release_label").(string))
+		}
+
+		log.Printf("[DEBUG] Updating EMR Serverless Application: %s", input)
+		_, err := conn.UpdateApplicationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating EMR Serverless Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating EMR Serverless Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceApplicationRead(ctx, d, meta)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRServerlessConn
+
+	// Applications must be stopped before they can be deleted.
+	application, err := FindApplicationByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EMR Serverless Application (%s): %s", d.Id(), err)
+	}
+
+	switch aws.StringValue(application.State) {
+	case emrserverless.ApplicationStateStarting, emrserverless.ApplicationStateStarted:
+		log.Printf("[DEBUG] Stopping EMR Serverless Application: %s", d.Id())
+		_, err := conn.StopApplicationWithContext(ctx, &emrserverless.StopApplicationInput{
+			ApplicationId: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, emrserverless.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("error stopping EMR Serverless Application (%s): %s", d.Id(), err)
+		}
+
+		fallthrough
+	case emrserverless.ApplicationStateStopping:
+		if _, err := waitApplicationStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for EMR Serverless Application (%s) stop: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting EMR Serverless Application: %s", d.Id())
+	_, err = conn.DeleteApplicationWithContext(ctx, &emrserverless.DeleteApplicationInput{
+		ApplicationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrserverless.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting EMR Serverless Application (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for EMR Serverless Application (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAutoStartConfig(tfMap map[string]interface{}) *emrserverless.AutoStartConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.AutoStartConfig{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandAutoStopConfig(tfMap map[string]interface{}) *emrserverless.AutoStopConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.AutoStopConfig{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["idle_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.IdleTimeoutMinutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandImageConfiguration(tfMap map[string]interface{}) *emrserverless.ImageConfigurationInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.ImageConfigurationInput_{}
+
+	if v, ok := tfMap["image_uri"].(string); ok && v != "" {
+		apiObject.ImageUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInitialCapacity(tfList []interface{}) map[string]*emrserverless.InitialCapacityConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*emrserverless.InitialCapacityConfig)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &emrserverless.InitialCapacityConfig{}
+
+		if v, ok := tfMap["initial_capacity_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["worker_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.WorkerConfiguration = expandWorkerResourceConfig(v[0].(map[string]interface{}))
+			}
+
+			if v, ok := tfMap["worker_count"].(int); ok {
+				apiObject.WorkerCount = aws.Int64(int64(v))
+			}
+		}
+
+		apiObjects[tfMap["initial_capacity_type"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandWorkerResourceConfig(tfMap map[string]interface{}) *emrserverless.WorkerResourceConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.WorkerResourceConfig{}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["disk"].(string); ok && v != "" {
+		apiObject.Disk = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMaximumCapacity(tfMap map[string]interface{}) *emrserverless.MaximumAllowedResources {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.MaximumAllowedResources{}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["disk"].(string); ok && v != "" {
+		apiObject.Disk = aws.String(v)
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNetworkConfiguration(tfMap map[string]interface{}) *emrserverless.NetworkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrserverless.NetworkConfiguration{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAutoStartConfig(apiObject *emrserverless.AutoStartConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAutoStopConfig(apiObject *emrserverless.AutoStopConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":              aws.BoolValue(apiObject.Enabled),
+		"idle_timeout_minutes": aws.Int64Value(apiObject.IdleTimeoutMinutes),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenImageConfiguration(apiObject *emrserverless.ImageConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"image_uri": aws.StringValue(apiObject.ImageUri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInitialCapacity(apiObjects map[string]*emrserverless.InitialCapacityConfig) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"initial_capacity_config": []interface{}{map[string]interface{}{
+				"worker_configuration": flattenWorkerResourceConfig(apiObject.WorkerConfiguration),
+				"worker_count":         aws.Int64Value(apiObject.WorkerCount),
+			}},
+			"initial_capacity_type": k,
+		})
+	}
+
+	return tfList
+}
+
+func flattenWorkerResourceConfig(apiObject *emrserverless.WorkerResourceConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cpu":    aws.StringValue(apiObject.Cpu),
+		"disk":   aws.StringValue(apiObject.Disk),
+		"memory": aws.StringValue(apiObject.Memory),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMaximumCapacity(apiObject *emrserverless.MaximumAllowedResources) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"cpu":    aws.StringValue(apiObject.Cpu),
+		"disk":   aws.StringValue(apiObject.Disk),
+		"memory": aws.StringValue(apiObject.Memory),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNetworkConfiguration(apiObject *emrserverless.NetworkConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	if len(apiObject.SecurityGroupIds) == 0 && len(apiObject.SubnetIds) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":         aws.StringValueSlice(apiObject.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
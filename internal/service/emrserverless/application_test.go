@@ -0,0 +1,292 @@
+package emrserverless_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrserverless"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrserverless "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEMRServerlessApplication_basic(t *testing.T) {
+	var v emrserverless.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrserverless_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "architecture", "X86_64"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "emr-serverless", regexp.MustCompile(`/applications/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_start_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_start_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_stop_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_stop_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_stop_configuration.0.idle_timeout_minutes", "15"),
+					resource.TestCheckResourceAttr(resourceName, "initial_capacity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "release_label", "emr-6.6.0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "hive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessApplication_disappears(t *testing.T) {
+	var v emrserverless.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrserverless_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfemrserverless.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessApplication_capacity(t *testing.T) {
+	var v emrserverless.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrserverless_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationCapacityConfig(rName, "2 vCPU", "10 GB"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "initial_capacity.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "initial_capacity.*", map[string]string{
+						"initial_capacity_type":                                   "HiveDriver",
+						"initial_capacity_config.#":                               "1",
+						"initial_capacity_config.0.worker_count":                  "1",
+						"initial_capacity_config.0.worker_configuration.#":        "1",
+						"initial_capacity_config.0.worker_configuration.0.cpu":    "2 vCPU",
+						"initial_capacity_config.0.worker_configuration.0.memory": "10 GB",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.0.cpu", "2 vCPU"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.0.memory", "10 GB"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationCapacityConfig(rName, "4 vCPU", "12 GB"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "initial_capacity.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "initial_capacity.*", map[string]string{
+						"initial_capacity_type":                                   "HiveDriver",
+						"initial_capacity_config.0.worker_configuration.0.cpu":    "4 vCPU",
+						"initial_capacity_config.0.worker_configuration.0.memory": "12 GB",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.0.cpu", "4 vCPU"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_capacity.0.memory", "12 GB"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessApplication_tags(t *testing.T) {
+	var v emrserverless.Application
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrserverless_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrserverless.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccApplicationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationExists(n string, v *emrserverless.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Serverless Application ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessConn
+
+		output, err := tfemrserverless.FindApplicationByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrserverless_application" {
+			continue
+		}
+
+		_, err := tfemrserverless.FindApplicationByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR Serverless Application %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccApplicationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "hive"
+}
+`, rName)
+}
+
+func testAccApplicationCapacityConfig(rName, cpu, memory string) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "hive"
+
+  initial_capacity {
+    initial_capacity_type = "HiveDriver"
+
+    initial_capacity_config {
+      worker_count = 1
+
+      worker_configuration {
+        cpu    = %[2]q
+        memory = %[3]q
+      }
+    }
+  }
+
+  maximum_capacity {
+    cpu    = %[2]q
+    memory = %[3]q
+  }
+}
+`, rName, cpu, memory)
+}
+
+func testAccApplicationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "hive"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccApplicationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-6.6.0"
+  type          = "hive"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,69 @@
+package emrserverless
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitApplicationCreated(ctx context.Context, conn *emrserverless.EMRServerless, id string, timeout time.Duration) (*emrserverless.Application, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrserverless.ApplicationStateCreating},
+		Target:  []string{emrserverless.ApplicationStateCreated},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrserverless.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationStopped(ctx context.Context, conn *emrserverless.EMRServerless, id string, timeout time.Duration) (*emrserverless.Application, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{emrserverless.ApplicationStateStarting, emrserverless.ApplicationStateStarted, emrserverless.ApplicationStateStopping},
+		Target:  []string{emrserverless.ApplicationStateStopped},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrserverless.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *emrserverless.EMRServerless, id string, timeout time.Duration) (*emrserverless.Application, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: emrserverless.ApplicationState_Values(),
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*emrserverless.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
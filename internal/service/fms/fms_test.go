@@ -16,6 +16,13 @@ func TestAccFMS_serial(t *testing.T) {
 			"update":                 testAccPolicy_update,
 			"resourceTags":           testAccPolicy_resourceTags,
 			"tags":                   testAccPolicy_tags,
+			"wafV2":                  testAccPolicy_wafV2,
+			"shieldAdvanced":         testAccPolicy_shieldAdvanced,
+			"securityGroupsCommon":   testAccPolicy_securityGroupsCommon,
+			"dnsFirewall":            testAccPolicy_dnsFirewall,
+		},
+		"PolicyComplianceDataSource": {
+			"basic": testAccPolicyComplianceDataSource_basic,
 		},
 	}
 
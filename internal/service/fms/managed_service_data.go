@@ -0,0 +1,746 @@
+package fms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// managedServiceDataBlocks maps each typed managed service data block to the
+// security service type whose JSON document it describes.
+var managedServiceDataBlocks = map[string]string{
+	"dns_firewall":           fms.SecurityServiceTypeDnsFirewall,
+	"network_firewall":       fms.SecurityServiceTypeNetworkFirewall,
+	"security_groups_common": fms.SecurityServiceTypeSecurityGroupsCommon,
+	"shield_advanced":        fms.SecurityServiceTypeShieldAdvanced,
+	"waf_v2":                 fms.SecurityServiceTypeWafv2,
+}
+
+func managedServiceDataConflicts(key string) []string {
+	var conflicts []string
+
+	for _, k := range []string{"dns_firewall", "managed_service_data", "network_firewall", "security_groups_common", "shield_advanced", "waf_v2"} {
+		if k != key {
+			conflicts = append(conflicts, "security_service_policy_data.0."+k)
+		}
+	}
+
+	return conflicts
+}
+
+func dnsFirewallManagedServiceDataSchema() *schema.Schema {
+	ruleGroupSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"priority": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 10000),
+					},
+					"rule_group_id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: managedServiceDataConflicts("dns_firewall"),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"post_process_rule_group": ruleGroupSchema(),
+				"pre_process_rule_group":  ruleGroupSchema(),
+			},
+		},
+	}
+}
+
+func networkFirewallManagedServiceDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: managedServiceDataConflicts("network_firewall"),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"orchestration_config": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_ipv4_cidr_list": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+								},
+							},
+							"single_firewall_endpoint_per_vpc": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+				"stateful_rule_group_reference": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"resource_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"stateless_default_actions": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"stateless_fragment_default_actions": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"stateless_rule_group_reference": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"priority": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 65535),
+							},
+							"resource_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func securityGroupsCommonManagedServiceDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: managedServiceDataConflicts("security_groups_common"),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"apply_to_all_ec2_instance_enis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"exclusive_resource_security_group_management": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"include_shared_vpc": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"revert_manual_security_group_changes": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"security_group_ids": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func shieldAdvancedManagedServiceDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: managedServiceDataConflicts("shield_advanced"),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"automatic_response_action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"BLOCK", "COUNT"}, false),
+				},
+				"automatic_response_status": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"DISABLED", "ENABLED", "IGNORED"}, false),
+				},
+				"override_customer_web_acl_classic": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func wafV2ManagedServiceDataSchema() *schema.Schema {
+	jsonSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: managedServiceDataConflicts("waf_v2"),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_action": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ALLOW", "BLOCK"}, false),
+				},
+				"logging_configuration": jsonSchema(),
+				"override_customer_web_acl_association": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"post_process_rule_groups": jsonSchema(),
+				"pre_process_rule_groups":  jsonSchema(),
+				"sampled_requests_enabled_for_default_actions": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+// checkSecurityServicePolicyData rejects a typed managed service data block
+// that does not describe the configured security service type.
+func checkSecurityServicePolicyData(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	securityServiceType := diff.Get("security_service_policy_data.0.type").(string)
+
+	if securityServiceType == "" {
+		return nil
+	}
+
+	for key, blockType := range managedServiceDataBlocks {
+		if v, ok := diff.Get("security_service_policy_data.0." + key).([]interface{}); !ok || len(v) == 0 {
+			continue
+		}
+
+		if blockType != securityServiceType {
+			return fmt.Errorf("security_service_policy_data.0.%s requires type %q, got %q", key, blockType, securityServiceType)
+		}
+	}
+
+	return nil
+}
+
+func expandSecurityServicePolicyData(tfMap map[string]interface{}) (*fms.SecurityServicePolicyData, error) {
+	securityServiceType := tfMap["type"].(string)
+	apiObject := &fms.SecurityServicePolicyData{
+		Type: aws.String(securityServiceType),
+	}
+
+	for key := range managedServiceDataBlocks {
+		v, ok := tfMap[key].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		document := map[string]interface{}{
+			"type": securityServiceType,
+		}
+
+		var err error
+
+		switch key {
+		case "dns_firewall":
+			expandDNSFirewallManagedServiceData(v[0].(map[string]interface{}), document)
+		case "network_firewall":
+			expandNetworkFirewallManagedServiceData(v[0].(map[string]interface{}), document)
+		case "security_groups_common":
+			expandSecurityGroupsCommonManagedServiceData(v[0].(map[string]interface{}), document)
+		case "shield_advanced":
+			expandShieldAdvancedManagedServiceData(v[0].(map[string]interface{}), document)
+		case "waf_v2":
+			err = expandWAFV2ManagedServiceData(v[0].(map[string]interface{}), document)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding security_service_policy_data.0.%s: %w", key, err)
+		}
+
+		b, err := json.Marshal(document)
+
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling security_service_policy_data.0.%s: %w", key, err)
+		}
+
+		apiObject.ManagedServiceData = aws.String(string(b))
+
+		return apiObject, nil
+	}
+
+	if v, ok := tfMap["managed_service_data"].(string); ok {
+		apiObject.ManagedServiceData = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func expandDNSFirewallManagedServiceData(tfMap map[string]interface{}, document map[string]interface{}) {
+	expandRuleGroups := func(tfList []interface{}) []interface{} {
+		ruleGroups := []interface{}{}
+
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			ruleGroups = append(ruleGroups, map[string]interface{}{
+				"priority":    tfMap["priority"].(int),
+				"ruleGroupId": tfMap["rule_group_id"].(string),
+			})
+		}
+
+		return ruleGroups
+	}
+
+	document["preProcessRuleGroups"] = expandRuleGroups(tfMap["pre_process_rule_group"].([]interface{}))
+	document["postProcessRuleGroups"] = expandRuleGroups(tfMap["post_process_rule_group"].([]interface{}))
+}
+
+func expandNetworkFirewallManagedServiceData(tfMap map[string]interface{}, document map[string]interface{}) {
+	statelessRuleGroups := []interface{}{}
+
+	for _, tfMapRaw := range tfMap["stateless_rule_group_reference"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		statelessRuleGroups = append(statelessRuleGroups, map[string]interface{}{
+			"priority":    tfMap["priority"].(int),
+			"resourceARN": tfMap["resource_arn"].(string),
+		})
+	}
+
+	statefulRuleGroups := []interface{}{}
+
+	for _, tfMapRaw := range tfMap["stateful_rule_group_reference"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		statefulRuleGroups = append(statefulRuleGroups, map[string]interface{}{
+			"resourceARN": tfMap["resource_arn"].(string),
+		})
+	}
+
+	document["networkFirewallStatelessRuleGroupReferences"] = statelessRuleGroups
+	document["networkFirewallStatelessDefaultActions"] = expandStringList(tfMap["stateless_default_actions"].([]interface{}))
+	document["networkFirewallStatelessFragmentDefaultActions"] = expandStringList(tfMap["stateless_fragment_default_actions"].([]interface{}))
+	document["networkFirewallStatelessCustomActions"] = []interface{}{}
+	document["networkFirewallStatefulRuleGroupReferences"] = statefulRuleGroups
+
+	if v, ok := tfMap["orchestration_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		document["networkFirewallOrchestrationConfig"] = map[string]interface{}{
+			"allowedIPV4CidrList":          expandStringList(tfMap["allowed_ipv4_cidr_list"].([]interface{})),
+			"singleFirewallEndpointPerVPC": tfMap["single_firewall_endpoint_per_vpc"].(bool),
+		}
+	}
+}
+
+func expandSecurityGroupsCommonManagedServiceData(tfMap map[string]interface{}, document map[string]interface{}) {
+	securityGroups := []interface{}{}
+
+	for _, v := range expandStringList(tfMap["security_group_ids"].([]interface{})) {
+		securityGroups = append(securityGroups, map[string]interface{}{
+			"id": v,
+		})
+	}
+
+	document["applyToAllEC2InstanceENIs"] = tfMap["apply_to_all_ec2_instance_enis"].(bool)
+	document["exclusiveResourceSecurityGroupManagement"] = tfMap["exclusive_resource_security_group_management"].(bool)
+	document["includeSharedVPC"] = tfMap["include_shared_vpc"].(bool)
+	document["revertManualSecurityGroupChanges"] = tfMap["revert_manual_security_group_changes"].(bool)
+	document["securityGroups"] = securityGroups
+}
+
+func expandShieldAdvancedManagedServiceData(tfMap map[string]interface{}, document map[string]interface{}) {
+	if v, ok := tfMap["automatic_response_status"].(string); ok && v != "" {
+		automaticResponseConfiguration := map[string]interface{}{
+			"automaticResponseStatus": v,
+		}
+
+		if v, ok := tfMap["automatic_response_action"].(string); ok && v != "" {
+			automaticResponseConfiguration["automaticResponseAction"] = v
+		}
+
+		document["automaticResponseConfiguration"] = automaticResponseConfiguration
+	}
+
+	document["overrideCustomerWebaclClassic"] = tfMap["override_customer_web_acl_classic"].(bool)
+}
+
+func expandWAFV2ManagedServiceData(tfMap map[string]interface{}, document map[string]interface{}) error {
+	document["defaultAction"] = map[string]interface{}{
+		"type": tfMap["default_action"].(string),
+	}
+	document["overrideCustomerWebACLAssociation"] = tfMap["override_customer_web_acl_association"].(bool)
+	document["sampledRequestsEnabledForDefaultActions"] = tfMap["sampled_requests_enabled_for_default_actions"].(bool)
+
+	for key, documentKey := range map[string]string{
+		"logging_configuration":    "loggingConfiguration",
+		"post_process_rule_groups": "postProcessRuleGroups",
+		"pre_process_rule_groups":  "preProcessRuleGroups",
+	} {
+		v, ok := tfMap[key].(string)
+
+		if !ok || v == "" {
+			if key != "logging_configuration" {
+				document[documentKey] = []interface{}{}
+			}
+
+			continue
+		}
+
+		var value interface{}
+
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			return fmt.Errorf("error parsing %s: %w", key, err)
+		}
+
+		document[documentKey] = value
+	}
+
+	return nil
+}
+
+// flattenSecurityServicePolicyData returns either the raw managed service data
+// or, when the typed block matching the policy's type is already present in
+// state, that block. Configurations using the raw JSON string, and imports,
+// only get managed_service_data.
+func flattenSecurityServicePolicyData(apiObject *fms.SecurityServicePolicyData, prior []interface{}) ([]interface{}, error) {
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	securityServiceType := aws.StringValue(apiObject.Type)
+	managedServiceData := aws.StringValue(apiObject.ManagedServiceData)
+
+	tfMap := map[string]interface{}{
+		"type": securityServiceType,
+	}
+
+	var priorMap map[string]interface{}
+	var hasBlock bool
+
+	if len(prior) > 0 && prior[0] != nil {
+		priorMap = prior[0].(map[string]interface{})
+	}
+
+	for key, blockType := range managedServiceDataBlocks {
+		if blockType != securityServiceType {
+			continue
+		}
+
+		v, ok := priorMap[key].([]interface{})
+
+		if !ok || len(v) == 0 {
+			continue
+		}
+
+		hasBlock = true
+
+		if managedServiceData == "" {
+			tfMap[key] = v
+			continue
+		}
+
+		document := map[string]interface{}{}
+
+		if err := json.Unmarshal([]byte(managedServiceData), &document); err != nil {
+			return nil, fmt.Errorf("error parsing FMS Policy managed service data: %w", err)
+		}
+
+		var block map[string]interface{}
+		var err error
+
+		switch key {
+		case "dns_firewall":
+			block = flattenDNSFirewallManagedServiceData(document)
+		case "network_firewall":
+			block = flattenNetworkFirewallManagedServiceData(document)
+		case "security_groups_common":
+			block = flattenSecurityGroupsCommonManagedServiceData(document)
+		case "shield_advanced":
+			block = flattenShieldAdvancedManagedServiceData(document)
+		case "waf_v2":
+			block, err = flattenWAFV2ManagedServiceData(document)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap[key] = []interface{}{block}
+	}
+
+	if !hasBlock {
+		tfMap["managed_service_data"] = managedServiceData
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func flattenDNSFirewallManagedServiceData(document map[string]interface{}) map[string]interface{} {
+	flattenRuleGroups := func(v interface{}) []interface{} {
+		var tfList []interface{}
+
+		list, _ := v.([]interface{})
+
+		for _, raw := range list {
+			ruleGroup, ok := raw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"priority":      documentInt(ruleGroup["priority"]),
+				"rule_group_id": documentString(ruleGroup["ruleGroupId"]),
+			})
+		}
+
+		return tfList
+	}
+
+	return map[string]interface{}{
+		"post_process_rule_group": flattenRuleGroups(document["postProcessRuleGroups"]),
+		"pre_process_rule_group":  flattenRuleGroups(document["preProcessRuleGroups"]),
+	}
+}
+
+func flattenNetworkFirewallManagedServiceData(document map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"stateless_default_actions":          documentStringList(document["networkFirewallStatelessDefaultActions"]),
+		"stateless_fragment_default_actions": documentStringList(document["networkFirewallStatelessFragmentDefaultActions"]),
+	}
+
+	var statelessRuleGroups []interface{}
+
+	list, _ := document["networkFirewallStatelessRuleGroupReferences"].([]interface{})
+
+	for _, raw := range list {
+		ruleGroup, ok := raw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		statelessRuleGroups = append(statelessRuleGroups, map[string]interface{}{
+			"priority":     documentInt(ruleGroup["priority"]),
+			"resource_arn": documentString(ruleGroup["resourceARN"]),
+		})
+	}
+
+	tfMap["stateless_rule_group_reference"] = statelessRuleGroups
+
+	var statefulRuleGroups []interface{}
+
+	list, _ = document["networkFirewallStatefulRuleGroupReferences"].([]interface{})
+
+	for _, raw := range list {
+		ruleGroup, ok := raw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		statefulRuleGroups = append(statefulRuleGroups, map[string]interface{}{
+			"resource_arn": documentString(ruleGroup["resourceARN"]),
+		})
+	}
+
+	tfMap["stateful_rule_group_reference"] = statefulRuleGroups
+
+	if v, ok := document["networkFirewallOrchestrationConfig"].(map[string]interface{}); ok {
+		tfMap["orchestration_config"] = []interface{}{map[string]interface{}{
+			"allowed_ipv4_cidr_list":           documentStringList(v["allowedIPV4CidrList"]),
+			"single_firewall_endpoint_per_vpc": documentBool(v["singleFirewallEndpointPerVPC"]),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenSecurityGroupsCommonManagedServiceData(document map[string]interface{}) map[string]interface{} {
+	var securityGroupIDs []interface{}
+
+	list, _ := document["securityGroups"].([]interface{})
+
+	for _, raw := range list {
+		securityGroup, ok := raw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		securityGroupIDs = append(securityGroupIDs, documentString(securityGroup["id"]))
+	}
+
+	return map[string]interface{}{
+		"apply_to_all_ec2_instance_enis":               documentBool(document["applyToAllEC2InstanceENIs"]),
+		"exclusive_resource_security_group_management": documentBool(document["exclusiveResourceSecurityGroupManagement"]),
+		"include_shared_vpc":                           documentBool(document["includeSharedVPC"]),
+		"revert_manual_security_group_changes":         documentBool(document["revertManualSecurityGroupChanges"]),
+		"security_group_ids":                           securityGroupIDs,
+	}
+}
+
+func flattenShieldAdvancedManagedServiceData(document map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"override_customer_web_acl_classic": documentBool(document["overrideCustomerWebaclClassic"]),
+	}
+
+	if v, ok := document["automaticResponseConfiguration"].(map[string]interface{}); ok {
+		tfMap["automatic_response_action"] = documentString(v["automaticResponseAction"])
+		tfMap["automatic_response_status"] = documentString(v["automaticResponseStatus"])
+	}
+
+	return tfMap
+}
+
+func flattenWAFV2ManagedServiceData(document map[string]interface{}) (map[string]interface{}, error) {
+	tfMap := map[string]interface{}{
+		"override_customer_web_acl_association":        documentBool(document["overrideCustomerWebACLAssociation"]),
+		"sampled_requests_enabled_for_default_actions": documentBool(document["sampledRequestsEnabledForDefaultActions"]),
+	}
+
+	if v, ok := document["defaultAction"].(map[string]interface{}); ok {
+		tfMap["default_action"] = documentString(v["type"])
+	}
+
+	for key, documentKey := range map[string]string{
+		"logging_configuration":    "loggingConfiguration",
+		"post_process_rule_groups": "postProcessRuleGroups",
+		"pre_process_rule_groups":  "preProcessRuleGroups",
+	} {
+		v, ok := document[documentKey]
+
+		if !ok || v == nil {
+			continue
+		}
+
+		// Empty rule group lists are sent when the argument is omitted.
+		if list, ok := v.([]interface{}); ok && len(list) == 0 {
+			continue
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling %s: %w", documentKey, err)
+		}
+
+		tfMap[key] = string(b)
+	}
+
+	return tfMap, nil
+}
+
+func expandStringList(tfList []interface{}) []string {
+	var apiObject []string
+
+	for _, v := range tfList {
+		if v, ok := v.(string); ok {
+			apiObject = append(apiObject, v)
+		}
+	}
+
+	if apiObject == nil {
+		return []string{}
+	}
+
+	return apiObject
+}
+
+func documentBool(v interface{}) bool {
+	b, _ := v.(bool)
+
+	return b
+}
+
+func documentInt(v interface{}) int {
+	f, _ := v.(float64)
+
+	return int(f)
+}
+
+func documentString(v interface{}) string {
+	s, _ := v.(string)
+
+	return s
+}
+
+func documentStringList(v interface{}) []interface{} {
+	var tfList []interface{}
+
+	list, _ := v.([]interface{})
+
+	for _, raw := range list {
+		if s, ok := raw.(string); ok {
+			tfList = append(tfList, s)
+		}
+	}
+
+	return tfList
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,7 +30,10 @@ func ResourcePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			checkSecurityServicePolicyData,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -134,15 +138,21 @@ func ResourcePolicy() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"dns_firewall": dnsFirewallManagedServiceDataSchema(),
 						"managed_service_data": {
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							ConflictsWith:    managedServiceDataConflicts("managed_service_data"),
 						},
+						"network_firewall":       networkFirewallManagedServiceDataSchema(),
+						"security_groups_common": securityGroupsCommonManagedServiceDataSchema(),
+						"shield_advanced":        shieldAdvancedManagedServiceDataSchema(),
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"waf_v2": wafV2ManagedServiceDataSchema(),
 					},
 				},
 			},
@@ -157,8 +167,14 @@ func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
+	policy, err := resourcePolicyExpandPolicy(d)
+
+	if err != nil {
+		return err
+	}
+
 	input := &fms.PutPolicyInput{
-		Policy:  resourcePolicyExpandPolicy(d),
+		Policy:  policy,
 		TagList: Tags(tags.IgnoreAWS()),
 	}
 
@@ -217,11 +233,17 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).FMSConn
 
 	if d.HasChangesExcept("tags", "tags_all") {
+		policy, err := resourcePolicyExpandPolicy(d)
+
+		if err != nil {
+			return err
+		}
+
 		input := &fms.PutPolicyInput{
-			Policy: resourcePolicyExpandPolicy(d),
+			Policy: policy,
 		}
 
-		_, err := conn.PutPolicy(input)
+		_, err = conn.PutPolicy(input)
 
 		if err != nil {
 			return fmt.Errorf("error updating FMS Policy (%s): %w", d.Id(), err)
@@ -306,10 +328,10 @@ func resourcePolicyFlattenPolicy(d *schema.ResourceData, resp *fms.GetPolicyOutp
 		return err
 	}
 
-	securityServicePolicy := []map[string]string{{
-		"type":                 *resp.Policy.SecurityServicePolicyData.Type,
-		"managed_service_data": *resp.Policy.SecurityServicePolicyData.ManagedServiceData,
-	}}
+	securityServicePolicy, err := flattenSecurityServicePolicyData(resp.Policy.SecurityServicePolicyData, d.Get("security_service_policy_data").([]interface{}))
+	if err != nil {
+		return err
+	}
 	if err := d.Set("security_service_policy_data", securityServicePolicy); err != nil {
 		return err
 	}
@@ -317,7 +339,7 @@ func resourcePolicyFlattenPolicy(d *schema.ResourceData, resp *fms.GetPolicyOutp
 	return nil
 }
 
-func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
+func resourcePolicyExpandPolicy(d *schema.ResourceData) (*fms.Policy, error) {
 	resourceType := aws.String("ResourceTypeList")
 	resourceTypeList := flex.ExpandStringSet(d.Get("resource_type_list").(*schema.Set))
 	if t, ok := d.GetOk("resource_type"); ok {
@@ -344,13 +366,13 @@ func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 
 	fmsPolicy.ResourceTags = constructResourceTags(d.Get("resource_tags"))
 
-	securityServicePolicy := d.Get("security_service_policy_data").([]interface{})[0].(map[string]interface{})
-	fmsPolicy.SecurityServicePolicyData = &fms.SecurityServicePolicyData{
-		ManagedServiceData: aws.String(securityServicePolicy["managed_service_data"].(string)),
-		Type:               aws.String(securityServicePolicy["type"].(string)),
+	securityServicePolicy, err := expandSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return nil, err
 	}
+	fmsPolicy.SecurityServicePolicyData = securityServicePolicy
 
-	return fmsPolicy
+	return fmsPolicy, nil
 }
 
 func expandFMSPolicyMap(set []interface{}) map[string][]*string {
@@ -0,0 +1,195 @@
+package fms
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourcePolicyCompliance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"non_compliant_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"non_compliant_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"violation_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyComplianceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	policyID := d.Get("policy_id").(string)
+
+	statuses, err := FindComplianceStatusesByPolicyID(conn, policyID)
+
+	if err != nil {
+		return fmt.Errorf("error listing FMS Policy (%s) compliance status: %w", policyID, err)
+	}
+
+	var policyName string
+	var accountIDs []string
+	var resources []interface{}
+
+	for _, status := range statuses {
+		if policyName == "" {
+			policyName = aws.StringValue(status.PolicyName)
+		}
+
+		if !complianceStatusIsNonCompliant(status) {
+			continue
+		}
+
+		accountID := aws.StringValue(status.MemberAccount)
+		accountIDs = append(accountIDs, accountID)
+
+		detail, err := FindComplianceDetail(conn, policyID, accountID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading FMS Policy (%s) compliance detail for account (%s): %w", policyID, accountID, err)
+		}
+
+		for _, violator := range detail.Violators {
+			if violator == nil {
+				continue
+			}
+
+			resources = append(resources, map[string]interface{}{
+				"account_id":       accountID,
+				"resource_id":      aws.StringValue(violator.ResourceId),
+				"resource_type":    aws.StringValue(violator.ResourceType),
+				"violation_reason": aws.StringValue(violator.ViolationReason),
+			})
+		}
+	}
+
+	d.SetId(policyID)
+	d.Set("policy_id", policyID)
+	d.Set("policy_name", policyName)
+
+	if err := d.Set("non_compliant_accounts", accountIDs); err != nil {
+		return fmt.Errorf("error setting non_compliant_accounts: %w", err)
+	}
+
+	if err := d.Set("non_compliant_resources", resources); err != nil {
+		return fmt.Errorf("error setting non_compliant_resources: %w", err)
+	}
+
+	return nil
+}
+
+func complianceStatusIsNonCompliant(status *fms.PolicyComplianceStatus) bool {
+	for _, result := range status.EvaluationResults {
+		if aws.StringValue(result.ComplianceStatus) == fms.PolicyComplianceStatusTypeNonCompliant {
+			return true
+		}
+	}
+
+	return false
+}
+
+func FindComplianceStatusesByPolicyID(conn *fms.FMS, id string) ([]*fms.PolicyComplianceStatus, error) {
+	input := &fms.ListComplianceStatusInput{
+		PolicyId: aws.String(id),
+	}
+	var output []*fms.PolicyComplianceStatus
+
+	err := conn.ListComplianceStatusPages(input, func(page *fms.ListComplianceStatusOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PolicyComplianceStatusList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindComplianceDetail(conn *fms.FMS, policyID, accountID string) (*fms.PolicyComplianceDetail, error) {
+	input := &fms.GetComplianceDetailInput{
+		MemberAccount: aws.String(accountID),
+		PolicyId:      aws.String(policyID),
+	}
+
+	output, err := conn.GetComplianceDetail(input)
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PolicyComplianceDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PolicyComplianceDetail, nil
+}
@@ -0,0 +1,46 @@
+package fms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPolicyComplianceDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_fms_policy_compliance.test"
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, fms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyComplianceDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_accounts.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyComplianceDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFmsPolicyConfig(rName, rName), `
+data "aws_fms_policy_compliance" "test" {
+  policy_id = aws_fms_policy.test.id
+}
+`)
+}
@@ -217,6 +217,141 @@ func testAccPolicy_tags(t *testing.T) {
 	})
 }
 
+func testAccPolicy_wafV2(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, fms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_wafV2(rName, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "WAFV2"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.waf_v2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.waf_v2.0.default_action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.waf_v2.0.override_customer_web_acl_association", "false"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.managed_service_data", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources", "security_service_policy_data"},
+			},
+			{
+				Config: testAccFmsPolicyConfig_wafV2(rName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.waf_v2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.waf_v2.0.default_action", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicy_shieldAdvanced(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, fms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_shieldAdvanced(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "SHIELD_ADVANCED"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.shield_advanced.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.shield_advanced.0.automatic_response_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.shield_advanced.0.automatic_response_action", "COUNT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicy_securityGroupsCommon(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, fms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_securityGroupsCommon(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "SECURITY_GROUPS_COMMON"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_common.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_common.0.revert_manual_security_group_changes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.security_groups_common.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_service_policy_data.0.security_groups_common.0.security_group_ids.0", "aws_security_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicy_dnsFirewall(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, fms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_dnsFirewall(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "DNS_FIREWALL"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.dns_firewall.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.dns_firewall.0.pre_process_rule_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.dns_firewall.0.pre_process_rule_group.0.priority", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_service_policy_data.0.dns_firewall.0.pre_process_rule_group.0.rule_group_id", "aws_route53_resolver_firewall_rule_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.dns_firewall.0.post_process_rule_group.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).FMSConn
 
@@ -544,3 +679,123 @@ resource "aws_wafregional_rule_group" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccFmsPolicyConfig_wafV2(rName, defaultAction string) string {
+	return acctest.ConfigCompose(testAccFmsPolicyConfigOrgMgmtAccountBase(), fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  security_service_policy_data {
+    type = "WAFV2"
+
+    waf_v2 {
+      default_action = %[2]q
+
+      pre_process_rule_groups = jsonencode([{
+        ruleGroupArn   = null
+        overrideAction = {
+          type = "NONE"
+        }
+        managedRuleGroupIdentifier = {
+          version              = null
+          vendorName           = "AWS"
+          managedRuleGroupName = "AWSManagedRulesAmazonIpReputationList"
+        }
+        ruleGroupType = "ManagedRuleGroup"
+        excludeRules  = []
+      }])
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName, defaultAction))
+}
+
+func testAccFmsPolicyConfig_shieldAdvanced(rName string) string {
+	return acctest.ConfigCompose(testAccFmsPolicyConfigOrgMgmtAccountBase(), fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::CloudFront::Distribution"
+
+  security_service_policy_data {
+    type = "SHIELD_ADVANCED"
+
+    shield_advanced {
+      automatic_response_status = "ENABLED"
+      automatic_response_action = "COUNT"
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
+
+func testAccFmsPolicyConfig_securityGroupsCommon(rName string) string {
+	return acctest.ConfigCompose(testAccFmsPolicyConfigOrgMgmtAccountBase(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::Instance"
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_COMMON"
+
+    security_groups_common {
+      revert_manual_security_group_changes = true
+      security_group_ids                   = [aws_security_group.test.id]
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
+
+func testAccFmsPolicyConfig_dnsFirewall(rName string) string {
+	return acctest.ConfigCompose(testAccFmsPolicyConfigOrgMgmtAccountBase(), fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_rule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::VPC"
+
+  security_service_policy_data {
+    type = "DNS_FIREWALL"
+
+    dns_firewall {
+      pre_process_rule_group {
+        priority      = 10
+        rule_group_id = aws_route53_resolver_firewall_rule_group.test.id
+      }
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
@@ -0,0 +1,707 @@
+package greengrassv2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidStringIsJSONOrYAML,
+				StateFunc: func(v interface{}) string {
+					recipe, _ := verify.NormalizeJSONOrYAMLString(v)
+					return recipe
+				},
+				ExactlyOneOf: []string{"inline_recipe", "lambda_function"},
+			},
+			"lambda_function": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_dependency": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"component_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"dependency_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.ComponentDependencyType_Values(), false),
+									},
+									"version_requirement": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+						"component_lambda_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"environment_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"event_source": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"topic": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaEventSourceType_Values(), false),
+												},
+											},
+										},
+									},
+									"exec_args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"input_payload_encoding_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.LambdaInputPayloadEncodingType_Values(), false),
+									},
+									"linux_process_params": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"container_params": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"device": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"add_group_owner": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"permission": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(greengrassv2.LambdaFilesystemPermission_Values(), false),
+																		},
+																	},
+																},
+															},
+															"memory_size_in_kb": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(2048),
+															},
+															"mount_ro_sysfs": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"volume": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"add_group_owner": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"destination_path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"permission": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ForceNew:     true,
+																			ValidateFunc: validation.StringInSlice(greengrassv2.LambdaFilesystemPermission_Values(), false),
+																		},
+																		"source_path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"isolation_mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaIsolationMode_Values(), false),
+												},
+											},
+										},
+									},
+									"max_idle_time_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_instances_count": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_queue_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"pinned": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"status_timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"component_platform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+						"component_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"lambda_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"inline_recipe", "lambda_function"},
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrassv2.CreateComponentVersionInput{}
+
+	if v, ok := d.GetOk("inline_recipe"); ok {
+		recipe, err := verify.NormalizeJSONOrYAMLString(v)
+
+		if err != nil {
+			return diag.Errorf("inline_recipe (%s) is invalid JSON or YAML: %s", v, err)
+		}
+
+		input.InlineRecipe = []byte(recipe)
+	}
+
+	if v, ok := d.GetOk("lambda_function"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LambdaFunction = expandLambdaFunctionRecipeSource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass V2 Component Version: %s", input)
+	output, err := conn.CreateComponentVersionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceComponentVersionRead(ctx, d, meta)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	d.Set("description", output.Description)
+	d.Set("publisher", output.Publisher)
+	d.Set("status", output.Status.ComponentState)
+
+	// The recipe generated for a Lambda function component is not managed by this resource.
+	// For inline recipes the configured value is kept, as the service may add fields when storing the recipe;
+	// the stored recipe is only read back when there is no configured value, e.g. on import.
+	if _, ok := d.GetOk("lambda_function"); !ok && d.Get("inline_recipe").(string) == "" {
+		recipe, err := FindComponentRecipeByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading Greengrass V2 Component Version (%s) recipe: %s", d.Id(), err)
+		}
+
+		recipe, err = verify.NormalizeJSONOrYAMLString(recipe)
+
+		if err != nil {
+			return diag.Errorf("Greengrass V2 Component Version (%s) recipe is invalid JSON: %s", d.Id(), err)
+		}
+
+		d.Set("inline_recipe", recipe)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass V2 Component Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceComponentVersionRead(ctx, d, meta)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponentWithContext(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLambdaFunctionRecipeSource(tfMap map[string]interface{}) *greengrassv2.LambdaFunctionRecipeSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaFunctionRecipeSource{}
+
+	if v, ok := tfMap["component_dependency"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentDependencies = expandComponentDependencyRequirements(v.List())
+	}
+
+	if v, ok := tfMap["component_lambda_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ComponentLambdaParameters = expandLambdaExecutionParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["component_name"].(string); ok && v != "" {
+		apiObject.ComponentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["component_platform"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ComponentPlatforms = expandComponentPlatforms(v)
+	}
+
+	if v, ok := tfMap["component_version"].(string); ok && v != "" {
+		apiObject.ComponentVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["lambda_arn"].(string); ok && v != "" {
+		apiObject.LambdaArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandComponentDependencyRequirements(tfList []interface{}) map[string]*greengrassv2.ComponentDependencyRequirement {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*greengrassv2.ComponentDependencyRequirement)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentDependencyRequirement{}
+
+		if v, ok := tfMap["dependency_type"].(string); ok && v != "" {
+			apiObject.DependencyType = aws.String(v)
+		}
+
+		if v, ok := tfMap["version_requirement"].(string); ok && v != "" {
+			apiObject.VersionRequirement = aws.String(v)
+		}
+
+		apiObjects[tfMap["component_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandComponentPlatforms(tfList []interface{}) []*greengrassv2.ComponentPlatform {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*greengrassv2.ComponentPlatform
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentPlatform{}
+
+		if v, ok := tfMap["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Attributes = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLambdaExecutionParameters(tfMap map[string]interface{}) *greengrassv2.LambdaExecutionParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaExecutionParameters{}
+
+	if v, ok := tfMap["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.EnvironmentVariables = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["event_source"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EventSources = expandLambdaEventSources(v)
+	}
+
+	if v, ok := tfMap["exec_args"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExecArgs = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["input_payload_encoding_type"].(string); ok && v != "" {
+		apiObject.InputPayloadEncodingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["linux_process_params"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LinuxProcessParams = expandLambdaLinuxProcessParams(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["max_idle_time_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaxIdleTimeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_instances_count"].(int); ok && v != 0 {
+		apiObject.MaxInstancesCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_queue_size"].(int); ok && v != 0 {
+		apiObject.MaxQueueSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pinned"].(bool); ok {
+		apiObject.Pinned = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["status_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.StatusTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLambdaEventSources(tfList []interface{}) []*greengrassv2.LambdaEventSource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*greengrassv2.LambdaEventSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &greengrassv2.LambdaEventSource{
+			Topic: aws.String(tfMap["topic"].(string)),
+			Type:  aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandLambdaLinuxProcessParams(tfMap map[string]interface{}) *greengrassv2.LambdaLinuxProcessParams {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaLinuxProcessParams{}
+
+	if v, ok := tfMap["container_params"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerParams = expandLambdaContainerParams(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["isolation_mode"].(string); ok && v != "" {
+		apiObject.IsolationMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLambdaContainerParams(tfMap map[string]interface{}) *greengrassv2.LambdaContainerParams {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaContainerParams{}
+
+	if v, ok := tfMap["device"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			device := &greengrassv2.LambdaDeviceMount{
+				AddGroupOwner: aws.Bool(tfMap["add_group_owner"].(bool)),
+				Path:          aws.String(tfMap["path"].(string)),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				device.Permission = aws.String(v)
+			}
+
+			apiObject.Devices = append(apiObject.Devices, device)
+		}
+	}
+
+	if v, ok := tfMap["memory_size_in_kb"].(int); ok && v != 0 {
+		apiObject.MemorySizeInKB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mount_ro_sysfs"].(bool); ok {
+		apiObject.MountROSysfs = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["volume"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			volume := &greengrassv2.LambdaVolumeMount{
+				AddGroupOwner:   aws.Bool(tfMap["add_group_owner"].(bool)),
+				DestinationPath: aws.String(tfMap["destination_path"].(string)),
+				SourcePath:      aws.String(tfMap["source_path"].(string)),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				volume.Permission = aws.String(v)
+			}
+
+			apiObject.Volumes = append(apiObject.Volumes, volume)
+		}
+	}
+
+	return apiObject
+}
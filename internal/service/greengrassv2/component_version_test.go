@@ -0,0 +1,349 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2ComponentVersion_basic(t *testing.T) {
+	resourceName := "aws_greengrassv2_component_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "greengrass", fmt.Sprintf("components:%s:versions:1.0.0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Test component"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_function.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "publisher", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", greengrassv2.CloudComponentStateDeployable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe"},
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_disappears(t *testing.T) {
+	resourceName := "aws_greengrassv2_component_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrassv2.ResourceComponentVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_tags(t *testing.T) {
+	resourceName := "aws_greengrassv2_component_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe"},
+			},
+			{
+				Config: testAccComponentVersionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_yamlRecipe(t *testing.T) {
+	resourceName := "aws_greengrassv2_component_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_yamlRecipe(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "2.1.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_lambdaFunction(t *testing.T) {
+	resourceName := "aws_greengrassv2_component_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckComponentVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_lambdaFunction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_function.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_function.0.component_lambda_parameters.0.linux_process_params.0.isolation_mode", "NoContainer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrassv2_component_version" {
+			continue
+		}
+
+		_, err := tfgreengrassv2.FindComponentVersionByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass V2 Component Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckComponentVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Component Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+		_, err := tfgreengrassv2.FindComponentVersionByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccComponentVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = "1.0.0"
+    ComponentDescription = "Test component"
+    ComponentPublisher   = "Terraform"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccComponentVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccComponentVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccComponentVersionConfig_yamlRecipe(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = <<EOF
+---
+RecipeFormatVersion: "2020-01-25"
+ComponentName: %[1]s
+ComponentVersion: "2.1.0"
+Manifests:
+  - Platform:
+      os: linux
+    Lifecycle:
+      Run: echo Hello
+EOF
+}
+`, rName)
+}
+
+func testAccComponentVersionConfig_lambdaFunction(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+  publish       = true
+}
+
+resource "aws_greengrassv2_component_version" "test" {
+  lambda_function {
+    lambda_arn        = aws_lambda_function.test.qualified_arn
+    component_name    = %[1]q
+    component_version = "1.0.0"
+
+    component_platform {
+      name = "Linux"
+
+      attributes = {
+        os = "linux"
+      }
+    }
+
+    component_lambda_parameters {
+      timeout_in_seconds = 30
+
+      linux_process_params {
+        isolation_mode = "NoContainer"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,889 @@
+package greengrassv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"component_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"configuration_update": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"merge": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsJSON,
+										StateFunc: func(v interface{}) string {
+											json, _ := structure.NormalizeJsonString(v)
+											return json
+										},
+									},
+									"reset": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"run_with": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"posix_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"system_resource_limits": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpus": {
+													Type:         schema.TypeFloat,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatAtLeast(0),
+												},
+												"memory": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"windows_user": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"deployment_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"deployment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_update_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentComponentUpdatePolicyAction_Values(), false),
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"configuration_validation_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"failure_handling_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(greengrassv2.DeploymentFailureHandlingPolicy_Values(), false),
+						},
+					},
+				},
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iot_job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iot_job_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"criteria": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"action": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.IoTJobAbortAction_Values(), false),
+												},
+												"failure_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.IoTJobExecutionFailureType_Values(), false),
+												},
+												"min_number_of_executed_things": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"threshold_percentage": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatBetween(0, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"job_executions_rollout_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exponential_rate": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"base_rate_per_minute": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 1000),
+												},
+												"increment_factor": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.FloatBetween(1.1, 5),
+												},
+												"rate_increase_criteria": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"number_of_notified_things": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"number_of_succeeded_things": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"maximum_per_minute": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+						"timeout_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"in_progress_timeout_in_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"iot_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrassv2.CreateDeploymentInput{
+		TargetArn: aws.String(d.Get("target_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("component"); ok && v.(*schema.Set).Len() > 0 {
+		components, err := expandComponentDeploymentSpecifications(v.(*schema.Set).List())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.Components = components
+	}
+
+	if v, ok := d.GetOk("deployment_name"); ok {
+		input.DeploymentName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_policies"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DeploymentPolicies = expandDeploymentPolicies(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("iot_job_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IotJobConfiguration = expandDeploymentIoTJobConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Greengrass V2 Deployment: %s", input)
+	output, err := conn.CreateDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Greengrass V2 Deployment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.DeploymentId))
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", deploymentARN(meta.(*conns.AWSClient), d.Id()))
+	if err := d.Set("component", flattenComponentDeploymentSpecifications(output.Components)); err != nil {
+		return diag.Errorf("error setting component: %s", err)
+	}
+	d.Set("deployment_name", output.DeploymentName)
+	if output.DeploymentPolicies != nil {
+		if err := d.Set("deployment_policies", []interface{}{flattenDeploymentPolicies(output.DeploymentPolicies)}); err != nil {
+			return diag.Errorf("error setting deployment_policies: %s", err)
+		}
+	} else {
+		d.Set("deployment_policies", nil)
+	}
+	d.Set("deployment_status", output.DeploymentStatus)
+	d.Set("iot_job_arn", output.IotJobArn)
+	if output.IotJobConfiguration != nil {
+		if err := d.Set("iot_job_configuration", []interface{}{flattenDeploymentIoTJobConfiguration(output.IotJobConfiguration)}); err != nil {
+			return diag.Errorf("error setting iot_job_configuration: %s", err)
+		}
+	} else {
+		d.Set("iot_job_configuration", nil)
+	}
+	d.Set("iot_job_id", output.IotJobId)
+	d.Set("target_arn", output.TargetArn)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Greengrass V2 Deployment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDeploymentRead(ctx, d, meta)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn
+
+	output, err := FindDeploymentByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	// An active deployment must be canceled before it can be deleted.
+	if aws.StringValue(output.DeploymentStatus) == greengrassv2.DeploymentStatusActive {
+		log.Printf("[DEBUG] Canceling Greengrass V2 Deployment: %s", d.Id())
+		_, err := conn.CancelDeploymentWithContext(ctx, &greengrassv2.CancelDeploymentInput{
+			DeploymentId: aws.String(d.Id()),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+			return diag.Errorf("error canceling Greengrass V2 Deployment (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDeploymentCanceled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for Greengrass V2 Deployment (%s) cancel: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Deployment: %s", d.Id())
+	_, err = conn.DeleteDeploymentWithContext(ctx, &greengrassv2.DeleteDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Greengrass V2 Deployment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func deploymentARN(client *conns.AWSClient, id string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "greengrass",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("deployments:%s", id),
+	}.String()
+}
+
+func expandComponentDeploymentSpecifications(tfList []interface{}) (map[string]*greengrassv2.ComponentDeploymentSpecification, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	apiObjects := make(map[string]*greengrassv2.ComponentDeploymentSpecification)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["component_name"].(string)
+
+		if _, ok := apiObjects[name]; ok {
+			return nil, fmt.Errorf("duplicate component: %s", name)
+		}
+
+		apiObject := &greengrassv2.ComponentDeploymentSpecification{
+			ComponentVersion: aws.String(tfMap["component_version"].(string)),
+		}
+
+		if v, ok := tfMap["configuration_update"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ConfigurationUpdate = expandComponentConfigurationUpdate(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["run_with"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RunWith = expandComponentRunWith(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+func expandComponentConfigurationUpdate(tfMap map[string]interface{}) *greengrassv2.ComponentConfigurationUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.ComponentConfigurationUpdate{}
+
+	if v, ok := tfMap["merge"].(string); ok && v != "" {
+		apiObject.Merge = aws.String(v)
+	}
+
+	if v, ok := tfMap["reset"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Reset = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func expandComponentRunWith(tfMap map[string]interface{}) *greengrassv2.ComponentRunWith {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.ComponentRunWith{}
+
+	if v, ok := tfMap["posix_user"].(string); ok && v != "" {
+		apiObject.PosixUser = aws.String(v)
+	}
+
+	if v, ok := tfMap["system_resource_limits"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		limits := &greengrassv2.SystemResourceLimits{}
+
+		if v, ok := tfMap["cpus"].(float64); ok && v != 0 {
+			limits.Cpus = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			limits.Memory = aws.Int64(int64(v))
+		}
+
+		apiObject.SystemResourceLimits = limits
+	}
+
+	if v, ok := tfMap["windows_user"].(string); ok && v != "" {
+		apiObject.WindowsUser = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentPolicies(tfMap map[string]interface{}) *greengrassv2.DeploymentPolicies {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.DeploymentPolicies{}
+
+	if v, ok := tfMap["component_update_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		policy := &greengrassv2.DeploymentComponentUpdatePolicy{}
+
+		if v, ok := tfMap["action"].(string); ok && v != "" {
+			policy.Action = aws.String(v)
+		}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			policy.TimeoutInSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.ComponentUpdatePolicy = policy
+	}
+
+	if v, ok := tfMap["configuration_validation_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		policy := &greengrassv2.DeploymentConfigurationValidationPolicy{}
+
+		if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+			policy.TimeoutInSeconds = aws.Int64(int64(v))
+		}
+
+		apiObject.ConfigurationValidationPolicy = policy
+	}
+
+	if v, ok := tfMap["failure_handling_policy"].(string); ok && v != "" {
+		apiObject.FailureHandlingPolicy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDeploymentIoTJobConfiguration(tfMap map[string]interface{}) *greengrassv2.DeploymentIoTJobConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.DeploymentIoTJobConfiguration{}
+
+	if v, ok := tfMap["abort_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AbortConfig = expandIoTJobAbortConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["job_executions_rollout_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.JobExecutionsRolloutConfig = expandIoTJobExecutionsRolloutConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["timeout_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &greengrassv2.IoTJobTimeoutConfig{}
+
+		if v, ok := tfMap["in_progress_timeout_in_minutes"].(int); ok && v != 0 {
+			config.InProgressTimeoutInMinutes = aws.Int64(int64(v))
+		}
+
+		apiObject.TimeoutConfig = config
+	}
+
+	return apiObject
+}
+
+func expandIoTJobAbortConfig(tfMap map[string]interface{}) *greengrassv2.IoTJobAbortConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.IoTJobAbortConfig{}
+
+	if v, ok := tfMap["criteria"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.CriteriaList = append(apiObject.CriteriaList, &greengrassv2.IoTJobAbortCriteria{
+				Action:                    aws.String(tfMap["action"].(string)),
+				FailureType:               aws.String(tfMap["failure_type"].(string)),
+				MinNumberOfExecutedThings: aws.Int64(int64(tfMap["min_number_of_executed_things"].(int))),
+				ThresholdPercentage:       aws.Float64(tfMap["threshold_percentage"].(float64)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandIoTJobExecutionsRolloutConfig(tfMap map[string]interface{}) *greengrassv2.IoTJobExecutionsRolloutConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.IoTJobExecutionsRolloutConfig{}
+
+	if v, ok := tfMap["exponential_rate"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		rate := &greengrassv2.IoTJobExponentialRolloutRate{
+			BaseRatePerMinute: aws.Int64(int64(tfMap["base_rate_per_minute"].(int))),
+			IncrementFactor:   aws.Float64(tfMap["increment_factor"].(float64)),
+		}
+
+		if v, ok := tfMap["rate_increase_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			criteria := &greengrassv2.IoTJobRateIncreaseCriteria{}
+
+			if v, ok := tfMap["number_of_notified_things"].(int); ok && v != 0 {
+				criteria.NumberOfNotifiedThings = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["number_of_succeeded_things"].(int); ok && v != 0 {
+				criteria.NumberOfSucceededThings = aws.Int64(int64(v))
+			}
+
+			rate.RateIncreaseCriteria = criteria
+		}
+
+		apiObject.ExponentialRate = rate
+	}
+
+	if v, ok := tfMap["maximum_per_minute"].(int); ok && v != 0 {
+		apiObject.MaximumPerMinute = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenComponentDeploymentSpecifications(apiObjects map[string]*greengrassv2.ComponentDeploymentSpecification) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"component_name":    name,
+			"component_version": aws.StringValue(apiObject.ComponentVersion),
+		}
+
+		if v := apiObject.ConfigurationUpdate; v != nil {
+			merge := aws.StringValue(v.Merge)
+
+			if merge != "" {
+				if json, err := structure.NormalizeJsonString(merge); err == nil {
+					merge = json
+				}
+			}
+
+			tfMap["configuration_update"] = []interface{}{map[string]interface{}{
+				"merge": merge,
+				"reset": aws.StringValueSlice(v.Reset),
+			}}
+		}
+
+		if v := apiObject.RunWith; v != nil && (v.PosixUser != nil || v.SystemResourceLimits != nil || v.WindowsUser != nil) {
+			runWith := map[string]interface{}{
+				"posix_user":   aws.StringValue(v.PosixUser),
+				"windows_user": aws.StringValue(v.WindowsUser),
+			}
+
+			if v := v.SystemResourceLimits; v != nil {
+				runWith["system_resource_limits"] = []interface{}{map[string]interface{}{
+					"cpus":   aws.Float64Value(v.Cpus),
+					"memory": aws.Int64Value(v.Memory),
+				}}
+			}
+
+			tfMap["run_with"] = []interface{}{runWith}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDeploymentPolicies(apiObject *greengrassv2.DeploymentPolicies) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failure_handling_policy": aws.StringValue(apiObject.FailureHandlingPolicy),
+	}
+
+	if v := apiObject.ComponentUpdatePolicy; v != nil {
+		tfMap["component_update_policy"] = []interface{}{map[string]interface{}{
+			"action":             aws.StringValue(v.Action),
+			"timeout_in_seconds": aws.Int64Value(v.TimeoutInSeconds),
+		}}
+	}
+
+	if v := apiObject.ConfigurationValidationPolicy; v != nil {
+		tfMap["configuration_validation_policy"] = []interface{}{map[string]interface{}{
+			"timeout_in_seconds": aws.Int64Value(v.TimeoutInSeconds),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDeploymentIoTJobConfiguration(apiObject *greengrassv2.DeploymentIoTJobConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AbortConfig; v != nil && len(v.CriteriaList) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v.CriteriaList {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"action":                        aws.StringValue(apiObject.Action),
+				"failure_type":                  aws.StringValue(apiObject.FailureType),
+				"min_number_of_executed_things": aws.Int64Value(apiObject.MinNumberOfExecutedThings),
+				"threshold_percentage":          aws.Float64Value(apiObject.ThresholdPercentage),
+			})
+		}
+
+		tfMap["abort_config"] = []interface{}{map[string]interface{}{
+			"criteria": tfList,
+		}}
+	}
+
+	if v := apiObject.JobExecutionsRolloutConfig; v != nil && (v.ExponentialRate != nil || v.MaximumPerMinute != nil) {
+		config := map[string]interface{}{
+			"maximum_per_minute": aws.Int64Value(v.MaximumPerMinute),
+		}
+
+		if v := v.ExponentialRate; v != nil {
+			rate := map[string]interface{}{
+				"base_rate_per_minute": aws.Int64Value(v.BaseRatePerMinute),
+				"increment_factor":     aws.Float64Value(v.IncrementFactor),
+			}
+
+			if v := v.RateIncreaseCriteria; v != nil {
+				rate["rate_increase_criteria"] = []interface{}{map[string]interface{}{
+					"number_of_notified_things":  aws.Int64Value(v.NumberOfNotifiedThings),
+					"number_of_succeeded_things": aws.Int64Value(v.NumberOfSucceededThings),
+				}}
+			}
+
+			config["exponential_rate"] = []interface{}{rate}
+		}
+
+		tfMap["job_executions_rollout_config"] = []interface{}{config}
+	}
+
+	if v := apiObject.TimeoutConfig; v != nil && v.InProgressTimeoutInMinutes != nil {
+		tfMap["timeout_config"] = []interface{}{map[string]interface{}{
+			"in_progress_timeout_in_minutes": aws.Int64Value(v.InProgressTimeoutInMinutes),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,367 @@
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGreengrassV2Deployment_basic(t *testing.T) {
+	resourceName := "aws_greengrassv2_deployment.test"
+	thingGroupResourceName := "aws_iot_thing_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":    rName,
+						"component_version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_status", greengrassv2.DeploymentStatusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "iot_job_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", thingGroupResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_disappears(t *testing.T) {
+	resourceName := "aws_greengrassv2_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgreengrassv2.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_tags(t *testing.T) {
+	resourceName := "aws_greengrassv2_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_full(t *testing.T) {
+	resourceName := "aws_greengrassv2_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(greengrassv2.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, greengrassv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":                             rName,
+						"configuration_update.#":                     "1",
+						"configuration_update.0.merge":               `{"Message":"Hello from Terraform"}`,
+						"configuration_update.0.reset.#":             "1",
+						"configuration_update.0.reset.0":             "/Debug",
+						"run_with.#":                                 "1",
+						"run_with.0.posix_user":                      "ggc_user:ggc_group",
+						"run_with.0.system_resource_limits.#":        "1",
+						"run_with.0.system_resource_limits.0.cpus":   "0.5",
+						"run_with.0.system_resource_limits.0.memory": "102400",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.action", "SKIP_NOTIFY_COMPONENTS"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.timeout_in_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.configuration_validation_policy.0.timeout_in_seconds", "45"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.failure_handling_policy", "DO_NOTHING"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.action", "CANCEL"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.failure_type", "FAILED"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.min_number_of_executed_things", "5"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.criteria.0.threshold_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.base_rate_per_minute", "5"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.increment_factor", "2"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.rate_increase_criteria.0.number_of_succeeded_things", "10"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.maximum_per_minute", "50"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.timeout_config.0.in_progress_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_greengrassv2_deployment" {
+			continue
+		}
+
+		_, err := tfgreengrassv2.FindDeploymentByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Greengrass V2 Deployment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDeploymentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Greengrass V2 Deployment ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Conn
+
+		_, err := tfgreengrassv2.FindDeploymentByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDeploymentBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    ComponentConfiguration = {
+      DefaultConfiguration = {
+        Message = "Hello"
+        Debug   = false
+      }
+    }
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo {configuration:/Message}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDeploymentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDeploymentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccDeploymentConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentBaseConfig(rName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+
+    configuration_update {
+      merge = jsonencode({
+        Message = "Hello from Terraform"
+      })
+      reset = ["/Debug"]
+    }
+
+    run_with {
+      posix_user = "ggc_user:ggc_group"
+
+      system_resource_limits {
+        cpus   = 0.5
+        memory = 102400
+      }
+    }
+  }
+
+  deployment_policies {
+    failure_handling_policy = "DO_NOTHING"
+
+    component_update_policy {
+      action             = "SKIP_NOTIFY_COMPONENTS"
+      timeout_in_seconds = 120
+    }
+
+    configuration_validation_policy {
+      timeout_in_seconds = 45
+    }
+  }
+
+  iot_job_configuration {
+    abort_config {
+      criteria {
+        action                        = "CANCEL"
+        failure_type                  = "FAILED"
+        min_number_of_executed_things = 5
+        threshold_percentage          = 25
+      }
+    }
+
+    job_executions_rollout_config {
+      maximum_per_minute = 50
+
+      exponential_rate {
+        base_rate_per_minute = 5
+        increment_factor     = 2
+
+        rate_increase_criteria {
+          number_of_succeeded_things = 10
+        }
+      }
+    }
+
+    timeout_config {
+      in_progress_timeout_in_minutes = 60
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,42 @@
+package greengrassv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.ComponentState), nil
+	}
+}
+
+func statusDeployment(ctx context.Context, conn *greengrassv2.GreengrassV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DeploymentStatus), nil
+	}
+}
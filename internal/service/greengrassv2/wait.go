@@ -0,0 +1,80 @@
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if status := output.Status; status != nil && aws.StringValue(status.ComponentState) == greengrassv2.CloudComponentStateFailed {
+			tfresource.SetLastError(err, componentStatusError(status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentCanceled(ctx context.Context, conn *greengrassv2.GreengrassV2, id string, timeout time.Duration) (*greengrassv2.GetDeploymentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{greengrassv2.DeploymentStatusActive},
+		Target: []string{
+			greengrassv2.DeploymentStatusCanceled,
+			greengrassv2.DeploymentStatusCompleted,
+			greengrassv2.DeploymentStatusFailed,
+			greengrassv2.DeploymentStatusInactive,
+		},
+		Refresh: statusDeployment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.GetDeploymentOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func componentStatusError(apiObject *greengrassv2.CloudComponentStatus) error {
+	var messages []string
+
+	if v := aws.StringValue(apiObject.Message); v != "" {
+		messages = append(messages, v)
+	}
+
+	var keys []string
+
+	for k := range apiObject.Errors {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %s", k, aws.StringValue(apiObject.Errors[k])))
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
@@ -0,0 +1,159 @@
+package identitystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+)
+
+// attributeOperation sets the attribute at AttributePath to AttributeValue, or removes it if AttributeValue is nil.
+type attributeOperation struct {
+	AttributePath  string      `json:"AttributePath"`
+	AttributeValue interface{} `json:"AttributeValue,omitempty"`
+}
+
+// The AWS SDK for Go v1 can't serialize AttributeOperation values, which are document types,
+// so the UpdateGroup and UpdateUser request bodies are built here and replace the SDK-built ones.
+
+func updateGroupAttributes(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, groupID string, operations []attributeOperation) error {
+	req, _ := conn.UpdateGroupRequest(&identitystore.UpdateGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperationPaths(operations),
+	})
+
+	return sendAttributeOperations(ctx, req, map[string]interface{}{
+		"GroupId":         groupID,
+		"IdentityStoreId": identityStoreID,
+		"Operations":      operations,
+	})
+}
+
+func updateUserAttributes(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, userID string, operations []attributeOperation) error {
+	req, _ := conn.UpdateUserRequest(&identitystore.UpdateUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperationPaths(operations),
+		UserId:          aws.String(userID),
+	})
+
+	return sendAttributeOperations(ctx, req, map[string]interface{}{
+		"IdentityStoreId": identityStoreID,
+		"Operations":      operations,
+		"UserId":          userID,
+	})
+}
+
+func sendAttributeOperations(ctx context.Context, req *request.Request, body map[string]interface{}) error {
+	b, err := json.Marshal(body)
+
+	if err != nil {
+		return fmt.Errorf("error encoding attribute operations: %w", err)
+	}
+
+	req.Handlers.Build.PushBack(func(r *request.Request) {
+		r.SetBufferBody(b)
+	})
+	req.SetContext(ctx)
+
+	return req.Send()
+}
+
+func expandAttributeOperationPaths(operations []attributeOperation) []*identitystore.AttributeOperation {
+	apiObjects := make([]*identitystore.AttributeOperation, 0, len(operations))
+
+	for _, operation := range operations {
+		apiObjects = append(apiObjects, &identitystore.AttributeOperation{
+			AttributePath: aws.String(operation.AttributePath),
+		})
+	}
+
+	return apiObjects
+}
+
+// The following types encode multi-valued user attributes with the API's field names, omitting unset fields.
+
+type addressValue struct {
+	Country       *string `json:"Country,omitempty"`
+	Formatted     *string `json:"Formatted,omitempty"`
+	Locality      *string `json:"Locality,omitempty"`
+	PostalCode    *string `json:"PostalCode,omitempty"`
+	Primary       *bool   `json:"Primary,omitempty"`
+	Region        *string `json:"Region,omitempty"`
+	StreetAddress *string `json:"StreetAddress,omitempty"`
+	Type          *string `json:"Type,omitempty"`
+}
+
+type emailValue struct {
+	Primary *bool   `json:"Primary,omitempty"`
+	Type    *string `json:"Type,omitempty"`
+	Value   *string `json:"Value,omitempty"`
+}
+
+type phoneNumberValue struct {
+	Primary *bool   `json:"Primary,omitempty"`
+	Type    *string `json:"Type,omitempty"`
+	Value   *string `json:"Value,omitempty"`
+}
+
+func addressValues(apiObjects []*identitystore.Address) []addressValue {
+	values := make([]addressValue, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		values = append(values, addressValue{
+			Country:       apiObject.Country,
+			Formatted:     apiObject.Formatted,
+			Locality:      apiObject.Locality,
+			PostalCode:    apiObject.PostalCode,
+			Primary:       apiObject.Primary,
+			Region:        apiObject.Region,
+			StreetAddress: apiObject.StreetAddress,
+			Type:          apiObject.Type,
+		})
+	}
+
+	return values
+}
+
+func emailValues(apiObjects []*identitystore.Email) []emailValue {
+	values := make([]emailValue, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		values = append(values, emailValue{
+			Primary: apiObject.Primary,
+			Type:    apiObject.Type,
+			Value:   apiObject.Value,
+		})
+	}
+
+	return values
+}
+
+func phoneNumberValues(apiObjects []*identitystore.PhoneNumber) []phoneNumberValue {
+	values := make([]phoneNumberValue, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		values = append(values, phoneNumberValue{
+			Primary: apiObject.Primary,
+			Type:    apiObject.Type,
+			Value:   apiObject.Value,
+		})
+	}
+
+	return values
+}
@@ -0,0 +1,43 @@
+package identitystore
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func externalIDsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"issuer": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenExternalIDs(apiObjects []*identitystore.ExternalId) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":     aws.StringValue(apiObject.Id),
+			"issuer": aws.StringValue(apiObject.Issuer),
+		})
+	}
+
+	return tfList
+}
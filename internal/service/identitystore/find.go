@@ -0,0 +1,89 @@
+package identitystore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGroupByTwoPartKey(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, groupID string) (*identitystore.DescribeGroupOutput, error) {
+	input := &identitystore.DescribeGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	output, err := conn.DescribeGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindGroupMembershipByTwoPartKey(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, membershipID string) (*identitystore.DescribeGroupMembershipOutput, error) {
+	input := &identitystore.DescribeGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	}
+
+	output, err := conn.DescribeGroupMembershipWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MemberId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindUserByTwoPartKey(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, userID string) (*identitystore.DescribeUserOutput, error) {
+	input := &identitystore.DescribeUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	}
+
+	output, err := conn.DescribeUserWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
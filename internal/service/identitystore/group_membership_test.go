@@ -0,0 +1,153 @@
+package identitystore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIdentityStoreGroupMembership_basic(t *testing.T) {
+	resourceName := "aws_identitystore_group_membership.test"
+	groupResourceName := "aws_identitystore_group.test"
+	userResourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_store_id", "data.aws_ssoadmin_instances.test", "identity_store_ids.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_id", userResourceName, "user_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "membership_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMembership_disappears(t *testing.T) {
+	resourceName := "aws_identitystore_group_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfidentitystore.ResourceGroupMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_identitystore_group_membership" {
+			continue
+		}
+
+		identityStoreID, membershipID, err := tfidentitystore.GroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfidentitystore.FindGroupMembershipByTwoPartKey(context.Background(), conn, identityStoreID, membershipID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Identity Store Group Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGroupMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Identity Store Group Membership ID is set")
+		}
+
+		identityStoreID, membershipID, err := tfidentitystore.GroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+		_, err = tfidentitystore.FindGroupMembershipByTwoPartKey(context.Background(), conn, identityStoreID, membershipID)
+
+		return err
+	}
+}
+
+func testAccGroupMembershipConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group_membership" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id          = aws_identitystore_group.test.group_id
+  member_id         = aws_identitystore_user.test.user_id
+}
+`, rName)
+}
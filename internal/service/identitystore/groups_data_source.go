@@ -0,0 +1,94 @@
+package identitystore
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_ids": externalIDsSchema(),
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+				),
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.ListGroupsInput{
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+	var groups []interface{}
+
+	err := conn.ListGroupsPagesWithContext(ctx, input, func(page *identitystore.ListGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.Groups {
+			if group == nil {
+				continue
+			}
+
+			groups = append(groups, map[string]interface{}{
+				"description":  aws.StringValue(group.Description),
+				"display_name": aws.StringValue(group.DisplayName),
+				"external_ids": flattenExternalIDs(group.ExternalIds),
+				"group_id":     aws.StringValue(group.GroupId),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("error listing Identity Store Groups (%s): %s", identityStoreID, err)
+	}
+
+	d.SetId(identityStoreID)
+
+	if err := d.Set("groups", groups); err != nil {
+		return diag.Errorf("error setting groups: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package identitystore_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIdentityStoreGroupsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_groups.test"
+	resourceName := "aws_identitystore_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck: acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_store_id", "data.aws_ssoadmin_instances.test", "identity_store_ids.0"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "groups.*.group_id", resourceName, "group_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "groups.*", map[string]string{
+						"description":  "description1",
+						"display_name": rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_description(rName, "description1"), `
+data "aws_identitystore_groups" "test" {
+  identity_store_id = aws_identitystore_group.test.identity_store_id
+}
+`)
+}
@@ -0,0 +1,171 @@
+//go:build sweep
+// +build sweep
+
+package identitystore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_identitystore_group", &resource.Sweeper{
+		Name: "aws_identitystore_group",
+		F:    sweepGroups,
+	})
+
+	resource.AddTestSweepers("aws_identitystore_user", &resource.Sweeper{
+		Name: "aws_identitystore_user",
+		F:    sweepUsers,
+	})
+}
+
+// sweepIdentityStoreIDs returns the identity stores of the region's SSO instances.
+func sweepIdentityStoreIDs(client interface{}) ([]string, error) {
+	conn := client.(*conns.AWSClient).SSOAdminConn
+	var identityStoreIDs []string
+
+	err := conn.ListInstancesPagesWithContext(context.Background(), &ssoadmin.ListInstancesInput{}, func(page *ssoadmin.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Instances {
+			identityStoreIDs = append(identityStoreIDs, aws.StringValue(v.IdentityStoreId))
+		}
+
+		return !lastPage
+	})
+
+	return identityStoreIDs, err
+}
+
+func sweepGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IdentityStoreConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	identityStoreIDs, err := sweepIdentityStoreIDs(client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Identity Store Groups sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SSO Instances (%s): %w", region, err)
+	}
+
+	for _, identityStoreID := range identityStoreIDs {
+		input := &identitystore.ListGroupsInput{
+			IdentityStoreId: aws.String(identityStoreID),
+		}
+
+		err := conn.ListGroupsPagesWithContext(context.Background(), input, func(page *identitystore.ListGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Groups {
+				// Only sweep groups created by acceptance tests.
+				if !strings.HasPrefix(aws.StringValue(v.DisplayName), "tf-acc-test") {
+					continue
+				}
+
+				r := ResourceGroup()
+				d := r.Data(nil)
+				d.SetId(GroupCreateResourceID(identityStoreID, aws.StringValue(v.GroupId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Identity Store Groups (%s): %w", identityStoreID, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Identity Store Groups (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepUsers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IdentityStoreConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	identityStoreIDs, err := sweepIdentityStoreIDs(client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Identity Store Users sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SSO Instances (%s): %w", region, err)
+	}
+
+	for _, identityStoreID := range identityStoreIDs {
+		input := &identitystore.ListUsersInput{
+			IdentityStoreId: aws.String(identityStoreID),
+		}
+
+		err := conn.ListUsersPagesWithContext(context.Background(), input, func(page *identitystore.ListUsersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Users {
+				// Only sweep users created by acceptance tests.
+				if !strings.HasPrefix(aws.StringValue(v.UserName), "tf-acc-test") {
+					continue
+				}
+
+				r := ResourceUser()
+				d := r.Data(nil)
+				d.SetId(UserCreateResourceID(identityStoreID, aws.StringValue(v.UserId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Identity Store Users (%s): %w", identityStoreID, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Identity Store Users (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
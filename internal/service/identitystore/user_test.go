@@ -0,0 +1,264 @@
+package identitystore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIdentityStoreUser_basic(t *testing.T) {
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_store_id", "data.aws_ssoadmin_instances.test", "identity_store_ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.family_name", "Doe"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.given_name", "John"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_disappears(t *testing.T) {
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfidentitystore.ResourceUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_full(t *testing.T) {
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	email1 := acctest.RandomEmailAddress(domain)
+	email2 := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_full(rName, "1 Main Street", "work", email1, "+1 555 0100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.country", "US"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.locality", "Seattle"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.primary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.street_address", "1 Main Street"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.type", "work"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.primary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.type", "work"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", email1),
+					resource.TestCheckResourceAttr(resourceName, "locale", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.honorific_prefix", "Dr."),
+					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", "Quincy"),
+					resource.TestCheckResourceAttr(resourceName, "nickname", "Johnny"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.0.value", "+1 555 0100"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_language", "en"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Engineer"),
+					resource.TestCheckResourceAttr(resourceName, "user_type", "Employee"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserConfig_full(rName, "2 Main Street", "home", email2, "+1 555 0199"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.street_address", "2 Main Street"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.type", "home"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.type", "home"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", email2),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.0.type", "home"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.0.value", "+1 555 0199"),
+				),
+			},
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "locale", ""),
+					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "nickname", ""),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_identitystore_user" {
+			continue
+		}
+
+		identityStoreID, userID, err := tfidentitystore.UserParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfidentitystore.FindUserByTwoPartKey(context.Background(), conn, identityStoreID, userID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Identity Store User %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Identity Store User ID is set")
+		}
+
+		identityStoreID, userID, err := tfidentitystore.UserParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+		_, err = tfidentitystore.FindUserByTwoPartKey(context.Background(), conn, identityStoreID, userID)
+
+		return err
+	}
+}
+
+func testAccUserConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = %[1]q
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+`, rName)
+}
+
+func testAccUserConfig_full(rName, streetAddress, contactType, email, phoneNumber string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name       = "Acceptance Test"
+  locale             = "en-US"
+  nickname           = "Johnny"
+  preferred_language = "en"
+  timezone           = "America/Los_Angeles"
+  title              = "Engineer"
+  user_name          = %[1]q
+  user_type          = "Employee"
+
+  name {
+    family_name      = "Doe"
+    given_name       = "John"
+    honorific_prefix = "Dr."
+    middle_name      = "Quincy"
+  }
+
+  addresses {
+    country        = "US"
+    locality       = "Seattle"
+    primary        = true
+    street_address = %[2]q
+    type           = %[3]q
+  }
+
+  emails {
+    primary = true
+    type    = %[3]q
+    value   = %[4]q
+  }
+
+  phone_numbers {
+    primary = true
+    type    = %[3]q
+    value   = %[5]q
+  }
+}
+`, rName, streetAddress, contactType, email, phoneNumber)
+}
@@ -0,0 +1,94 @@
+package identitystore
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+				),
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_ids": externalIDsSchema(),
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.ListUsersInput{
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+	var users []interface{}
+
+	err := conn.ListUsersPagesWithContext(ctx, input, func(page *identitystore.ListUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, user := range page.Users {
+			if user == nil {
+				continue
+			}
+
+			users = append(users, map[string]interface{}{
+				"display_name": aws.StringValue(user.DisplayName),
+				"external_ids": flattenExternalIDs(user.ExternalIds),
+				"user_id":      aws.StringValue(user.UserId),
+				"user_name":    aws.StringValue(user.UserName),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("error listing Identity Store Users (%s): %s", identityStoreID, err)
+	}
+
+	d.SetId(identityStoreID)
+
+	if err := d.Set("users", users); err != nil {
+		return diag.Errorf("error setting users: %s", err)
+	}
+
+	return nil
+}
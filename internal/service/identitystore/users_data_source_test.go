@@ -0,0 +1,43 @@
+package identitystore_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIdentityStoreUsersDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_users.test"
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck: acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_store_id", "data.aws_ssoadmin_instances.test", "identity_store_ids.0"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "users.*.user_id", resourceName, "user_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "users.*", map[string]string{
+						"display_name": "Acceptance Test",
+						"user_name":    rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUserConfig_basic(rName), `
+data "aws_identitystore_users" "test" {
+  identity_store_id = aws_identitystore_user.test.identity_store_id
+}
+`)
+}
@@ -0,0 +1,251 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3StorageSchema(),
+						"service_managed_s3":  emptySchema(),
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ChannelStorage = expandChannelStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Channel: %s", input)
+	_, err := conn.CreateChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Analytics Channel (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channel, err := FindChannelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+	d.Set("name", channel.Name)
+	if channel.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(channel.RetentionPeriod)}); err != nil {
+			return diag.Errorf("error setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+	if channel.Storage != nil {
+		if err := d.Set("storage", []interface{}{flattenChannelStorage(channel.Storage)}); err != nil {
+			return diag.Errorf("error setting storage: %s", err)
+		}
+	} else {
+		d.Set("storage", nil)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ChannelStorage = expandChannelStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Channel: %s", input)
+		_, err := conn.UpdateChannelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Analytics Channel (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating IoT Analytics Channel (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannelWithContext(ctx, &iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Analytics Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandChannelStorage(tfMap map[string]interface{}) *iotanalytics.ChannelStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.ChannelStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedChannelS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedChannelS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenChannelStorage(apiObject *iotanalytics.ChannelStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = make([]map[string]interface{}, 1)
+	}
+
+	return tfMap
+}
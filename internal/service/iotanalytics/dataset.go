@@ -0,0 +1,1076 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"action.0.container_action", "action.0.query_action"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotanalytics.ComputeType_Values(), false),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validName,
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"query_action": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"action.0.container_action", "action.0.query_action"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iot_events_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"s3_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 255),
+												},
+												"glue_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"database_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+															"table_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+														},
+													},
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"entry_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"late_data_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delta_time_session_window_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timeout_in_minutes": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 60),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"rule_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validName,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"tags":             tftags.TagsSchema(),
+			"tags_all":         tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+								},
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 1000),
+							ConflictsWith: []string{"versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"versioning_configuration.0.max_versions"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:     expandDatasetActions(d.Get("action").([]interface{})),
+		DatasetName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("content_delivery_rule"); ok && len(v.([]interface{})) > 0 {
+		input.ContentDeliveryRules = expandDatasetContentDeliveryRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("late_data_rule"); ok && len(v.([]interface{})) > 0 {
+		input.LateDataRules = expandLateDataRules(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		input.Triggers = expandDatasetTriggers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("versioning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VersioningConfiguration = expandVersioningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Dataset: %s", input)
+	_, err := conn.CreateDatasetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Analytics Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDatasetRead(ctx, d, meta)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	dataset, err := FindDatasetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenDatasetActions(dataset.Actions)); err != nil {
+		return diag.Errorf("error setting action: %s", err)
+	}
+	arn := aws.StringValue(dataset.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("content_delivery_rule", flattenDatasetContentDeliveryRules(dataset.ContentDeliveryRules)); err != nil {
+		return diag.Errorf("error setting content_delivery_rule: %s", err)
+	}
+	if err := d.Set("late_data_rule", flattenLateDataRules(dataset.LateDataRules)); err != nil {
+		return diag.Errorf("error setting late_data_rule: %s", err)
+	}
+	d.Set("name", dataset.Name)
+	if dataset.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(dataset.RetentionPeriod)}); err != nil {
+			return diag.Errorf("error setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+	if err := d.Set("trigger", flattenDatasetTriggers(dataset.Triggers)); err != nil {
+		return diag.Errorf("error setting trigger: %s", err)
+	}
+	if dataset.VersioningConfiguration != nil {
+		if err := d.Set("versioning_configuration", []interface{}{flattenVersioningConfiguration(dataset.VersioningConfiguration)}); err != nil {
+			return diag.Errorf("error setting versioning_configuration: %s", err)
+		}
+	} else {
+		d.Set("versioning_configuration", nil)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:     expandDatasetActions(d.Get("action").([]interface{})),
+			DatasetName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("content_delivery_rule"); ok && len(v.([]interface{})) > 0 {
+			input.ContentDeliveryRules = expandDatasetContentDeliveryRules(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("late_data_rule"); ok && len(v.([]interface{})) > 0 {
+			input.LateDataRules = expandLateDataRules(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+			input.Triggers = expandDatasetTriggers(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("versioning_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.VersioningConfiguration = expandVersioningConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Dataset: %s", input)
+		_, err := conn.UpdateDatasetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Analytics Dataset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating IoT Analytics Dataset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatasetRead(ctx, d, meta)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Dataset: %s", d.Id())
+	_, err := conn.DeleteDatasetWithContext(ctx, &iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Analytics Dataset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDatasetAction(tfMap map[string]interface{}) *iotanalytics.DatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatasetAction{
+		ActionName: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["container_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerAction = expandContainerDatasetAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["query_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.QueryAction = expandSQLQueryDatasetAction(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDatasetActions(tfList []interface{}) []*iotanalytics.DatasetAction {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotanalytics.DatasetAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandDatasetAction(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDatasetAction(tfMap map[string]interface{}) *iotanalytics.ContainerDatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.ContainerDatasetAction{
+		ExecutionRoleArn: aws.String(tfMap["execution_role_arn"].(string)),
+		Image:            aws.String(tfMap["image"].(string)),
+	}
+
+	if v, ok := tfMap["resource_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ResourceConfiguration = &iotanalytics.ResourceConfiguration{
+			ComputeType:    aws.String(tfMap["compute_type"].(string)),
+			VolumeSizeInGB: aws.Int64(int64(tfMap["volume_size_in_gb"].(int))),
+		}
+	}
+
+	for _, tfMapRaw := range tfMap["variable"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		variable := &iotanalytics.Variable{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["dataset_content_version_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.DatasetContentVersionValue = &iotanalytics.DatasetContentVersionValue{
+				DatasetName: aws.String(v[0].(map[string]interface{})["dataset_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+			variable.DoubleValue = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["output_file_uri_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			variable.OutputFileUriValue = &iotanalytics.OutputFileUriValue{
+				FileName: aws.String(v[0].(map[string]interface{})["file_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			variable.StringValue = aws.String(v)
+		}
+
+		apiObject.Variables = append(apiObject.Variables, variable)
+	}
+
+	return apiObject
+}
+
+func expandSQLQueryDatasetAction(tfMap map[string]interface{}) *iotanalytics.SqlQueryDatasetAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.SqlQueryDatasetAction{
+		SqlQuery: aws.String(tfMap["sql_query"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["filter"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter := &iotanalytics.QueryFilter{}
+
+		if v, ok := tfMap["delta_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			filter.DeltaTime = &iotanalytics.DeltaTime{
+				OffsetSeconds:  aws.Int64(int64(tfMap["offset_seconds"].(int))),
+				TimeExpression: aws.String(tfMap["time_expression"].(string)),
+			}
+		}
+
+		apiObject.Filters = append(apiObject.Filters, filter)
+	}
+
+	return apiObject
+}
+
+func expandDatasetContentDeliveryRules(tfList []interface{}) []*iotanalytics.DatasetContentDeliveryRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotanalytics.DatasetContentDeliveryRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetContentDeliveryRule{
+			Destination: &iotanalytics.DatasetContentDeliveryDestination{},
+		}
+
+		if v, ok := tfMap["destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["iot_events_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Destination.IotEventsDestinationConfiguration = &iotanalytics.IotEventsDestinationConfiguration{
+					InputName: aws.String(tfMap["input_name"].(string)),
+					RoleArn:   aws.String(tfMap["role_arn"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["s3_destination_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Destination.S3DestinationConfiguration = &iotanalytics.S3DestinationConfiguration{
+					Bucket:  aws.String(tfMap["bucket"].(string)),
+					Key:     aws.String(tfMap["key"].(string)),
+					RoleArn: aws.String(tfMap["role_arn"].(string)),
+				}
+
+				if v, ok := tfMap["glue_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					tfMap := v[0].(map[string]interface{})
+
+					apiObject.Destination.S3DestinationConfiguration.GlueConfiguration = &iotanalytics.GlueConfiguration{
+						DatabaseName: aws.String(tfMap["database_name"].(string)),
+						TableName:    aws.String(tfMap["table_name"].(string)),
+					}
+				}
+			}
+		}
+
+		if v, ok := tfMap["entry_name"].(string); ok && v != "" {
+			apiObject.EntryName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLateDataRules(tfList []interface{}) []*iotanalytics.LateDataRule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotanalytics.LateDataRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.LateDataRule{
+			RuleConfiguration: &iotanalytics.LateDataRuleConfiguration{},
+		}
+
+		if v, ok := tfMap["rule_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["delta_time_session_window_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.RuleConfiguration.DeltaTimeSessionWindowConfiguration = &iotanalytics.DeltaTimeSessionWindowConfiguration{
+					TimeoutInMinutes: aws.Int64(int64(v[0].(map[string]interface{})["timeout_in_minutes"].(int))),
+				}
+			}
+		}
+
+		if v, ok := tfMap["rule_name"].(string); ok && v != "" {
+			apiObject.RuleName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDatasetTriggers(tfList []interface{}) []*iotanalytics.DatasetTrigger {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotanalytics.DatasetTrigger
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotanalytics.DatasetTrigger{}
+
+		if v, ok := tfMap["dataset"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Dataset = &iotanalytics.TriggeringDataset{
+				Name: aws.String(v[0].(map[string]interface{})["name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["schedule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Schedule = &iotanalytics.Schedule{
+				Expression: aws.String(v[0].(map[string]interface{})["expression"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVersioningConfiguration(tfMap map[string]interface{}) *iotanalytics.VersioningConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.VersioningConfiguration{}
+
+	if v, ok := tfMap["max_versions"].(int); ok && v != 0 {
+		apiObject.MaxVersions = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenDatasetActions(apiObjects []*iotanalytics.DatasetAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.ActionName),
+		}
+
+		if v := apiObject.ContainerAction; v != nil {
+			tfMap["container_action"] = []interface{}{flattenContainerDatasetAction(v)}
+		}
+
+		if v := apiObject.QueryAction; v != nil {
+			tfMap["query_action"] = []interface{}{flattenSQLQueryDatasetAction(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDatasetAction(apiObject *iotanalytics.ContainerDatasetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"execution_role_arn": aws.StringValue(apiObject.ExecutionRoleArn),
+		"image":              aws.StringValue(apiObject.Image),
+	}
+
+	if v := apiObject.ResourceConfiguration; v != nil {
+		tfMap["resource_configuration"] = []interface{}{map[string]interface{}{
+			"compute_type":      aws.StringValue(v.ComputeType),
+			"volume_size_in_gb": aws.Int64Value(v.VolumeSizeInGB),
+		}}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Variables {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"double_value": aws.Float64Value(apiObject.DoubleValue),
+			"name":         aws.StringValue(apiObject.Name),
+			"string_value": aws.StringValue(apiObject.StringValue),
+		}
+
+		if v := apiObject.DatasetContentVersionValue; v != nil {
+			tfMap["dataset_content_version_value"] = []interface{}{map[string]interface{}{
+				"dataset_name": aws.StringValue(v.DatasetName),
+			}}
+		}
+
+		if v := apiObject.OutputFileUriValue; v != nil {
+			tfMap["output_file_uri_value"] = []interface{}{map[string]interface{}{
+				"file_name": aws.StringValue(v.FileName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	tfMap["variable"] = tfList
+
+	return tfMap
+}
+
+func flattenSQLQueryDatasetAction(apiObject *iotanalytics.SqlQueryDatasetAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sql_query": aws.StringValue(apiObject.SqlQuery),
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Filters {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.DeltaTime; v != nil {
+			tfMap["delta_time"] = []interface{}{map[string]interface{}{
+				"offset_seconds":  aws.Int64Value(v.OffsetSeconds),
+				"time_expression": aws.StringValue(v.TimeExpression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	tfMap["filter"] = tfList
+
+	return tfMap
+}
+
+func flattenDatasetContentDeliveryRules(apiObjects []*iotanalytics.DatasetContentDeliveryRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"entry_name": aws.StringValue(apiObject.EntryName),
+		}
+
+		if v := apiObject.Destination; v != nil {
+			tfDestinationMap := map[string]interface{}{}
+
+			if v := v.IotEventsDestinationConfiguration; v != nil {
+				tfDestinationMap["iot_events_destination_configuration"] = []interface{}{map[string]interface{}{
+					"input_name": aws.StringValue(v.InputName),
+					"role_arn":   aws.StringValue(v.RoleArn),
+				}}
+			}
+
+			if v := v.S3DestinationConfiguration; v != nil {
+				tfS3Map := map[string]interface{}{
+					"bucket":   aws.StringValue(v.Bucket),
+					"key":      aws.StringValue(v.Key),
+					"role_arn": aws.StringValue(v.RoleArn),
+				}
+
+				if v := v.GlueConfiguration; v != nil {
+					tfS3Map["glue_configuration"] = []interface{}{map[string]interface{}{
+						"database_name": aws.StringValue(v.DatabaseName),
+						"table_name":    aws.StringValue(v.TableName),
+					}}
+				}
+
+				tfDestinationMap["s3_destination_configuration"] = []interface{}{tfS3Map}
+			}
+
+			tfMap["destination"] = []interface{}{tfDestinationMap}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenLateDataRules(apiObjects []*iotanalytics.LateDataRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"rule_name": aws.StringValue(apiObject.RuleName),
+		}
+
+		if v := apiObject.RuleConfiguration; v != nil && v.DeltaTimeSessionWindowConfiguration != nil {
+			tfMap["rule_configuration"] = []interface{}{map[string]interface{}{
+				"delta_time_session_window_configuration": []interface{}{map[string]interface{}{
+					"timeout_in_minutes": aws.Int64Value(v.DeltaTimeSessionWindowConfiguration.TimeoutInMinutes),
+				}},
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDatasetTriggers(apiObjects []*iotanalytics.DatasetTrigger) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Dataset; v != nil {
+			tfMap["dataset"] = []interface{}{map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := apiObject.Schedule; v != nil {
+			tfMap["schedule"] = []interface{}{map[string]interface{}{
+				"expression": aws.StringValue(v.Expression),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVersioningConfiguration(apiObject *iotanalytics.VersioningConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unlimited": aws.BoolValue(apiObject.Unlimited),
+	}
+
+	if v := apiObject.MaxVersions; v != nil {
+		tfMap["max_versions"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,244 @@
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsDataset_basic(t *testing.T) {
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.container_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.name", "query"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.sql_query", fmt.Sprintf("SELECT * FROM %s", rName)),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("dataset/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_disappears(t *testing.T) {
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotanalytics.ResourceDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_update(t *testing.T) {
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.offset_seconds", "-60"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.time_expression", "from_unixtime(event_time)"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.key", "dataset/!{iotanalytics:scheduleTime}.csv"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.0.rule_configuration.0.delta_time_session_window_configuration.0.timeout_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.max_versions", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_dataset" {
+			continue
+		}
+
+		_, err := tfiotanalytics.FindDatasetByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Dataset %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDatasetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Dataset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn
+
+		_, err := tfiotanalytics.FindDatasetByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDatasetConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccS3StorageBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+
+      filter {
+        delta_time {
+          offset_seconds  = -60
+          time_expression = "from_unixtime(event_time)"
+        }
+      }
+    }
+  }
+
+  content_delivery_rule {
+    destination {
+      s3_destination_configuration {
+        bucket   = aws_s3_bucket.test.bucket
+        key      = "dataset/!{iotanalytics:scheduleTime}.csv"
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  late_data_rule {
+    rule_configuration {
+      delta_time_session_window_configuration {
+        timeout_in_minutes = 10
+      }
+    }
+  }
+
+  retention_period {
+    number_of_days = 7
+  }
+
+  trigger {
+    schedule {
+      expression = "rate(1 hour)"
+    }
+  }
+
+  versioning_configuration {
+    max_versions = 5
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
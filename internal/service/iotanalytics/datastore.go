@@ -0,0 +1,556 @@
+package iotanalytics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatastoreCreate,
+		ReadWithoutTimeout:   resourceDatastoreRead,
+		UpdateWithoutTimeout: resourceDatastoreUpdate,
+		DeleteWithoutTimeout: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_partitions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 25,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+									"timestamp_partition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attribute_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"timestamp_format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"file_format_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"parquet_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schema_definition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 100,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+															"type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringLenBetween(1, 131072),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"retention_period": retentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": customerManagedS3StorageSchema(),
+						"service_managed_s3":  emptySchema(),
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("datastore_partitions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastorePartitions = expandDatastorePartitions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("file_format_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastoreStorage = expandDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Datastore: %s", input)
+	_, err := conn.CreateDatastoreWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Analytics Datastore (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDatastoreRead(ctx, d, meta)
+}
+
+func resourceDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	datastore, err := FindDatastoreByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(datastore.Arn)
+	d.Set("arn", arn)
+	if datastore.DatastorePartitions != nil && len(datastore.DatastorePartitions.Partitions) > 0 {
+		if err := d.Set("datastore_partitions", []interface{}{flattenDatastorePartitions(datastore.DatastorePartitions)}); err != nil {
+			return diag.Errorf("error setting datastore_partitions: %s", err)
+		}
+	} else {
+		d.Set("datastore_partitions", nil)
+	}
+	if datastore.FileFormatConfiguration != nil {
+		if err := d.Set("file_format_configuration", []interface{}{flattenFileFormatConfiguration(datastore.FileFormatConfiguration)}); err != nil {
+			return diag.Errorf("error setting file_format_configuration: %s", err)
+		}
+	} else {
+		d.Set("file_format_configuration", nil)
+	}
+	d.Set("name", datastore.Name)
+	if datastore.RetentionPeriod != nil {
+		if err := d.Set("retention_period", []interface{}{flattenRetentionPeriod(datastore.RetentionPeriod)}); err != nil {
+			return diag.Errorf("error setting retention_period: %s", err)
+		}
+	} else {
+		d.Set("retention_period", nil)
+	}
+	if datastore.Storage != nil {
+		if err := d.Set("storage", []interface{}{flattenDatastoreStorage(datastore.Storage)}); err != nil {
+			return diag.Errorf("error setting storage: %s", err)
+		}
+	} else {
+		d.Set("storage", nil)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("file_format_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.FileFormatConfiguration = expandFileFormatConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DatastoreStorage = expandDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Datastore: %s", input)
+		_, err := conn.UpdateDatastoreWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Analytics Datastore (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating IoT Analytics Datastore (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDatastoreRead(ctx, d, meta)
+}
+
+func resourceDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastoreWithContext(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Analytics Datastore (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDatastoreStorage(tfMap map[string]interface{}) *iotanalytics.DatastoreStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastoreStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedDatastoreS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedDatastoreS3Storage{}
+	}
+
+	return apiObject
+}
+
+func expandFileFormatConfiguration(tfMap map[string]interface{}) *iotanalytics.FileFormatConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.FileFormatConfiguration{}
+
+	if v, ok := tfMap["json_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.JsonConfiguration = &iotanalytics.JsonConfiguration{}
+	}
+
+	if v, ok := tfMap["parquet_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ParquetConfiguration = &iotanalytics.ParquetConfiguration{}
+
+		if v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["schema_definition"].([]interface{}); ok && len(v) > 0 {
+				apiObject.ParquetConfiguration.SchemaDefinition = &iotanalytics.SchemaDefinition{}
+
+				if v[0] != nil {
+					for _, tfMapRaw := range v[0].(map[string]interface{})["column"].([]interface{}) {
+						tfMap, ok := tfMapRaw.(map[string]interface{})
+
+						if !ok {
+							continue
+						}
+
+						apiObject.ParquetConfiguration.SchemaDefinition.Columns = append(apiObject.ParquetConfiguration.SchemaDefinition.Columns, &iotanalytics.Column{
+							Name: aws.String(tfMap["name"].(string)),
+							Type: aws.String(tfMap["type"].(string)),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandDatastorePartitions(tfMap map[string]interface{}) *iotanalytics.DatastorePartitions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastorePartitions{}
+
+	for _, tfMapRaw := range tfMap["partition"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		partition := &iotanalytics.DatastorePartition{}
+
+		if v, ok := tfMap["attribute_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			partition.AttributePartition = &iotanalytics.Partition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["timestamp_partition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			partition.TimestampPartition = &iotanalytics.TimestampPartition{
+				AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			}
+
+			if v, ok := tfMap["timestamp_format"].(string); ok && v != "" {
+				partition.TimestampPartition.TimestampFormat = aws.String(v)
+			}
+		}
+
+		apiObject.Partitions = append(apiObject.Partitions, partition)
+	}
+
+	return apiObject
+}
+
+func flattenDatastoreStorage(apiObject *iotanalytics.DatastoreStorage) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+			"role_arn":   aws.StringValue(v.RoleArn),
+		}}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = make([]map[string]interface{}, 1)
+	}
+
+	return tfMap
+}
+
+func flattenFileFormatConfiguration(apiObject *iotanalytics.FileFormatConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.JsonConfiguration != nil {
+		tfMap["json_configuration"] = make([]map[string]interface{}, 1)
+	}
+
+	if v := apiObject.ParquetConfiguration; v != nil {
+		tfParquetMap := map[string]interface{}{}
+
+		if v := v.SchemaDefinition; v != nil {
+			var tfList []interface{}
+
+			for _, apiObject := range v.Columns {
+				if apiObject == nil {
+					continue
+				}
+
+				tfList = append(tfList, map[string]interface{}{
+					"name": aws.StringValue(apiObject.Name),
+					"type": aws.StringValue(apiObject.Type),
+				})
+			}
+
+			tfParquetMap["schema_definition"] = []interface{}{map[string]interface{}{
+				"column": tfList,
+			}}
+		}
+
+		tfMap["parquet_configuration"] = []interface{}{tfParquetMap}
+	}
+
+	return tfMap
+}
+
+func flattenDatastorePartitions(apiObject *iotanalytics.DatastorePartitions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Partitions {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.AttributePartition; v != nil {
+			tfMap["attribute_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name": aws.StringValue(v.AttributeName),
+			}}
+		}
+
+		if v := apiObject.TimestampPartition; v != nil {
+			tfMap["timestamp_partition"] = []interface{}{map[string]interface{}{
+				"attribute_name":   aws.StringValue(v.AttributeName),
+				"timestamp_format": aws.StringValue(v.TimestampFormat),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	tfMap := map[string]interface{}{
+		"partition": tfList,
+	}
+
+	return tfMap
+}
@@ -0,0 +1,248 @@
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsDatastore_basic(t *testing.T) {
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("datastore/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_disappears(t *testing.T) {
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotanalytics.ResourceDatastore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_parquet(t *testing.T) {
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_parquet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.0.attribute_partition.0.attribute_name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.1.timestamp_partition.0.attribute_name", "event_time"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.1.timestamp_partition.0.timestamp_format", "yyyy-MM-dd HH:mm:ss"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.json_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.type", "string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_retentionPeriod(t *testing.T) {
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDatastoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_retentionPeriod(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatastoreConfig_retentionPeriod(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatastoreExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatastoreDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_datastore" {
+			continue
+		}
+
+		_, err := tfiotanalytics.FindDatastoreByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Datastore %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDatastoreExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Datastore ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn
+
+		_, err := tfiotanalytics.FindDatastoreByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_parquet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  file_format_configuration {
+    parquet_configuration {
+      schema_definition {
+        column {
+          name = "device_id"
+          type = "string"
+        }
+
+        column {
+          name = "event_time"
+          type = "string"
+        }
+
+        column {
+          name = "temperature"
+          type = "double"
+        }
+      }
+    }
+  }
+
+  datastore_partitions {
+    partition {
+      attribute_partition {
+        attribute_name = "device_id"
+      }
+    }
+
+    partition {
+      timestamp_partition {
+        attribute_name   = "event_time"
+        timestamp_format = "yyyy-MM-dd HH:mm:ss"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_retentionPeriod(rName string, numberOfDays int) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+}
+`, rName, numberOfDays)
+}
@@ -0,0 +1,111 @@
+package iotanalytics
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindChannelByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Channel, nil
+}
+
+func FindDatastoreByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastoreWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+func FindPipelineByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipelineWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+func FindDatasetByName(ctx context.Context, conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatasetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dataset, nil
+}
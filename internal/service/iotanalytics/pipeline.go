@@ -0,0 +1,759 @@
+package iotanalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// pipelineActivityTypes lists the activity blocks. Every activity type except
+// datastore, which is always the end of the chain, supports a next attribute.
+var pipelineActivityTypes = []string{
+	"add_attributes",
+	"channel",
+	"datastore",
+	"device_registry_enrich",
+	"device_shadow_enrich",
+	"filter",
+	"lambda",
+	"math",
+	"remove_attributes",
+	"select_attributes",
+}
+
+func ResourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineCreate,
+		ReadWithoutTimeout:   resourcePipelineRead,
+		UpdateWithoutTimeout: resourcePipelineUpdate,
+		DeleteWithoutTimeout: resourcePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourcePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+						"channel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validName,
+									},
+									"name": pipelineActivityNameSchema(),
+								},
+							},
+						},
+						"device_registry_enrich": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"thing_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"device_shadow_enrich": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"thing_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"batch_size": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"lambda_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+						"math": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"math": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+						"remove_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+						"select_attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+									"name": pipelineActivityNameSchema(),
+									"next": pipelineActivityNextSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func pipelineActivityNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func pipelineActivityNextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+}
+
+func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+		PipelineName:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Pipeline: %s", input)
+	_, err := conn.CreatePipelineWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Analytics Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	pipeline, err := FindPipelineByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenPipelineActivities(pipeline.Activities)); err != nil {
+		return diag.Errorf("error setting activity: %s", err)
+	}
+	arn := aws.StringValue(pipeline.Arn)
+	d.Set("arn", arn)
+	d.Set("name", pipeline.Name)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	if d.HasChange("activity") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandPipelineActivities(d.Get("activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Pipeline: %s", input)
+		_, err := conn.UpdatePipelineWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Analytics Pipeline (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating IoT Analytics Pipeline (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePipelineRead(ctx, d, meta)
+}
+
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTAnalyticsConn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Pipeline: %s", d.Id())
+	_, err := conn.DeletePipelineWithContext(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Analytics Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourcePipelineCustomizeDiff rejects activity chains that do not have
+// exactly one channel and one datastore activity, or in which a next attribute
+// does not name another activity of the pipeline or forms a cycle.
+func resourcePipelineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ValidatePipelineActivities(diff.Get("activity").([]interface{}))
+}
+
+func ValidatePipelineActivities(tfList []interface{}) error {
+	counts := make(map[string]int)
+	nexts := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, activityType := range pipelineActivityTypes {
+			if v, ok := tfMap[activityType].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				counts[activityType]++
+			}
+		}
+	}
+
+	for _, activityType := range []string{"channel", "datastore"} {
+		if n := counts[activityType]; n != 1 {
+			return fmt.Errorf("pipeline must have exactly one %s activity, got %d", activityType, n)
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, activityType := range pipelineActivityTypes {
+			v, ok := tfMap[activityType].([]interface{})
+
+			if !ok || len(v) == 0 || v[0] == nil {
+				continue
+			}
+
+			tfMap := v[0].(map[string]interface{})
+			name := tfMap["name"].(string)
+
+			// Skip validation until all activity names are known.
+			if name == "" {
+				return nil
+			}
+
+			if _, ok := nexts[name]; ok {
+				return fmt.Errorf("duplicate activity name: %s", name)
+			}
+
+			nexts[name], _ = tfMap["next"].(string)
+		}
+	}
+
+	for _, next := range nexts {
+		if next == "" {
+			continue
+		}
+
+		if _, ok := nexts[next]; !ok {
+			return fmt.Errorf("activity next (%s) does not refer to an activity in the pipeline", next)
+		}
+	}
+
+	for name := range nexts {
+		visited := map[string]struct{}{name: {}}
+
+		for next := nexts[name]; next != ""; next = nexts[next] {
+			if _, ok := visited[next]; ok {
+				return fmt.Errorf("activity next (%s) forms a cycle", next)
+			}
+
+			visited[next] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func expandPipelineActivity(tfMap map[string]interface{}) *iotanalytics.PipelineActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.PipelineActivity{}
+
+	if v, ok := tfMap["add_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AddAttributes = &iotanalytics.AddAttributesActivity{
+			Attributes: flex.ExpandStringMap(tfMap["attributes"].(map[string]interface{})),
+			Name:       aws.String(tfMap["name"].(string)),
+			Next:       expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Channel = &iotanalytics.ChannelActivity{
+			ChannelName: aws.String(tfMap["channel_name"].(string)),
+			Name:        aws.String(tfMap["name"].(string)),
+			Next:        expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["datastore"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Datastore = &iotanalytics.DatastoreActivity{
+			DatastoreName: aws.String(tfMap["datastore_name"].(string)),
+			Name:          aws.String(tfMap["name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["device_registry_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			Next:      expandPipelineActivityNext(tfMap),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+			ThingName: aws.String(tfMap["thing_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["device_shadow_enrich"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			Next:      expandPipelineActivityNext(tfMap),
+			RoleArn:   aws.String(tfMap["role_arn"].(string)),
+			ThingName: aws.String(tfMap["thing_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Filter = &iotanalytics.FilterActivity{
+			Filter: aws.String(tfMap["filter"].(string)),
+			Name:   aws.String(tfMap["name"].(string)),
+			Next:   expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Lambda = &iotanalytics.LambdaActivity{
+			BatchSize:  aws.Int64(int64(tfMap["batch_size"].(int))),
+			LambdaName: aws.String(tfMap["lambda_name"].(string)),
+			Name:       aws.String(tfMap["name"].(string)),
+			Next:       expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["math"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Math = &iotanalytics.MathActivity{
+			Attribute: aws.String(tfMap["attribute"].(string)),
+			Math:      aws.String(tfMap["math"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			Next:      expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["remove_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+			Attributes: flex.ExpandStringList(tfMap["attributes"].([]interface{})),
+			Name:       aws.String(tfMap["name"].(string)),
+			Next:       expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["select_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+			Attributes: flex.ExpandStringList(tfMap["attributes"].([]interface{})),
+			Name:       aws.String(tfMap["name"].(string)),
+			Next:       expandPipelineActivityNext(tfMap),
+		}
+	}
+
+	return apiObject
+}
+
+func expandPipelineActivities(tfList []interface{}) []*iotanalytics.PipelineActivity {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotanalytics.PipelineActivity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandPipelineActivity(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPipelineActivityNext(tfMap map[string]interface{}) *string {
+	if v, ok := tfMap["next"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenPipelineActivity(apiObject *iotanalytics.PipelineActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AddAttributes; v != nil {
+		tfMap["add_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueMap(v.Attributes),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Channel; v != nil {
+		tfMap["channel"] = []interface{}{map[string]interface{}{
+			"channel_name": aws.StringValue(v.ChannelName),
+			"name":         aws.StringValue(v.Name),
+			"next":         aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Datastore; v != nil {
+		tfMap["datastore"] = []interface{}{map[string]interface{}{
+			"datastore_name": aws.StringValue(v.DatastoreName),
+			"name":           aws.StringValue(v.Name),
+		}}
+	}
+
+	if v := apiObject.DeviceRegistryEnrich; v != nil {
+		tfMap["device_registry_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":  aws.StringValue(v.Attribute),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+			"role_arn":   aws.StringValue(v.RoleArn),
+			"thing_name": aws.StringValue(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.DeviceShadowEnrich; v != nil {
+		tfMap["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+			"attribute":  aws.StringValue(v.Attribute),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+			"role_arn":   aws.StringValue(v.RoleArn),
+			"thing_name": aws.StringValue(v.ThingName),
+		}}
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap["filter"] = []interface{}{map[string]interface{}{
+			"filter": aws.StringValue(v.Filter),
+			"name":   aws.StringValue(v.Name),
+			"next":   aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = []interface{}{map[string]interface{}{
+			"batch_size":  aws.Int64Value(v.BatchSize),
+			"lambda_name": aws.StringValue(v.LambdaName),
+			"name":        aws.StringValue(v.Name),
+			"next":        aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.Math; v != nil {
+		tfMap["math"] = []interface{}{map[string]interface{}{
+			"attribute": aws.StringValue(v.Attribute),
+			"math":      aws.StringValue(v.Math),
+			"name":      aws.StringValue(v.Name),
+			"next":      aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.RemoveAttributes; v != nil {
+		tfMap["remove_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueSlice(v.Attributes),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+		}}
+	}
+
+	if v := apiObject.SelectAttributes; v != nil {
+		tfMap["select_attributes"] = []interface{}{map[string]interface{}{
+			"attributes": aws.StringValueSlice(v.Attributes),
+			"name":       aws.StringValue(v.Name),
+			"next":       aws.StringValue(v.Next),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenPipelineActivities(apiObjects []*iotanalytics.PipelineActivity) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenPipelineActivity(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,403 @@
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTAnalyticsPipeline_basic(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "source"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "sink"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "sink"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_disappears(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotanalytics.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_activities(t *testing.T) {
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_activities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 0"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.temperature", "temperature_c"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.next", "sink"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_danglingNext(t *testing.T) {
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotanalytics.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotanalytics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfig_danglingNext(rName),
+				ExpectError: regexp.MustCompile(`activity next \(missing\) does not refer to an activity in the pipeline`),
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		_, err := tfiotanalytics.FindPipelineByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Pipeline %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPipelineExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsConn
+
+		_, err := tfiotanalytics.FindPipelineByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPipelineBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "source"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "sink"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "sink"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_activities(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "source"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "positive"
+    }
+  }
+
+  activity {
+    filter {
+      name   = "positive"
+      filter = "temperature > 0"
+      next   = "fahrenheit"
+    }
+  }
+
+  activity {
+    math {
+      name      = "fahrenheit"
+      attribute = "temperature_f"
+      math      = "temperature * 9 / 5 + 32"
+      next      = "rename"
+    }
+  }
+
+  activity {
+    add_attributes {
+      name = "rename"
+      next = "cleanup"
+
+      attributes = {
+        temperature = "temperature_c"
+      }
+    }
+  }
+
+  activity {
+    remove_attributes {
+      name       = "cleanup"
+      attributes = ["temperature"]
+      next       = "sink"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "sink"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_danglingNext(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "source"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "missing"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "sink"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func TestValidatePipelineActivities(t *testing.T) {
+	activity := func(activityType, name, next string) interface{} {
+		tfMap := map[string]interface{}{"name": name}
+
+		if activityType != "datastore" {
+			tfMap["next"] = next
+		}
+
+		return map[string]interface{}{activityType: []interface{}{tfMap}}
+	}
+
+	testCases := []struct {
+		TestName    string
+		Activities  []interface{}
+		ExpectError bool
+	}{
+		{
+			TestName: "valid",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "filter"),
+				activity("filter", "filter", "to_datastore"),
+				activity("datastore", "to_datastore", ""),
+			},
+		},
+		{
+			TestName: "missing channel",
+			Activities: []interface{}{
+				activity("filter", "filter", "to_datastore"),
+				activity("datastore", "to_datastore", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "missing datastore",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "filter"),
+				activity("filter", "filter", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "duplicate channel",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "to_datastore"),
+				activity("channel", "from_other_channel", "to_datastore"),
+				activity("datastore", "to_datastore", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "duplicate datastore",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "to_datastore"),
+				activity("datastore", "to_datastore", ""),
+				activity("datastore", "to_other_datastore", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "duplicate name",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "filter"),
+				activity("filter", "filter", "to_datastore"),
+				activity("math", "filter", "to_datastore"),
+				activity("datastore", "to_datastore", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "dangling next",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "filter"),
+				activity("filter", "filter", "missing"),
+				activity("datastore", "to_datastore", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "cycle",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "filter"),
+				activity("filter", "filter", "math"),
+				activity("math", "math", "filter"),
+				activity("datastore", "to_datastore", ""),
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "unknown name",
+			Activities: []interface{}{
+				activity("channel", "from_channel", "missing"),
+				activity("datastore", "", ""),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := tfiotanalytics.ValidatePipelineActivities(testCase.Activities)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package iotanalytics
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+func emptySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+func customerManagedS3StorageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+				"key_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9!_.*'()/{}:-]*/$`), "must end with a forward slash"),
+					),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func retentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					ConflictsWith: []string{"retention_period.0.number_of_days"},
+				},
+			},
+		},
+	}
+}
+
+func expandRetentionPeriod(tfMap map[string]interface{}) *iotanalytics.RetentionPeriod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v != 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unlimited": aws.BoolValue(apiObject.Unlimited),
+	}
+
+	if v := apiObject.NumberOfDays; v != nil {
+		tfMap["number_of_days"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
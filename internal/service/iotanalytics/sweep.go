@@ -0,0 +1,230 @@
+//go:build sweep
+// +build sweep
+
+package iotanalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_channel", &resource.Sweeper{
+		Name: "aws_iotanalytics_channel",
+		F:    sweepChannels,
+		Dependencies: []string{
+			"aws_iotanalytics_pipeline",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotanalytics_dataset", &resource.Sweeper{
+		Name: "aws_iotanalytics_dataset",
+		F:    sweepDatasets,
+	})
+
+	resource.AddTestSweepers("aws_iotanalytics_datastore", &resource.Sweeper{
+		Name: "aws_iotanalytics_datastore",
+		F:    sweepDatastores,
+		Dependencies: []string{
+			"aws_iotanalytics_dataset",
+			"aws_iotanalytics_pipeline",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iotanalytics_pipeline", &resource.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    sweepPipelines,
+	})
+}
+
+func sweepChannels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &iotanalytics.ListChannelsInput{}
+
+	err = conn.ListChannelsPagesWithContext(ctx, input, func(page *iotanalytics.ListChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ChannelSummaries {
+			r := ResourceChannel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChannelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Channels sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Channels (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Channels (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDatasets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &iotanalytics.ListDatasetsInput{}
+
+	err = conn.ListDatasetsPagesWithContext(ctx, input, func(page *iotanalytics.ListDatasetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatasetSummaries {
+			r := ResourceDataset()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Datasets sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Datasets (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Datasets (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDatastores(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &iotanalytics.ListDatastoresInput{}
+
+	err = conn.ListDatastoresPagesWithContext(ctx, input, func(page *iotanalytics.ListDatastoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatastoreSummaries {
+			r := ResourceDatastore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatastoreName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Datastores sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Datastores (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Datastores (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepPipelines(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTAnalyticsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &iotanalytics.ListPipelinesInput{}
+
+	err = conn.ListPipelinesPagesWithContext(ctx, input, func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PipelineSummaries {
+			r := ResourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Pipelines sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IoT Analytics Pipelines (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Analytics Pipelines (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,1324 @@
+package iotevents
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourceDetectorModelCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": detectorModelEventSchema(),
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": detectorModelEventSchema(),
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": detectorModelEventSchema(),
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": detectorModelActionSchema(),
+															"condition": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 512),
+															},
+															"event_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+															"next_state": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^((`+"`"+`[\w\- ]+`+"`"+`)|([\w\-]+))(\.((`+"`"+`[\w\- ]+`+"`"+`)|([\w\-]+)))*$`), "must be a dot-separated attribute path"),
+				),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func detectorModelEventSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": detectorModelActionSchema(),
+				"condition": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+				"event_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func detectorModelActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"clear_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"dynamodb": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hash_key_field": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"hash_key_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+							},
+							"hash_key_value": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"operation": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"DELETE", "INSERT", "UPDATE"}, false),
+							},
+							"payload": detectorModelPayloadSchema(),
+							"payload_field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"range_key_field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"range_key_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+							},
+							"range_key_value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"table_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"dynamodbv2": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": detectorModelPayloadSchema(),
+							"table_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"firehose": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delivery_stream_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"payload": detectorModelPayloadSchema(),
+							"separator": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+							},
+						},
+					},
+				},
+				"iot_events": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"input_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"payload": detectorModelPayloadSchema(),
+						},
+					},
+				},
+				"iot_topic_publish": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mqtt_topic": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"payload": detectorModelPayloadSchema(),
+						},
+					},
+				},
+				"lambda": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"function_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"payload": detectorModelPayloadSchema(),
+						},
+					},
+				},
+				"reset_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"set_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"duration_expression": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"timer_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"set_variable": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"variable_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"sns": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": detectorModelPayloadSchema(),
+							"target_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"sqs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": detectorModelPayloadSchema(),
+							"queue_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"use_base64": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func detectorModelPayloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: expandDetectorModelDefinition(d.Get("definition").([]interface{})[0].(map[string]interface{})),
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Detector Model: %s", input)
+	_, err := conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDetectorModelRead(ctx, d, meta)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	arn := aws.StringValue(configuration.DetectorModelArn)
+	d.Set("arn", arn)
+	if output.DetectorModelDefinition != nil {
+		if err := d.Set("definition", []interface{}{flattenDetectorModelDefinition(output.DetectorModelDefinition)}); err != nil {
+			return diag.Errorf("error setting definition: %s", err)
+		}
+	} else {
+		d.Set("definition", nil)
+	}
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  expandDetectorModelDefinition(d.Get("definition").([]interface{})[0].(map[string]interface{})),
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Detector Model: %s", input)
+		_, err := conn.UpdateDetectorModelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating IoT Events Detector Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDetectorModelRead(ctx, d, meta)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceDetectorModelCustomizeDiff rejects state machine definitions whose
+// initial state or transition targets do not name a state in the definition.
+// Names that are not yet known are skipped.
+func resourceDetectorModelCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.Get("definition").([]interface{})
+
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	return ValidateDetectorModelDefinition(v[0].(map[string]interface{}))
+}
+
+func ValidateDetectorModelDefinition(tfMap map[string]interface{}) error {
+	stateNames := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfMap["state"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["state_name"].(string)
+
+		if name == "" {
+			return nil
+		}
+
+		if _, ok := stateNames[name]; ok {
+			return fmt.Errorf("duplicate state_name: %s", name)
+		}
+
+		stateNames[name] = struct{}{}
+	}
+
+	if name := tfMap["initial_state_name"].(string); name != "" {
+		if _, ok := stateNames[name]; !ok {
+			return fmt.Errorf("initial_state_name (%s) does not refer to a defined state", name)
+		}
+	}
+
+	for _, tfMapRaw := range tfMap["state"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range tfMap["on_input"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for _, tfMapRaw := range tfMap["transition_event"].([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				if name := tfMap["next_state"].(string); name != "" {
+					if _, ok := stateNames[name]; !ok {
+						return fmt.Errorf("transition_event (%s) next_state (%s) does not refer to a defined state", tfMap["event_name"].(string), name)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandDetectorModelDefinition(tfMap map[string]interface{}) *iotevents.DetectorModelDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if v, ok := tfMap["initial_state_name"].(string); ok && v != "" {
+		apiObject.InitialStateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = expandStates(v)
+	}
+
+	return apiObject
+}
+
+func expandState(tfMap map[string]interface{}) *iotevents.State {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.State{}
+
+	if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+			Events: expandEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnExit = &iotevents.OnExitLifecycle{
+			Events: expandEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.OnInput = &iotevents.OnInputLifecycle{
+			Events:           expandEvents(tfMap["event"].([]interface{})),
+			TransitionEvents: expandTransitionEvents(tfMap["transition_event"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["state_name"].(string); ok && v != "" {
+		apiObject.StateName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandStates(tfList []interface{}) []*iotevents.State {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandState(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEvent(tfMap map[string]interface{}) *iotevents.Event {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Event{}
+
+	if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Actions = expandActions(v)
+	}
+
+	if v, ok := tfMap["condition"].(string); ok && v != "" {
+		apiObject.Condition = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_name"].(string); ok && v != "" {
+		apiObject.EventName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEvents(tfList []interface{}) []*iotevents.Event {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandEvent(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitionEvent(tfMap map[string]interface{}) *iotevents.TransitionEvent {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.TransitionEvent{}
+
+	if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Actions = expandActions(v)
+	}
+
+	if v, ok := tfMap["condition"].(string); ok && v != "" {
+		apiObject.Condition = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_name"].(string); ok && v != "" {
+		apiObject.EventName = aws.String(v)
+	}
+
+	if v, ok := tfMap["next_state"].(string); ok && v != "" {
+		apiObject.NextState = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandTransitionEvent(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAction(tfMap map[string]interface{}) *iotevents.ActionData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.ActionData{}
+
+	if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ClearTimer = &iotevents.ClearTimerAction{
+			TimerName: aws.String(tfMap["timer_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["dynamodb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DynamoDB = expandDynamoDBAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["dynamodbv2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DynamoDBv2 = &iotevents.DynamoDBv2Action{
+			Payload:   expandPayload(tfMap["payload"].([]interface{})),
+			TableName: aws.String(tfMap["table_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Firehose = &iotevents.FirehoseAction{
+			DeliveryStreamName: aws.String(tfMap["delivery_stream_name"].(string)),
+			Payload:            expandPayload(tfMap["payload"].([]interface{})),
+		}
+
+		if v, ok := tfMap["separator"].(string); ok && v != "" {
+			apiObject.Firehose.Separator = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.IotEvents = &iotevents.Action{
+			InputName: aws.String(tfMap["input_name"].(string)),
+			Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.IotTopicPublish = &iotevents.IotTopicPublishAction{
+			MqttTopic: aws.String(tfMap["mqtt_topic"].(string)),
+			Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Lambda = &iotevents.LambdaAction{
+			FunctionArn: aws.String(tfMap["function_arn"].(string)),
+			Payload:     expandPayload(tfMap["payload"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ResetTimer = &iotevents.ResetTimerAction{
+			TimerName: aws.String(tfMap["timer_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SetTimer = &iotevents.SetTimerAction{
+			DurationExpression: aws.String(tfMap["duration_expression"].(string)),
+			TimerName:          aws.String(tfMap["timer_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SetVariable = &iotevents.SetVariableAction{
+			Value:        aws.String(tfMap["value"].(string)),
+			VariableName: aws.String(tfMap["variable_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Sns = &iotevents.SNSTopicPublishAction{
+			Payload:   expandPayload(tfMap["payload"].([]interface{})),
+			TargetArn: aws.String(tfMap["target_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Sqs = &iotevents.SqsAction{
+			Payload:   expandPayload(tfMap["payload"].([]interface{})),
+			QueueUrl:  aws.String(tfMap["queue_url"].(string)),
+			UseBase64: aws.Bool(tfMap["use_base64"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandActions(tfList []interface{}) []*iotevents.ActionData {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandAction(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDynamoDBAction(tfMap map[string]interface{}) *iotevents.DynamoDBAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DynamoDBAction{
+		HashKeyField: aws.String(tfMap["hash_key_field"].(string)),
+		HashKeyValue: aws.String(tfMap["hash_key_value"].(string)),
+		Payload:      expandPayload(tfMap["payload"].([]interface{})),
+		TableName:    aws.String(tfMap["table_name"].(string)),
+	}
+
+	if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+		apiObject.HashKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation"].(string); ok && v != "" {
+		apiObject.Operation = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+		apiObject.PayloadField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+		apiObject.RangeKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+		apiObject.RangeKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+		apiObject.RangeKeyValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &iotevents.Payload{
+		ContentExpression: aws.String(tfMap["content_expression"].(string)),
+		Type:              aws.String(tfMap["type"].(string)),
+	}
+
+	return apiObject
+}
+
+func flattenDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"initial_state_name": aws.StringValue(apiObject.InitialStateName),
+		"state":              flattenStates(apiObject.States),
+	}
+
+	return tfMap
+}
+
+func flattenState(apiObject *iotevents.State) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"state_name": aws.StringValue(apiObject.StateName),
+	}
+
+	if v := apiObject.OnEnter; v != nil && len(v.Events) > 0 {
+		tfMap["on_enter"] = []interface{}{map[string]interface{}{
+			"event": flattenEvents(v.Events),
+		}}
+	}
+
+	if v := apiObject.OnExit; v != nil && len(v.Events) > 0 {
+		tfMap["on_exit"] = []interface{}{map[string]interface{}{
+			"event": flattenEvents(v.Events),
+		}}
+	}
+
+	if v := apiObject.OnInput; v != nil && (len(v.Events) > 0 || len(v.TransitionEvents) > 0) {
+		tfMap["on_input"] = []interface{}{map[string]interface{}{
+			"event":            flattenEvents(v.Events),
+			"transition_event": flattenTransitionEvents(v.TransitionEvents),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenStates(apiObjects []*iotevents.State) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenState(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenEvent(apiObject *iotevents.Event) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action":     flattenActions(apiObject.Actions),
+		"condition":  aws.StringValue(apiObject.Condition),
+		"event_name": aws.StringValue(apiObject.EventName),
+	}
+
+	return tfMap
+}
+
+func flattenEvents(apiObjects []*iotevents.Event) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenEvent(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTransitionEvent(apiObject *iotevents.TransitionEvent) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"action":     flattenActions(apiObject.Actions),
+		"condition":  aws.StringValue(apiObject.Condition),
+		"event_name": aws.StringValue(apiObject.EventName),
+		"next_state": aws.StringValue(apiObject.NextState),
+	}
+
+	return tfMap
+}
+
+func flattenTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTransitionEvent(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenAction(apiObject *iotevents.ActionData) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ClearTimer; v != nil {
+		tfMap["clear_timer"] = []interface{}{map[string]interface{}{
+			"timer_name": aws.StringValue(v.TimerName),
+		}}
+	}
+
+	if v := apiObject.DynamoDB; v != nil {
+		tfMap["dynamodb"] = []interface{}{map[string]interface{}{
+			"hash_key_field":  aws.StringValue(v.HashKeyField),
+			"hash_key_type":   aws.StringValue(v.HashKeyType),
+			"hash_key_value":  aws.StringValue(v.HashKeyValue),
+			"operation":       aws.StringValue(v.Operation),
+			"payload":         flattenPayload(v.Payload),
+			"payload_field":   aws.StringValue(v.PayloadField),
+			"range_key_field": aws.StringValue(v.RangeKeyField),
+			"range_key_type":  aws.StringValue(v.RangeKeyType),
+			"range_key_value": aws.StringValue(v.RangeKeyValue),
+			"table_name":      aws.StringValue(v.TableName),
+		}}
+	}
+
+	if v := apiObject.DynamoDBv2; v != nil {
+		tfMap["dynamodbv2"] = []interface{}{map[string]interface{}{
+			"payload":    flattenPayload(v.Payload),
+			"table_name": aws.StringValue(v.TableName),
+		}}
+	}
+
+	if v := apiObject.Firehose; v != nil {
+		tfMap["firehose"] = []interface{}{map[string]interface{}{
+			"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+			"payload":              flattenPayload(v.Payload),
+			"separator":            aws.StringValue(v.Separator),
+		}}
+	}
+
+	if v := apiObject.IotEvents; v != nil {
+		tfMap["iot_events"] = []interface{}{map[string]interface{}{
+			"input_name": aws.StringValue(v.InputName),
+			"payload":    flattenPayload(v.Payload),
+		}}
+	}
+
+	if v := apiObject.IotTopicPublish; v != nil {
+		tfMap["iot_topic_publish"] = []interface{}{map[string]interface{}{
+			"mqtt_topic": aws.StringValue(v.MqttTopic),
+			"payload":    flattenPayload(v.Payload),
+		}}
+	}
+
+	if v := apiObject.Lambda; v != nil {
+		tfMap["lambda"] = []interface{}{map[string]interface{}{
+			"function_arn": aws.StringValue(v.FunctionArn),
+			"payload":      flattenPayload(v.Payload),
+		}}
+	}
+
+	if v := apiObject.ResetTimer; v != nil {
+		tfMap["reset_timer"] = []interface{}{map[string]interface{}{
+			"timer_name": aws.StringValue(v.TimerName),
+		}}
+	}
+
+	if v := apiObject.SetTimer; v != nil {
+		tfMap["set_timer"] = []interface{}{map[string]interface{}{
+			"duration_expression": aws.StringValue(v.DurationExpression),
+			"timer_name":          aws.StringValue(v.TimerName),
+		}}
+	}
+
+	if v := apiObject.SetVariable; v != nil {
+		tfMap["set_variable"] = []interface{}{map[string]interface{}{
+			"value":         aws.StringValue(v.Value),
+			"variable_name": aws.StringValue(v.VariableName),
+		}}
+	}
+
+	if v := apiObject.Sns; v != nil {
+		tfMap["sns"] = []interface{}{map[string]interface{}{
+			"payload":    flattenPayload(v.Payload),
+			"target_arn": aws.StringValue(v.TargetArn),
+		}}
+	}
+
+	if v := apiObject.Sqs; v != nil {
+		tfMap["sqs"] = []interface{}{map[string]interface{}{
+			"payload":    flattenPayload(v.Payload),
+			"queue_url":  aws.StringValue(v.QueueUrl),
+			"use_base64": aws.BoolValue(v.UseBase64),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenActions(apiObjects []*iotevents.ActionData) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenAction(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"content_expression": aws.StringValue(apiObject.ContentExpression),
+		"type":               aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
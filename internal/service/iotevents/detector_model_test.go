@@ -0,0 +1,489 @@
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotevents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.initial_state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.0.next_state", "overheated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.state_name", "overheated"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.0.sns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotevents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfiotevents.ResourceDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotevents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.1.set_timer.0.timer_name", "cooldown"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_key(t *testing.T) {
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotevents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_key(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodSerial),
+					resource.TestCheckResourceAttr(resourceName, "key", "sensorId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_undefinedState(t *testing.T) {
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(iotevents.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iotevents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDetectorModelConfig_states(rName, "missing", "overheated"),
+				ExpectError: regexp.MustCompile(`initial_state_name \(missing\) does not refer to a defined state`),
+			},
+			{
+				Config:      testAccDetectorModelConfig_states(rName, "normal", "missing"),
+				ExpectError: regexp.MustCompile(`next_state \(missing\) does not refer to a defined state`),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		_, err := tfiotevents.FindDetectorModelByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDetectorModelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn
+
+		_, err := tfiotevents.FindDetectorModelByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDetectorModelBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sns:Publish"
+      Effect   = "Allow"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  definition {
+    attribute {
+      json_path = "sensorId"
+    }
+
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_states(rName, initialStateName, nextState string) string {
+	return acctest.ConfigCompose(testAccDetectorModelBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = %[2]q
+
+    state {
+      state_name = "normal"
+
+      on_input {
+        transition_event {
+          event_name = "to_overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 80"
+          next_state = %[3]q
+        }
+      }
+    }
+
+    state {
+      state_name = "overheated"
+
+      on_enter {
+        event {
+          event_name = "notify"
+
+          action {
+            sns {
+              target_arn = aws_sns_topic.test.arn
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "to_normal"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature <= 80"
+          next_state = "normal"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, initialStateName, nextState))
+}
+
+func testAccDetectorModelConfig_basic(rName string) string {
+	return testAccDetectorModelConfig_states(rName, "normal", "overheated")
+}
+
+func testAccDetectorModelConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_input {
+        transition_event {
+          event_name = "to_overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > 90"
+          next_state = "overheated"
+        }
+      }
+    }
+
+    state {
+      state_name = "overheated"
+
+      on_enter {
+        event {
+          event_name = "notify"
+
+          action {
+            sns {
+              target_arn = aws_sns_topic.test.arn
+
+              payload {
+                content_expression = "'overheated'"
+                type               = "STRING"
+              }
+            }
+          }
+
+          action {
+            set_timer {
+              timer_name          = "cooldown"
+              duration_expression = "300"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "to_normal"
+          condition  = "timeout(\"cooldown\")"
+          next_state = "normal"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDetectorModelConfig_key(rName string) string {
+	return acctest.ConfigCompose(testAccDetectorModelBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  evaluation_method = "SERIAL"
+  key               = "sensorId"
+
+  definition {
+    initial_state_name = "monitoring"
+
+    state {
+      state_name = "monitoring"
+
+      on_input {
+        event {
+          event_name = "record"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "lastTemperature"
+              value         = "$input.${aws_iotevents_input.test.name}.temperature"
+            }
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func TestValidateDetectorModelDefinition(t *testing.T) {
+	state := func(name string, nextStates ...string) interface{} {
+		transitions := make([]interface{}, 0, len(nextStates))
+
+		for _, v := range nextStates {
+			transitions = append(transitions, map[string]interface{}{
+				"event_name": "to_" + v,
+				"next_state": v,
+			})
+		}
+
+		return map[string]interface{}{
+			"state_name": name,
+			"on_input": []interface{}{map[string]interface{}{
+				"transition_event": transitions,
+			}},
+		}
+	}
+
+	testCases := []struct {
+		TestName         string
+		InitialStateName string
+		States           []interface{}
+		ExpectError      bool
+	}{
+		{
+			TestName:         "valid",
+			InitialStateName: "first",
+			States:           []interface{}{state("first", "second"), state("second", "first")},
+		},
+		{
+			TestName:         "unknown initial state",
+			InitialStateName: "third",
+			States:           []interface{}{state("first", "second"), state("second")},
+			ExpectError:      true,
+		},
+		{
+			TestName:         "duplicate state name",
+			InitialStateName: "first",
+			States:           []interface{}{state("first"), state("first")},
+			ExpectError:      true,
+		},
+		{
+			TestName:         "transition to undefined state",
+			InitialStateName: "first",
+			States:           []interface{}{state("first", "second"), state("second", "third")},
+			ExpectError:      true,
+		},
+		{
+			TestName:         "unknown state name",
+			InitialStateName: "third",
+			States:           []interface{}{state("first"), state("")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := tfiotevents.ValidateDetectorModelDefinition(map[string]interface{}{
+				"initial_state_name": testCase.InitialStateName,
+				"state":              testCase.States,
+			})
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package iotevents
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputCreate,
+		ReadWithoutTimeout:   resourceInputRead,
+		UpdateWithoutTimeout: resourceInputUpdate,
+		DeleteWithoutTimeout: resourceInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 200,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateInputInput{
+		InputDefinition: expandInputDefinition(d.Get("definition").([]interface{})[0].(map[string]interface{})),
+		InputName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.InputDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Input: %s", input)
+	_, err := conn.CreateInputWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating IoT Events Input (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitInputCreated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for IoT Events Input (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceInputRead(ctx, d, meta)
+}
+
+func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindInputByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.InputConfiguration.InputArn)
+	d.Set("arn", arn)
+	if output.InputDefinition != nil {
+		if err := d.Set("definition", []interface{}{flattenInputDefinition(output.InputDefinition)}); err != nil {
+			return diag.Errorf("error setting definition: %s", err)
+		}
+	} else {
+		d.Set("definition", nil)
+	}
+	d.Set("description", output.InputConfiguration.InputDescription)
+	d.Set("name", output.InputConfiguration.InputName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotevents.UpdateInputInput{
+			InputDefinition:  expandInputDefinition(d.Get("definition").([]interface{})[0].(map[string]interface{})),
+			InputDescription: aws.String(d.Get("description").(string)),
+			InputName:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Input: %s", input)
+		_, err := conn.UpdateInputWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating IoT Events Input (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInputUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for IoT Events Input (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating IoT Events Input (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceInputRead(ctx, d, meta)
+}
+
+func resourceInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IoTEventsConn
+
+	log.Printf("[DEBUG] Deleting IoT Events Input: %s", d.Id())
+	_, err := conn.DeleteInputWithContext(ctx, &iotevents.DeleteInputInput{
+		InputName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting IoT Events Input (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInputDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for IoT Events Input (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputDefinition(tfMap map[string]interface{}) *iotevents.InputDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.InputDefinition{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Attributes = append(apiObject.Attributes, &iotevents.Attribute{
+				JsonPath: aws.String(tfMap["json_path"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenInputDefinition(apiObject *iotevents.InputDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.Attributes {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"json_path": aws.StringValue(apiObject.JsonPath),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"attribute": tfList,
+	}
+
+	return tfMap
+}
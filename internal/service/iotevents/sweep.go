@@ -0,0 +1,130 @@
+//go:build sweep
+// +build sweep
+
+package iotevents
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_detector_model", &resource.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    sweepDetectorModels,
+	})
+
+	resource.AddTestSweepers("aws_iotevents_input", &resource.Sweeper{
+		Name: "aws_iotevents_input",
+		F:    sweepInputs,
+		Dependencies: []string{
+			"aws_iotevents_detector_model",
+		},
+	})
+}
+
+func sweepDetectorModels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTEventsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &iotevents.ListDetectorModelsInput{}
+
+	for {
+		output, err := conn.ListDetectorModelsWithContext(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Models sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing IoT Events Detector Models (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range output.DetectorModelSummaries {
+			r := ResourceDetectorModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DetectorModelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Events Detector Models (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepInputs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).IoTEventsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &iotevents.ListInputsInput{}
+
+	for {
+		output, err := conn.ListInputsWithContext(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Inputs sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing IoT Events Inputs (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range output.InputSummaries {
+			r := ResourceInput()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.InputName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT Events Inputs (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
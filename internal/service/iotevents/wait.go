@@ -0,0 +1,100 @@
+package iotevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	inputCreatedTimeout = 2 * time.Minute
+	inputUpdatedTimeout = 2 * time.Minute
+	inputDeletedTimeout = 2 * time.Minute
+)
+
+func waitInputCreated(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusCreating},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: inputCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputUpdated(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusUpdating},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: inputUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusDeleting},
+		Target:  []string{},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: inputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
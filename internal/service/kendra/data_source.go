@@ -0,0 +1,876 @@
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataSourceCreate,
+		ReadWithoutTimeout:   resourceDataSourceRead,
+		UpdateWithoutTimeout: resourceDataSourceUpdate,
+		DeleteWithoutTimeout: resourceDataSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			checkDataSourceConfiguration,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_list_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key_path": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"documents_metadata_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"exclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 250,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"inclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 250,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"inclusion_prefixes": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+								},
+							},
+						},
+						"web_crawler_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"authentication_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"basic_authentication": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MaxItems: 10,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"credentials": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: verify.ValidARN,
+															},
+															"host": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 253),
+															},
+															"port": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IsPortNumber,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"crawl_depth": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      2,
+										ValidateFunc: validation.IntBetween(0, 10),
+									},
+									"max_content_size_per_page_in_mega_bytes": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Default:      50,
+										ValidateFunc: validation.FloatBetween(0.000001, 50),
+									},
+									"max_links_per_page": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      100,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"max_urls_per_minute_crawl_rate": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      300,
+										ValidateFunc: validation.IntBetween(1, 300),
+									},
+									"proxy_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"credentials": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"host": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 253),
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"url_exclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"url_inclusion_patterns": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 150),
+										},
+									},
+									"urls": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"seed_url_configuration": {
+													Type:         schema.TypeList,
+													Optional:     true,
+													MaxItems:     1,
+													ExactlyOneOf: []string{"configuration.0.web_crawler_configuration.0.urls.0.seed_url_configuration", "configuration.0.web_crawler_configuration.0.urls.0.site_maps_configuration"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"seed_urls": {
+																Type:     schema.TypeSet,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 100,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+																},
+															},
+															"web_crawler_mode": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Default:      kendra.WebCrawlerModeHostOnly,
+																ValidateFunc: validation.StringInSlice(kendra.WebCrawlerMode_Values(), false),
+															},
+														},
+													},
+												},
+												"site_maps_configuration": {
+													Type:         schema.TypeList,
+													Optional:     true,
+													MaxItems:     1,
+													ExactlyOneOf: []string{"configuration.0.web_crawler_configuration.0.urls.0.seed_url_configuration", "configuration.0.web_crawler_configuration.0.urls.0.site_maps_configuration"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"site_maps": {
+																Type:     schema.TypeSet,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 3,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 10),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kendra.DataSourceType_Values(), false),
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateDataSourceInput{
+		IndexId: aws.String(d.Get("index_id").(string)),
+		Name:    aws.String(name),
+		Type:    aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("language_code"); ok {
+		input.LanguageCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Data Source: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateDataSourceWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Kendra Data Source (%s): %s", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateDataSourceOutput).Id)
+	indexID := d.Get("index_id").(string)
+
+	d.SetId(createResourceID(id, indexID))
+
+	if _, err := waitDataSourceCreated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kendra Data Source (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDataSourceRead(ctx, d, meta)
+}
+
+func resourceDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindDataSourceByID(ctx, conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Data Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kendra Data Source (%s): %s", d.Id(), err)
+	}
+
+	arn := dataSourceARN(meta.(*conns.AWSClient), id, indexID)
+
+	if err := setDataSourceAttributes(d, output, arn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Kendra Data Source (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexID, err := parseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &kendra.UpdateDataSourceInput{
+			Id:      aws.String(id),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("configuration") {
+			if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Configuration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("language_code") {
+			input.LanguageCode = aws.String(d.Get("language_code").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("schedule") {
+			input.Schedule = aws.String(d.Get("schedule").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Data Source: %s", input)
+		_, err = tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateDataSourceWithContext(ctx, input)
+			},
+			retryableIAMPropagation,
+		)
+
+		if err != nil {
+			return diag.Errorf("error updating Kendra Data Source (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDataSourceUpdated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Kendra Data Source (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Kendra Data Source (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataSourceRead(ctx, d, meta)
+}
+
+func resourceDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Data Source: %s", d.Id())
+	_, err = conn.DeleteDataSourceWithContext(ctx, &kendra.DeleteDataSourceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kendra Data Source (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataSourceDeleted(ctx, conn, id, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kendra Data Source (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// checkDataSourceConfiguration verifies at plan time that the configuration block matches the data source type.
+func checkDataSourceConfiguration(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	dataSourceType := diff.Get("type").(string)
+	_, s3ConfigurationOk := diff.GetOk("configuration.0.s3_configuration")
+	_, webCrawlerConfigurationOk := diff.GetOk("configuration.0.web_crawler_configuration")
+
+	switch dataSourceType {
+	case kendra.DataSourceTypeCustom:
+		if _, ok := diff.GetOk("configuration"); ok {
+			return fmt.Errorf("configuration must not be set when type is %s", dataSourceType)
+		}
+
+		if _, ok := diff.GetOk("role_arn"); ok {
+			return fmt.Errorf("role_arn must not be set when type is %s", dataSourceType)
+		}
+	case kendra.DataSourceTypeS3:
+		if !s3ConfigurationOk {
+			return fmt.Errorf("configuration.0.s3_configuration must be set when type is %s", dataSourceType)
+		}
+
+		if webCrawlerConfigurationOk {
+			return fmt.Errorf("configuration.0.web_crawler_configuration must not be set when type is %s", dataSourceType)
+		}
+	case kendra.DataSourceTypeWebcrawler:
+		if !webCrawlerConfigurationOk {
+			return fmt.Errorf("configuration.0.web_crawler_configuration must be set when type is %s", dataSourceType)
+		}
+
+		if s3ConfigurationOk {
+			return fmt.Errorf("configuration.0.s3_configuration must not be set when type is %s", dataSourceType)
+		}
+	}
+
+	return nil
+}
+
+func setDataSourceAttributes(d *schema.ResourceData, output *kendra.DescribeDataSourceOutput, arn string) error {
+	d.Set("arn", arn)
+	if err := d.Set("configuration", flattenDataSourceConfiguration(output.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("data_source_id", output.Id)
+	d.Set("description", output.Description)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("index_id", output.IndexId)
+	d.Set("language_code", output.LanguageCode)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("schedule", output.Schedule)
+	d.Set("status", output.Status)
+	d.Set("type", output.Type)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+func dataSourceARN(client *conns.AWSClient, id, indexID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "kendra",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("index/%s/data-source/%s", indexID, id),
+	}.String()
+}
+
+func expandDataSourceConfiguration(tfMap map[string]interface{}) *kendra.DataSourceConfiguration {
+	apiObject := &kendra.DataSourceConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandS3DataSourceConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["web_crawler_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WebCrawlerConfiguration = expandWebCrawlerConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3DataSourceConfiguration(tfMap map[string]interface{}) *kendra.S3DataSourceConfiguration {
+	apiObject := &kendra.S3DataSourceConfiguration{
+		BucketName: aws.String(tfMap["bucket_name"].(string)),
+	}
+
+	if v, ok := tfMap["access_control_list_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AccessControlListConfiguration = &kendra.AccessControlListConfiguration{}
+
+		if v, ok := tfMap["key_path"].(string); ok && v != "" {
+			apiObject.AccessControlListConfiguration.KeyPath = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["documents_metadata_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DocumentsMetadataConfiguration = &kendra.DocumentsMetadataConfiguration{}
+
+		if v, ok := tfMap["s3_prefix"].(string); ok && v != "" {
+			apiObject.DocumentsMetadataConfiguration.S3Prefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["exclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["inclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["inclusion_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InclusionPrefixes = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandWebCrawlerConfiguration(tfMap map[string]interface{}) *kendra.WebCrawlerConfiguration {
+	apiObject := &kendra.WebCrawlerConfiguration{}
+
+	if v, ok := tfMap["authentication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AuthenticationConfiguration = &kendra.AuthenticationConfiguration{}
+
+		if v, ok := tfMap["basic_authentication"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.AuthenticationConfiguration.BasicAuthentication = append(apiObject.AuthenticationConfiguration.BasicAuthentication, &kendra.BasicAuthenticationConfiguration{
+					Credentials: aws.String(tfMap["credentials"].(string)),
+					Host:        aws.String(tfMap["host"].(string)),
+					Port:        aws.Int64(int64(tfMap["port"].(int))),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["crawl_depth"].(int); ok {
+		apiObject.CrawlDepth = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_content_size_per_page_in_mega_bytes"].(float64); ok && v != 0 {
+		apiObject.MaxContentSizePerPageInMegaBytes = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max_links_per_page"].(int); ok && v != 0 {
+		apiObject.MaxLinksPerPage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_urls_per_minute_crawl_rate"].(int); ok && v != 0 {
+		apiObject.MaxUrlsPerMinuteCrawlRate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["proxy_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ProxyConfiguration = &kendra.ProxyConfiguration{
+			Host: aws.String(tfMap["host"].(string)),
+			Port: aws.Int64(int64(tfMap["port"].(int))),
+		}
+
+		if v, ok := tfMap["credentials"].(string); ok && v != "" {
+			apiObject.ProxyConfiguration.Credentials = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["url_exclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UrlExclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["url_inclusion_patterns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UrlInclusionPatterns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["urls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Urls = &kendra.Urls{}
+
+		if v, ok := tfMap["seed_url_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Urls.SeedUrlConfiguration = &kendra.SeedUrlConfiguration{
+				SeedUrls: flex.ExpandStringSet(tfMap["seed_urls"].(*schema.Set)),
+			}
+
+			if v, ok := tfMap["web_crawler_mode"].(string); ok && v != "" {
+				apiObject.Urls.SeedUrlConfiguration.WebCrawlerMode = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["site_maps_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Urls.SiteMapsConfiguration = &kendra.SiteMapsConfiguration{
+				SiteMaps: flex.ExpandStringSet(tfMap["site_maps"].(*schema.Set)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDataSourceConfiguration(apiObject *kendra.DataSourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = flattenS3DataSourceConfiguration(v)
+	}
+
+	if v := apiObject.WebCrawlerConfiguration; v != nil {
+		tfMap["web_crawler_configuration"] = flattenWebCrawlerConfiguration(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3DataSourceConfiguration(apiObject *kendra.S3DataSourceConfiguration) []interface{} {
+	tfMap := map[string]interface{}{
+		"bucket_name":        aws.StringValue(apiObject.BucketName),
+		"exclusion_patterns": aws.StringValueSlice(apiObject.ExclusionPatterns),
+		"inclusion_patterns": aws.StringValueSlice(apiObject.InclusionPatterns),
+		"inclusion_prefixes": aws.StringValueSlice(apiObject.InclusionPrefixes),
+	}
+
+	if v := apiObject.AccessControlListConfiguration; v != nil {
+		tfMap["access_control_list_configuration"] = []interface{}{
+			map[string]interface{}{
+				"key_path": aws.StringValue(v.KeyPath),
+			},
+		}
+	}
+
+	if v := apiObject.DocumentsMetadataConfiguration; v != nil {
+		tfMap["documents_metadata_configuration"] = []interface{}{
+			map[string]interface{}{
+				"s3_prefix": aws.StringValue(v.S3Prefix),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWebCrawlerConfiguration(apiObject *kendra.WebCrawlerConfiguration) []interface{} {
+	tfMap := map[string]interface{}{
+		"crawl_depth": aws.Int64Value(apiObject.CrawlDepth),
+		"max_content_size_per_page_in_mega_bytes": aws.Float64Value(apiObject.MaxContentSizePerPageInMegaBytes),
+		"max_links_per_page":                      aws.Int64Value(apiObject.MaxLinksPerPage),
+		"max_urls_per_minute_crawl_rate":          aws.Int64Value(apiObject.MaxUrlsPerMinuteCrawlRate),
+		"url_exclusion_patterns":                  aws.StringValueSlice(apiObject.UrlExclusionPatterns),
+		"url_inclusion_patterns":                  aws.StringValueSlice(apiObject.UrlInclusionPatterns),
+	}
+
+	if v := apiObject.AuthenticationConfiguration; v != nil {
+		var basicAuthentication []interface{}
+
+		for _, apiObject := range v.BasicAuthentication {
+			if apiObject == nil {
+				continue
+			}
+
+			basicAuthentication = append(basicAuthentication, map[string]interface{}{
+				"credentials": aws.StringValue(apiObject.Credentials),
+				"host":        aws.StringValue(apiObject.Host),
+				"port":        aws.Int64Value(apiObject.Port),
+			})
+		}
+
+		tfMap["authentication_configuration"] = []interface{}{
+			map[string]interface{}{
+				"basic_authentication": basicAuthentication,
+			},
+		}
+	}
+
+	if v := apiObject.ProxyConfiguration; v != nil {
+		tfMap["proxy_configuration"] = []interface{}{
+			map[string]interface{}{
+				"credentials": aws.StringValue(v.Credentials),
+				"host":        aws.StringValue(v.Host),
+				"port":        aws.Int64Value(v.Port),
+			},
+		}
+	}
+
+	if v := apiObject.Urls; v != nil {
+		urls := map[string]interface{}{}
+
+		if v := v.SeedUrlConfiguration; v != nil {
+			urls["seed_url_configuration"] = []interface{}{
+				map[string]interface{}{
+					"seed_urls":        aws.StringValueSlice(v.SeedUrls),
+					"web_crawler_mode": aws.StringValue(v.WebCrawlerMode),
+				},
+			}
+		}
+
+		if v := v.SiteMapsConfiguration; v != nil {
+			urls["site_maps_configuration"] = []interface{}{
+				map[string]interface{}{
+					"site_maps": aws.StringValueSlice(v.SiteMaps),
+				},
+			}
+		}
+
+		tfMap["urls"] = []interface{}{urls}
+	}
+
+	return []interface{}{tfMap}
+}
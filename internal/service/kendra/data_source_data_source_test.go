@@ -0,0 +1,56 @@
+package kendra_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKendraDataSourceDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kendra_data_source.test"
+	resourceName := "aws_kendra_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataSourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.#", resourceName, "configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.s3_configuration.#", resourceName, "configuration.0.s3_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.s3_configuration.0.bucket_name", resourceName, "configuration.0.s3_configuration.0.bucket_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.s3_configuration.0.inclusion_prefixes.#", resourceName, "configuration.0.s3_configuration.0.inclusion_prefixes.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_source_id", resourceName, "data_source_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_id", resourceName, "index_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "language_code", resourceName, "language_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedule", resourceName, "schedule"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "updated_at", resourceName, "updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_s3Configuration(rName, "documents/", "cron(9 10 1 * ? *)"), `
+data "aws_kendra_data_source" "test" {
+  data_source_id = aws_kendra_data_source.test.data_source_id
+  index_id       = aws_kendra_index.test.id
+}
+`)
+}
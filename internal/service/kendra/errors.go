@@ -0,0 +1,20 @@
+package kendra
+
+import (
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+const (
+	errMessageRoleValidation = "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity"
+)
+
+// retryableIAMPropagation retries errors returned while a newly created IAM role is not yet
+// usable by Kendra.
+func retryableIAMPropagation(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, kendra.ErrCodeValidationException, errMessageRoleValidation) {
+		return true, err
+	}
+
+	return false, err
+}
@@ -0,0 +1,404 @@
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExperience() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceExperienceCreate,
+		ReadWithoutTimeout:   resourceExperienceRead,
+		UpdateWithoutTimeout: resourceExperienceUpdate,
+		DeleteWithoutTimeout: resourceExperienceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_source_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							AtLeastOneOf: []string{"configuration.0.content_source_configuration", "configuration.0.user_identity_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 100),
+										},
+									},
+									"direct_put_content": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"faq_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 100),
+										},
+									},
+								},
+							},
+						},
+						"user_identity_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							AtLeastOneOf: []string{"configuration.0.content_source_configuration", "configuration.0.user_identity_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"identity_attribute_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"endpoints": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"experience_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExperienceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateExperienceInput{
+		IndexId: aws.String(d.Get("index_id").(string)),
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandExperienceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Experience: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateExperienceWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Kendra Experience (%s): %s", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateExperienceOutput).Id)
+	indexID := d.Get("index_id").(string)
+
+	d.SetId(createResourceID(id, indexID))
+
+	if _, err := waitExperienceCreated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kendra Experience (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceExperienceRead(ctx, d, meta)
+}
+
+func resourceExperienceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindExperienceByID(ctx, conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Experience (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kendra Experience (%s): %s", d.Id(), err)
+	}
+
+	if err := setExperienceAttributes(d, output, experienceARN(meta.(*conns.AWSClient), id, indexID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceExperienceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &kendra.UpdateExperienceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	if d.HasChange("configuration") {
+		if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Configuration = expandExperienceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Kendra Experience: %s", input)
+	_, err = tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateExperienceWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error updating Kendra Experience (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitExperienceUpdated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for Kendra Experience (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceExperienceRead(ctx, d, meta)
+}
+
+func resourceExperienceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Experience: %s", d.Id())
+	_, err = conn.DeleteExperienceWithContext(ctx, &kendra.DeleteExperienceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kendra Experience (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitExperienceDeleted(ctx, conn, id, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kendra Experience (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setExperienceAttributes(d *schema.ResourceData, output *kendra.DescribeExperienceOutput, arn string) error {
+	d.Set("arn", arn)
+	if err := d.Set("configuration", flattenExperienceConfiguration(output.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+	d.Set("description", output.Description)
+	if err := d.Set("endpoints", flattenExperienceEndpoints(output.Endpoints)); err != nil {
+		return fmt.Errorf("error setting endpoints: %w", err)
+	}
+	d.Set("experience_id", output.Id)
+	d.Set("index_id", output.IndexId)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func experienceARN(client *conns.AWSClient, id, indexID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "kendra",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("index/%s/experience/%s", indexID, id),
+	}.String()
+}
+
+func expandExperienceConfiguration(tfMap map[string]interface{}) *kendra.ExperienceConfiguration {
+	apiObject := &kendra.ExperienceConfiguration{}
+
+	if v, ok := tfMap["content_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ContentSourceConfiguration = &kendra.ContentSourceConfiguration{}
+
+		if v, ok := tfMap["data_source_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ContentSourceConfiguration.DataSourceIds = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["direct_put_content"].(bool); ok {
+			apiObject.ContentSourceConfiguration.DirectPutContent = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["faq_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ContentSourceConfiguration.FaqIds = flex.ExpandStringSet(v)
+		}
+	}
+
+	if v, ok := tfMap["user_identity_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.UserIdentityConfiguration = &kendra.UserIdentityConfiguration{
+			IdentityAttributeName: aws.String(tfMap["identity_attribute_name"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenExperienceConfiguration(apiObject *kendra.ExperienceConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContentSourceConfiguration; v != nil {
+		tfMap["content_source_configuration"] = []interface{}{
+			map[string]interface{}{
+				"data_source_ids":    aws.StringValueSlice(v.DataSourceIds),
+				"direct_put_content": aws.BoolValue(v.DirectPutContent),
+				"faq_ids":            aws.StringValueSlice(v.FaqIds),
+			},
+		}
+	}
+
+	if v := apiObject.UserIdentityConfiguration; v != nil {
+		tfMap["user_identity_configuration"] = []interface{}{
+			map[string]interface{}{
+				"identity_attribute_name": aws.StringValue(v.IdentityAttributeName),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExperienceEndpoints(apiObjects []*kendra.ExperienceEndpoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"endpoint":      aws.StringValue(apiObject.Endpoint),
+			"endpoint_type": aws.StringValue(apiObject.EndpointType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,50 @@
+package kendra_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKendraExperienceDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kendra_experience.test"
+	resourceName := "aws_kendra_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.#", resourceName, "configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.content_source_configuration.#", resourceName, "configuration.0.content_source_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration.0.content_source_configuration.0.direct_put_content", resourceName, "configuration.0.content_source_configuration.0.direct_put_content"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoints.#", resourceName, "endpoints.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "experience_id", resourceName, "experience_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_id", resourceName, "index_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExperienceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExperienceConfig_basic(rName), `
+data "aws_kendra_experience" "test" {
+  experience_id = aws_kendra_experience.test.experience_id
+  index_id      = aws_kendra_index.test.id
+}
+`)
+}
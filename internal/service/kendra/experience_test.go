@@ -0,0 +1,232 @@
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraExperience_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_experience.test"
+	indexResourceName := "aws_kendra_index.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExperienceExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/experience/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.content_source_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.content_source_configuration.0.direct_put_content", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "experience_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", indexResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.ExperienceStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraExperience_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceExperience(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraExperience_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperienceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperienceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperienceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccExperienceConfig_update(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExperienceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.user_identity_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.user_identity_configuration.0.identity_attribute_name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckExperienceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_experience" {
+			continue
+		}
+
+		_, err := tfkendra.FindExperienceByID(context.Background(), conn, rs.Primary.Attributes["experience_id"], rs.Primary.Attributes["index_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Experience %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckExperienceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Experience ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		_, err := tfkendra.FindExperienceByID(context.Background(), conn, rs.Primary.Attributes["experience_id"], rs.Primary.Attributes["index_id"])
+
+		return err
+	}
+}
+
+func testAccExperienceConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_basic(rName), fmt.Sprintf(`
+data "aws_iam_policy_document" "experience" {
+  statement {
+    actions = [
+      "kendra:GetQuerySuggestions",
+      "kendra:Query",
+      "kendra:DescribeIndex",
+      "kendra:ListFaqs",
+      "kendra:DescribeDataSource",
+      "kendra:ListDataSources",
+      "kendra:DescribeFaq",
+    ]
+    resources = [
+      aws_kendra_index.test.arn,
+      "${aws_kendra_index.test.arn}/*",
+    ]
+  }
+
+  statement {
+    actions   = ["sso:ListDirectoryAssociations"]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "experience" {
+  name   = "%[1]s-experience"
+  role   = aws_iam_role.test.id
+  policy = data.aws_iam_policy_document.experience.json
+}
+`, rName))
+}
+
+func testAccExperienceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExperienceConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_experience" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  configuration {
+    content_source_configuration {
+      direct_put_content = true
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.experience]
+}
+`, rName))
+}
+
+func testAccExperienceConfig_update(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccExperienceConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_experience" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+
+  configuration {
+    content_source_configuration {
+      direct_put_content = true
+    }
+
+    user_identity_configuration {
+      identity_attribute_name = "email"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.experience]
+}
+`, rName2))
+}
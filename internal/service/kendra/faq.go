@@ -0,0 +1,337 @@
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFAQ() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFAQCreate,
+		ReadWithoutTimeout:   resourceFAQRead,
+		UpdateWithoutTimeout: resourceFAQUpdate,
+		DeleteWithoutTimeout: resourceFAQDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"faq_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kendra.FaqFileFormat_Values(), false),
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 10),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_path": s3PathSchema(true),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFAQCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateFaqInput{
+		IndexId: aws.String(d.Get("index_id").(string)),
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+		S3Path:  expandS3Path(d.Get("s3_path").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("file_format"); ok {
+		input.FileFormat = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("language_code"); ok {
+		input.LanguageCode = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra FAQ: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateFaqWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Kendra FAQ (%s): %s", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateFaqOutput).Id)
+	indexID := d.Get("index_id").(string)
+
+	d.SetId(createResourceID(id, indexID))
+
+	if _, err := waitFAQCreated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kendra FAQ (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceFAQRead(ctx, d, meta)
+}
+
+func resourceFAQRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindFAQByID(ctx, conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra FAQ (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kendra FAQ (%s): %s", d.Id(), err)
+	}
+
+	arn := faqARN(meta.(*conns.AWSClient), id, indexID)
+
+	if err := setFAQAttributes(d, output, arn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Kendra FAQ (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFAQUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Kendra FAQ (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFAQRead(ctx, d, meta)
+}
+
+func resourceFAQDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra FAQ: %s", d.Id())
+	_, err = conn.DeleteFaqWithContext(ctx, &kendra.DeleteFaqInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kendra FAQ (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFAQDeleted(ctx, conn, id, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kendra FAQ (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setFAQAttributes(d *schema.ResourceData, output *kendra.DescribeFaqOutput, arn string) error {
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("faq_id", output.Id)
+	d.Set("file_format", output.FileFormat)
+	d.Set("index_id", output.IndexId)
+	d.Set("language_code", output.LanguageCode)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("s3_path", flattenS3Path(output.S3Path)); err != nil {
+		return fmt.Errorf("error setting s3_path: %w", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+func faqARN(client *conns.AWSClient, id, indexID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "kendra",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("index/%s/faq/%s", indexID, id),
+	}.String()
+}
+
+// s3PathSchema returns the schema for an S3 bucket and key pair.
+// FAQs cannot be updated in place, so their source path forces replacement.
+func s3PathSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func expandS3Path(tfList []interface{}) *kendra.S3Path {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &kendra.S3Path{
+		Bucket: aws.String(tfMap["bucket"].(string)),
+		Key:    aws.String(tfMap["key"].(string)),
+	}
+}
+
+func flattenS3Path(apiObject *kendra.S3Path) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket": aws.StringValue(apiObject.Bucket),
+		"key":    aws.StringValue(apiObject.Key),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,55 @@
+package kendra_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKendraFAQDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kendra_faq.test"
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFAQDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFAQDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "faq_id", resourceName, "faq_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_format", resourceName, "file_format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_id", resourceName, "index_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "language_code", resourceName, "language_code"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_path.#", resourceName, "s3_path.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_path.0.bucket", resourceName, "s3_path.0.bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_path.0.key", resourceName, "s3_path.0.key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "updated_at", resourceName, "updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFAQDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFAQConfig_tags1(rName, "key1", "value1"), `
+data "aws_kendra_faq" "test" {
+  faq_id   = aws_kendra_faq.test.faq_id
+  index_id = aws_kendra_index.test.id
+}
+`)
+}
@@ -0,0 +1,242 @@
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraFAQ_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+	indexResourceName := "aws_kendra_index.test"
+	roleResourceName := "aws_iam_role.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFAQDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFAQConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFAQExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/faq/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "faq_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", kendra.FaqFileFormatCsv),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", indexResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_path.0.bucket", bucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_path.0.key", "test/faq.csv"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.FaqStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFAQ_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFAQDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFAQConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFAQExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceFAQ(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraFAQ_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_faq.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFAQDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFAQConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFAQExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFAQConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFAQExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFAQConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFAQExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFAQDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_faq" {
+			continue
+		}
+
+		_, err := tfkendra.FindFAQByID(context.Background(), conn, rs.Primary.Attributes["faq_id"], rs.Primary.Attributes["index_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra FAQ %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFAQExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra FAQ ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		_, err := tfkendra.FindFAQByID(context.Background(), conn, rs.Primary.Attributes["faq_id"], rs.Primary.Attributes["index_id"])
+
+		return err
+	}
+}
+
+func testAccFAQConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_baseS3(rName), `
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test/faq.csv"
+  content = "How tall is the Space Needle?,605 feet,https://www.spaceneedle.com/"
+}
+`)
+}
+
+func testAccFAQConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFAQConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName))
+}
+
+func testAccFAQConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFAQConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFAQConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFAQConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_faq" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  file_format = "CSV"
+  role_arn    = aws_iam_role.test.arn
+
+  s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
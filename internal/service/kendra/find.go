@@ -0,0 +1,166 @@
+package kendra
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindIndexByID(ctx context.Context, conn *kendra.Kendra, id string) (*kendra.DescribeIndexOutput, error) {
+	input := &kendra.DescribeIndexInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeIndexWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataSourceByID(ctx context.Context, conn *kendra.Kendra, id, indexID string) (*kendra.DescribeDataSourceOutput, error) {
+	input := &kendra.DescribeDataSourceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeDataSourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindExperienceByID(ctx context.Context, conn *kendra.Kendra, id, indexID string) (*kendra.DescribeExperienceOutput, error) {
+	input := &kendra.DescribeExperienceInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeExperienceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindFAQByID(ctx context.Context, conn *kendra.Kendra, id, indexID string) (*kendra.DescribeFaqOutput, error) {
+	input := &kendra.DescribeFaqInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeFaqWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindQuerySuggestionsBlockListByID(ctx context.Context, conn *kendra.Kendra, id, indexID string) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	input := &kendra.DescribeQuerySuggestionsBlockListInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeQuerySuggestionsBlockListWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindThesaurusByID(ctx context.Context, conn *kendra.Kendra, id, indexID string) (*kendra.DescribeThesaurusOutput, error) {
+	input := &kendra.DescribeThesaurusInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	}
+
+	output, err := conn.DescribeThesaurusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,26 @@
+package kendra
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resources that belong to an index are identified by their own ID and the ID of the index.
+const resourceIDSeparator = "/"
+
+func createResourceID(id, indexID string) string {
+	parts := []string{id, indexID}
+	resourceID := strings.Join(parts, resourceIDSeparator)
+
+	return resourceID
+}
+
+func parseResourceID(resourceID string) (string, string, error) {
+	parts := strings.Split(resourceID, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected id%[2]sindex-id", resourceID, resourceIDSeparator)
+}
@@ -0,0 +1,70 @@
+package kendra
+
+import (
+	"testing"
+)
+
+func TestParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		InputID         string
+		ExpectedID      string
+		ExpectedIndexID string
+		ExpectError     bool
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing index ID",
+			InputID:     "3b7ed8b3-43a9-4f8d-a4f4-5d3b4a0a7c1e",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty parts",
+			InputID:     "/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "a/b/c",
+			ExpectError: true,
+		},
+		{
+			TestName:        "valid",
+			InputID:         "3b7ed8b3-43a9-4f8d-a4f4-5d3b4a0a7c1e/9d4a6a3c-0fbc-4bd5-8b8a-6b7f6cf3e2a1",
+			ExpectedID:      "3b7ed8b3-43a9-4f8d-a4f4-5d3b4a0a7c1e",
+			ExpectedIndexID: "9d4a6a3c-0fbc-4bd5-8b8a-6b7f6cf3e2a1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotID, gotIndexID, err := parseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", gotID, testCase.ExpectedID)
+			}
+
+			if gotIndexID != testCase.ExpectedIndexID {
+				t.Errorf("got index ID %s, expected %s", gotIndexID, testCase.ExpectedIndexID)
+			}
+
+			if !testCase.ExpectError {
+				if got := createResourceID(gotID, gotIndexID); got != testCase.InputID {
+					t.Errorf("got resource ID %s, expected %s", got, testCase.InputID)
+				}
+			}
+		})
+	}
+}
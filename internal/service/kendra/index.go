@@ -0,0 +1,931 @@
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIndexCreate,
+		ReadWithoutTimeout:   resourceIndexRead,
+		UpdateWithoutTimeout: resourceIndexUpdate,
+		DeleteWithoutTimeout: resourceIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Update: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_units": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"document_metadata_configuration_updates": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 30),
+						},
+						"relevance": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"duration": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`[0-9]+[s]`), "numeric string followed by the character \"s\""),
+									},
+									"freshness": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"importance": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"rank_order": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(kendra.Order_Values(), false),
+									},
+									"values_importance_map": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeInt},
+									},
+								},
+							},
+						},
+						"search": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"displayable": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"facetable": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"searchable": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"sortable": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kendra.DocumentAttributeValueType_Values(), false),
+						},
+					},
+				},
+			},
+			"edition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kendra.IndexEditionEnterpriseEdition,
+				ValidateFunc: validation.StringInSlice(kendra.IndexEdition_Values(), false),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"faq_statistics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"indexed_question_answers_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"text_document_statistics": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"indexed_text_bytes": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"indexed_text_documents_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_context_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kendra.UserContextPolicyAttributeFilter,
+				ValidateFunc: validation.StringInSlice(kendra.UserContextPolicy_Values(), false),
+			},
+			"user_group_resolution_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_group_resolution_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kendra.UserGroupResolutionMode_Values(), false),
+						},
+					},
+				},
+			},
+			"user_token_configurations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"json_token_type_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_attribute_field": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"user_name_attribute_field": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"jwt_token_type_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"claim_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"group_attribute_field": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"issuer": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 65),
+									},
+									"key_location": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(kendra.KeyLocation_Values(), false),
+									},
+									"secrets_manager_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									"user_name_attribute_field": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateIndexInput{
+		Edition:           aws.String(d.Get("edition").(string)),
+		Name:              aws.String(name),
+		RoleArn:           aws.String(d.Get("role_arn").(string)),
+		UserContextPolicy: aws.String(d.Get("user_context_policy").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_group_resolution_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.UserGroupResolutionConfiguration = expandUserGroupResolutionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("user_token_configurations"); ok {
+		input.UserTokenConfigurations = expandUserTokenConfigurations(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Index: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateIndexWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Kendra Index (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*kendra.CreateIndexOutput).Id))
+
+	if _, err := waitIndexCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kendra Index (%s) create: %s", d.Id(), err)
+	}
+
+	// Capacity units and document metadata configurations can only be set once the index exists.
+	_, capacityUnitsOk := d.GetOk("capacity_units")
+	_, documentMetadataConfigurationUpdatesOk := d.GetOk("document_metadata_configuration_updates")
+
+	if capacityUnitsOk || documentMetadataConfigurationUpdatesOk {
+		input := &kendra.UpdateIndexInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if capacityUnitsOk {
+			input.CapacityUnits = expandCapacityUnits(d.Get("capacity_units").([]interface{}))
+		}
+
+		if documentMetadataConfigurationUpdatesOk {
+			input.DocumentMetadataConfigurationUpdates = expandDocumentMetadataConfigurationUpdates(d.Get("document_metadata_configuration_updates").(*schema.Set).List())
+		}
+
+		if err := updateIndex(ctx, conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIndexRead(ctx, d, meta)
+}
+
+func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	index, err := FindIndexByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Index (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kendra Index (%s): %s", d.Id(), err)
+	}
+
+	arn := indexARN(meta.(*conns.AWSClient), d.Id())
+
+	if err := setIndexAttributes(d, index, arn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Kendra Index (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &kendra.UpdateIndexInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("capacity_units") {
+			input.CapacityUnits = expandCapacityUnits(d.Get("capacity_units").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("document_metadata_configuration_updates") {
+			input.DocumentMetadataConfigurationUpdates = expandDocumentMetadataConfigurationUpdates(d.Get("document_metadata_configuration_updates").(*schema.Set).List())
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("user_context_policy") {
+			input.UserContextPolicy = aws.String(d.Get("user_context_policy").(string))
+		}
+
+		if d.HasChange("user_group_resolution_configuration") {
+			input.UserGroupResolutionConfiguration = &kendra.UserGroupResolutionConfiguration{
+				UserGroupResolutionMode: aws.String(kendra.UserGroupResolutionModeNone),
+			}
+
+			if v, ok := d.GetOk("user_group_resolution_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.UserGroupResolutionConfiguration = expandUserGroupResolutionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("user_token_configurations") {
+			input.UserTokenConfigurations = expandUserTokenConfigurations(d.Get("user_token_configurations").([]interface{}))
+
+			if input.UserTokenConfigurations == nil {
+				input.UserTokenConfigurations = []*kendra.UserTokenConfiguration{}
+			}
+		}
+
+		if err := updateIndex(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Kendra Index (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIndexRead(ctx, d, meta)
+}
+
+func resourceIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	log.Printf("[DEBUG] Deleting Kendra Index: %s", d.Id())
+	_, err := conn.DeleteIndexWithContext(ctx, &kendra.DeleteIndexInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kendra Index (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIndexDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kendra Index (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateIndex(ctx context.Context, conn *kendra.Kendra, input *kendra.UpdateIndexInput, timeout time.Duration) error {
+	id := aws.StringValue(input.Id)
+
+	log.Printf("[DEBUG] Updating Kendra Index: %s", input)
+	_, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateIndexWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error updating Kendra Index (%s): %w", id, err)
+	}
+
+	if _, err := waitIndexUpdated(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kendra Index (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
+func setIndexAttributes(d *schema.ResourceData, index *kendra.DescribeIndexOutput, arn string) error {
+	d.Set("arn", arn)
+	if err := d.Set("capacity_units", flattenCapacityUnits(index.CapacityUnits)); err != nil {
+		return fmt.Errorf("error setting capacity_units: %w", err)
+	}
+	d.Set("created_at", aws.TimeValue(index.CreatedAt).Format(time.RFC3339))
+	d.Set("description", index.Description)
+	if err := d.Set("document_metadata_configuration_updates", flattenDocumentMetadataConfigurations(index.DocumentMetadataConfigurations)); err != nil {
+		return fmt.Errorf("error setting document_metadata_configuration_updates: %w", err)
+	}
+	d.Set("edition", index.Edition)
+	d.Set("error_message", index.ErrorMessage)
+	if err := d.Set("index_statistics", flattenIndexStatistics(index.IndexStatistics)); err != nil {
+		return fmt.Errorf("error setting index_statistics: %w", err)
+	}
+	d.Set("name", index.Name)
+	d.Set("role_arn", index.RoleArn)
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(index.ServerSideEncryptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+	}
+	d.Set("status", index.Status)
+	d.Set("updated_at", aws.TimeValue(index.UpdatedAt).Format(time.RFC3339))
+	d.Set("user_context_policy", index.UserContextPolicy)
+	if err := d.Set("user_group_resolution_configuration", flattenUserGroupResolutionConfiguration(index.UserGroupResolutionConfiguration)); err != nil {
+		return fmt.Errorf("error setting user_group_resolution_configuration: %w", err)
+	}
+	if err := d.Set("user_token_configurations", flattenUserTokenConfigurations(index.UserTokenConfigurations)); err != nil {
+		return fmt.Errorf("error setting user_token_configurations: %w", err)
+	}
+
+	return nil
+}
+
+func indexARN(client *conns.AWSClient, id string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "kendra",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("index/%s", id),
+	}.String()
+}
+
+func expandCapacityUnits(tfList []interface{}) *kendra.CapacityUnitsConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &kendra.CapacityUnitsConfiguration{}
+
+	if v, ok := tfMap["query_capacity_units"].(int); ok {
+		apiObject.QueryCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_capacity_units"].(int); ok {
+		apiObject.StorageCapacityUnits = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandDocumentMetadataConfigurationUpdates(tfList []interface{}) []*kendra.DocumentMetadataConfiguration {
+	var apiObjects []*kendra.DocumentMetadataConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kendra.DocumentMetadataConfiguration{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["relevance"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Relevance = expandRelevance(v[0].(map[string]interface{}), tfMap["type"].(string))
+		}
+
+		if v, ok := tfMap["search"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Search = expandSearch(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRelevance(tfMap map[string]interface{}, attributeType string) *kendra.Relevance {
+	apiObject := &kendra.Relevance{}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		apiObject.Duration = aws.String(v)
+	}
+
+	// Freshness only applies to date attributes.
+	if v, ok := tfMap["freshness"].(bool); ok && attributeType == kendra.DocumentAttributeValueTypeDateValue {
+		apiObject.Freshness = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["importance"].(int); ok && v > 0 {
+		apiObject.Importance = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rank_order"].(string); ok && v != "" {
+		apiObject.RankOrder = aws.String(v)
+	}
+
+	if v, ok := tfMap["values_importance_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.ValueImportanceMap = make(map[string]*int64)
+
+		for k, v := range v {
+			apiObject.ValueImportanceMap[k] = aws.Int64(int64(v.(int)))
+		}
+	}
+
+	return apiObject
+}
+
+func expandSearch(tfMap map[string]interface{}) *kendra.Search {
+	apiObject := &kendra.Search{}
+
+	if v, ok := tfMap["displayable"].(bool); ok {
+		apiObject.Displayable = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["facetable"].(bool); ok {
+		apiObject.Facetable = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["searchable"].(bool); ok {
+		apiObject.Searchable = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sortable"].(bool); ok {
+		apiObject.Sortable = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandServerSideEncryptionConfiguration(tfMap map[string]interface{}) *kendra.ServerSideEncryptionConfiguration {
+	apiObject := &kendra.ServerSideEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUserGroupResolutionConfiguration(tfMap map[string]interface{}) *kendra.UserGroupResolutionConfiguration {
+	return &kendra.UserGroupResolutionConfiguration{
+		UserGroupResolutionMode: aws.String(tfMap["user_group_resolution_mode"].(string)),
+	}
+}
+
+func expandUserTokenConfigurations(tfList []interface{}) []*kendra.UserTokenConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &kendra.UserTokenConfiguration{}
+
+	if v, ok := tfMap["json_token_type_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.JsonTokenTypeConfiguration = &kendra.JsonTokenTypeConfiguration{
+			GroupAttributeField:    aws.String(tfMap["group_attribute_field"].(string)),
+			UserNameAttributeField: aws.String(tfMap["user_name_attribute_field"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["jwt_token_type_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		jwtTokenTypeConfiguration := &kendra.JwtTokenTypeConfiguration{
+			KeyLocation: aws.String(tfMap["key_location"].(string)),
+		}
+
+		if v, ok := tfMap["claim_regex"].(string); ok && v != "" {
+			jwtTokenTypeConfiguration.ClaimRegex = aws.String(v)
+		}
+
+		if v, ok := tfMap["group_attribute_field"].(string); ok && v != "" {
+			jwtTokenTypeConfiguration.GroupAttributeField = aws.String(v)
+		}
+
+		if v, ok := tfMap["issuer"].(string); ok && v != "" {
+			jwtTokenTypeConfiguration.Issuer = aws.String(v)
+		}
+
+		if v, ok := tfMap["secrets_manager_arn"].(string); ok && v != "" {
+			jwtTokenTypeConfiguration.SecretManagerArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["url"].(string); ok && v != "" {
+			jwtTokenTypeConfiguration.URL = aws.String(v)
+		}
+
+		if v, ok := tfMap["user_name_attribute_field"].(string); ok && v != "" {
+			jwtTokenTypeConfiguration.UserNameAttributeField = aws.String(v)
+		}
+
+		apiObject.JwtTokenTypeConfiguration = jwtTokenTypeConfiguration
+	}
+
+	return []*kendra.UserTokenConfiguration{apiObject}
+}
+
+func flattenCapacityUnits(apiObject *kendra.CapacityUnitsConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"query_capacity_units":   aws.Int64Value(apiObject.QueryCapacityUnits),
+		"storage_capacity_units": aws.Int64Value(apiObject.StorageCapacityUnits),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDocumentMetadataConfigurations(apiObjects []*kendra.DocumentMetadataConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.Relevance; v != nil {
+			relevance := map[string]interface{}{
+				"duration":   aws.StringValue(v.Duration),
+				"freshness":  aws.BoolValue(v.Freshness),
+				"importance": aws.Int64Value(v.Importance),
+				"rank_order": aws.StringValue(v.RankOrder),
+			}
+
+			if v := v.ValueImportanceMap; len(v) > 0 {
+				relevance["values_importance_map"] = aws.Int64ValueMap(v)
+			}
+
+			tfMap["relevance"] = []interface{}{relevance}
+		}
+
+		if v := apiObject.Search; v != nil {
+			tfMap["search"] = []interface{}{
+				map[string]interface{}{
+					"displayable": aws.BoolValue(v.Displayable),
+					"facetable":   aws.BoolValue(v.Facetable),
+					"searchable":  aws.BoolValue(v.Searchable),
+					"sortable":    aws.BoolValue(v.Sortable),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIndexStatistics(apiObject *kendra.IndexStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FaqStatistics; v != nil {
+		tfMap["faq_statistics"] = []interface{}{
+			map[string]interface{}{
+				"indexed_question_answers_count": aws.Int64Value(v.IndexedQuestionAnswersCount),
+			},
+		}
+	}
+
+	if v := apiObject.TextDocumentStatistics; v != nil {
+		tfMap["text_document_statistics"] = []interface{}{
+			map[string]interface{}{
+				"indexed_text_bytes":           aws.Int64Value(v.IndexedTextBytes),
+				"indexed_text_documents_count": aws.Int64Value(v.IndexedTextDocumentsCount),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServerSideEncryptionConfiguration(apiObject *kendra.ServerSideEncryptionConfiguration) []interface{} {
+	if apiObject == nil || aws.StringValue(apiObject.KmsKeyId) == "" {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserGroupResolutionConfiguration(apiObject *kendra.UserGroupResolutionConfiguration) []interface{} {
+	if apiObject == nil || aws.StringValue(apiObject.UserGroupResolutionMode) == kendra.UserGroupResolutionModeNone {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"user_group_resolution_mode": aws.StringValue(apiObject.UserGroupResolutionMode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUserTokenConfigurations(apiObjects []*kendra.UserTokenConfiguration) []interface{} {
+	if len(apiObjects) == 0 || apiObjects[0] == nil {
+		return nil
+	}
+
+	apiObject := apiObjects[0]
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.JsonTokenTypeConfiguration; v != nil {
+		tfMap["json_token_type_configuration"] = []interface{}{
+			map[string]interface{}{
+				"group_attribute_field":     aws.StringValue(v.GroupAttributeField),
+				"user_name_attribute_field": aws.StringValue(v.UserNameAttributeField),
+			},
+		}
+	}
+
+	if v := apiObject.JwtTokenTypeConfiguration; v != nil {
+		tfMap["jwt_token_type_configuration"] = []interface{}{
+			map[string]interface{}{
+				"claim_regex":               aws.StringValue(v.ClaimRegex),
+				"group_attribute_field":     aws.StringValue(v.GroupAttributeField),
+				"issuer":                    aws.StringValue(v.Issuer),
+				"key_location":              aws.StringValue(v.KeyLocation),
+				"secrets_manager_arn":       aws.StringValue(v.SecretManagerArn),
+				"url":                       aws.StringValue(v.URL),
+				"user_name_attribute_field": aws.StringValue(v.UserNameAttributeField),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
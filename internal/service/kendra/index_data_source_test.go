@@ -0,0 +1,56 @@
+package kendra_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKendraIndexDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kendra_index.test"
+	resourceName := "aws_kendra_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "capacity_units.#", resourceName, "capacity_units.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "document_metadata_configuration_updates.#", resourceName, "document_metadata_configuration_updates.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "edition", resourceName, "edition"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_statistics.#", resourceName, "index_statistics.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "server_side_encryption_configuration.#", resourceName, "server_side_encryption_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "updated_at", resourceName, "updated_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_context_policy", resourceName, "user_context_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_group_resolution_configuration.#", resourceName, "user_group_resolution_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_token_configurations.#", resourceName, "user_token_configurations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIndexDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_tags1(rName, "key1", "value1"), `
+data "aws_kendra_index" "test" {
+  id = aws_kendra_index.test.id
+}
+`)
+}
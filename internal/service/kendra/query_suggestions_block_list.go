@@ -0,0 +1,314 @@
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceQuerySuggestionsBlockList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQuerySuggestionsBlockListCreate,
+		ReadWithoutTimeout:   resourceQuerySuggestionsBlockListRead,
+		UpdateWithoutTimeout: resourceQuerySuggestionsBlockListUpdate,
+		DeleteWithoutTimeout: resourceQuerySuggestionsBlockListDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_suggestions_block_list_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_s3_path": s3PathSchema(false),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceQuerySuggestionsBlockListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateQuerySuggestionsBlockListInput{
+		IndexId:      aws.String(d.Get("index_id").(string)),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		SourceS3Path: expandS3Path(d.Get("source_s3_path").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Query Suggestions Block List: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateQuerySuggestionsBlockListWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Kendra Query Suggestions Block List (%s): %s", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateQuerySuggestionsBlockListOutput).Id)
+	indexID := d.Get("index_id").(string)
+
+	d.SetId(createResourceID(id, indexID))
+
+	if _, err := waitQuerySuggestionsBlockListCreated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kendra Query Suggestions Block List (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceQuerySuggestionsBlockListRead(ctx, d, meta)
+}
+
+func resourceQuerySuggestionsBlockListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindQuerySuggestionsBlockListByID(ctx, conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Query Suggestions Block List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kendra Query Suggestions Block List (%s): %s", d.Id(), err)
+	}
+
+	arn := querySuggestionsBlockListARN(meta.(*conns.AWSClient), id, indexID)
+
+	if err := setQuerySuggestionsBlockListAttributes(d, output, arn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Kendra Query Suggestions Block List (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceQuerySuggestionsBlockListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexID, err := parseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &kendra.UpdateQuerySuggestionsBlockListInput{
+			Id:      aws.String(id),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source_s3_path") {
+			input.SourceS3Path = expandS3Path(d.Get("source_s3_path").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Query Suggestions Block List: %s", input)
+		_, err = tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateQuerySuggestionsBlockListWithContext(ctx, input)
+			},
+			retryableIAMPropagation,
+		)
+
+		if err != nil {
+			return diag.Errorf("error updating Kendra Query Suggestions Block List (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitQuerySuggestionsBlockListUpdated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Kendra Query Suggestions Block List (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Kendra Query Suggestions Block List (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceQuerySuggestionsBlockListRead(ctx, d, meta)
+}
+
+func resourceQuerySuggestionsBlockListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Query Suggestions Block List: %s", d.Id())
+	_, err = conn.DeleteQuerySuggestionsBlockListWithContext(ctx, &kendra.DeleteQuerySuggestionsBlockListInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kendra Query Suggestions Block List (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitQuerySuggestionsBlockListDeleted(ctx, conn, id, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kendra Query Suggestions Block List (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setQuerySuggestionsBlockListAttributes(d *schema.ResourceData, output *kendra.DescribeQuerySuggestionsBlockListOutput, arn string) error {
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("file_size_bytes", output.FileSizeBytes)
+	d.Set("index_id", output.IndexId)
+	d.Set("item_count", output.ItemCount)
+	d.Set("name", output.Name)
+	d.Set("query_suggestions_block_list_id", output.Id)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("source_s3_path", flattenS3Path(output.SourceS3Path)); err != nil {
+		return fmt.Errorf("error setting source_s3_path: %w", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+func querySuggestionsBlockListARN(client *conns.AWSClient, id, indexID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "kendra",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("index/%s/query-suggestions-block-list/%s", indexID, id),
+	}.String()
+}
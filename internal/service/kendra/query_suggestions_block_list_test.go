@@ -0,0 +1,300 @@
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraQuerySuggestionsBlockList_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+	indexResourceName := "aws_kendra_index.test"
+	roleResourceName := "aws_iam_role.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/query-suggestions-block-list/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "query_suggestions_block_list_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", indexResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.bucket", bucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test/blocklist.txt"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.QuerySuggestionsBlockListStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceQuerySuggestionsBlockList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test/blocklist.txt"),
+				),
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_update(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test/updated-blocklist.txt"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.QuerySuggestionsBlockListStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraQuerySuggestionsBlockList_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_query_suggestions_block_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQuerySuggestionsBlockListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccQuerySuggestionsBlockListConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuerySuggestionsBlockListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuerySuggestionsBlockListDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_query_suggestions_block_list" {
+			continue
+		}
+
+		_, err := tfkendra.FindQuerySuggestionsBlockListByID(context.Background(), conn, rs.Primary.Attributes["query_suggestions_block_list_id"], rs.Primary.Attributes["index_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Query Suggestions Block List %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckQuerySuggestionsBlockListExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Query Suggestions Block List ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		_, err := tfkendra.FindQuerySuggestionsBlockListByID(context.Background(), conn, rs.Primary.Attributes["query_suggestions_block_list_id"], rs.Primary.Attributes["index_id"])
+
+		return err
+	}
+}
+
+func testAccQuerySuggestionsBlockListConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_baseS3(rName), `
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test/blocklist.txt"
+  content = "blocked"
+}
+
+resource "aws_s3_object" "updated" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test/updated-blocklist.txt"
+  content = "blocked"
+}
+`)
+}
+
+func testAccQuerySuggestionsBlockListConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName))
+}
+
+func testAccQuerySuggestionsBlockListConfig_update(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.updated.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName2))
+}
+
+func testAccQuerySuggestionsBlockListConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccQuerySuggestionsBlockListConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccQuerySuggestionsBlockListConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_query_suggestions_block_list" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
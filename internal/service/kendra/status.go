@@ -0,0 +1,106 @@
+package kendra
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusIndex(ctx context.Context, conn *kendra.Kendra, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindIndexByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDataSource(ctx context.Context, conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDataSourceByID(ctx, conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusExperience(ctx context.Context, conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindExperienceByID(ctx, conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusFAQ(ctx context.Context, conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFAQByID(ctx, conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusQuerySuggestionsBlockList(ctx context.Context, conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindQuerySuggestionsBlockListByID(ctx, conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusThesaurus(ctx context.Context, conn *kendra.Kendra, id, indexID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindThesaurusByID(ctx, conn, id, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,76 @@
+//go:build sweep
+// +build sweep
+
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_kendra_index", &resource.Sweeper{
+		Name: "aws_kendra_index",
+		F:    sweepIndexes,
+	})
+}
+
+// sweepIndexes deletes Kendra indexes. Deleting an index also deletes its
+// data sources, FAQs, thesauri, experiences and query suggestions block lists.
+func sweepIndexes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).KendraConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &kendra.ListIndicesInput{}
+
+	err = conn.ListIndicesPagesWithContext(ctx, input, func(page *kendra.ListIndicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, index := range page.IndexConfigurationSummaryItems {
+			if index == nil {
+				continue
+			}
+
+			r := ResourceIndex()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(index.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Kendra Indexes sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Kendra Indexes (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Kendra Indexes (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
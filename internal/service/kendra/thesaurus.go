@@ -0,0 +1,319 @@
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceThesaurus() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceThesaurusCreate,
+		ReadWithoutTimeout:   resourceThesaurusRead,
+		UpdateWithoutTimeout: resourceThesaurusUpdate,
+		DeleteWithoutTimeout: resourceThesaurusDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"index_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(36, 36),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_s3_path": s3PathSchema(false),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"synonym_rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"term_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"thesaurus_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceThesaurusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kendra.CreateThesaurusInput{
+		IndexId:      aws.String(d.Get("index_id").(string)),
+		Name:         aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		SourceS3Path: expandS3Path(d.Get("source_s3_path").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kendra Thesaurus: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateThesaurusWithContext(ctx, input)
+		},
+		retryableIAMPropagation,
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Kendra Thesaurus (%s): %s", name, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*kendra.CreateThesaurusOutput).Id)
+	indexID := d.Get("index_id").(string)
+
+	d.SetId(createResourceID(id, indexID))
+
+	if _, err := waitThesaurusCreated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kendra Thesaurus (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceThesaurusRead(ctx, d, meta)
+}
+
+func resourceThesaurusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindThesaurusByID(ctx, conn, id, indexID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Thesaurus (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kendra Thesaurus (%s): %s", d.Id(), err)
+	}
+
+	arn := thesaurusARN(meta.(*conns.AWSClient), id, indexID)
+
+	if err := setThesaurusAttributes(d, output, arn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Kendra Thesaurus (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceThesaurusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexID, err := parseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &kendra.UpdateThesaurusInput{
+			Id:      aws.String(id),
+			IndexId: aws.String(indexID),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source_s3_path") {
+			input.SourceS3Path = expandS3Path(d.Get("source_s3_path").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kendra Thesaurus: %s", input)
+		_, err = tfresource.RetryWhenContext(ctx, tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.UpdateThesaurusWithContext(ctx, input)
+			},
+			retryableIAMPropagation,
+		)
+
+		if err != nil {
+			return diag.Errorf("error updating Kendra Thesaurus (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitThesaurusUpdated(ctx, conn, id, indexID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Kendra Thesaurus (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Kendra Thesaurus (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceThesaurusRead(ctx, d, meta)
+}
+
+func resourceThesaurusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraConn
+
+	id, indexID, err := parseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kendra Thesaurus: %s", d.Id())
+	_, err = conn.DeleteThesaurusWithContext(ctx, &kendra.DeleteThesaurusInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kendra.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kendra Thesaurus (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitThesaurusDeleted(ctx, conn, id, indexID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kendra Thesaurus (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setThesaurusAttributes(d *schema.ResourceData, output *kendra.DescribeThesaurusOutput, arn string) error {
+	d.Set("arn", arn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("error_message", output.ErrorMessage)
+	d.Set("file_size_bytes", output.FileSizeBytes)
+	d.Set("index_id", output.IndexId)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("source_s3_path", flattenS3Path(output.SourceS3Path)); err != nil {
+		return fmt.Errorf("error setting source_s3_path: %w", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("synonym_rule_count", output.SynonymRuleCount)
+	d.Set("term_count", output.TermCount)
+	d.Set("thesaurus_id", output.Id)
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	return nil
+}
+
+func thesaurusARN(client *conns.AWSClient, id, indexID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "kendra",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("index/%s/thesaurus/%s", indexID, id),
+	}.String()
+}
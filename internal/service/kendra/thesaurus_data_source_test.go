@@ -0,0 +1,56 @@
+package kendra_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKendraThesaurusDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kendra_thesaurus.test"
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "file_size_bytes", resourceName, "file_size_bytes"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_id", resourceName, "index_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_s3_path.#", resourceName, "source_s3_path.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_s3_path.0.bucket", resourceName, "source_s3_path.0.bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_s3_path.0.key", resourceName, "source_s3_path.0.key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "synonym_rule_count", resourceName, "synonym_rule_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "term_count", resourceName, "term_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "thesaurus_id", resourceName, "thesaurus_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "updated_at", resourceName, "updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccThesaurusDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccThesaurusConfig_tags1(rName, "key1", "value1"), `
+data "aws_kendra_thesaurus" "test" {
+  index_id     = aws_kendra_index.test.id
+  thesaurus_id = aws_kendra_thesaurus.test.thesaurus_id
+}
+`)
+}
@@ -0,0 +1,300 @@
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kendra"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKendraThesaurus_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+	indexResourceName := "aws_kendra_index.test"
+	roleResourceName := "aws_iam_role.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kendra", regexp.MustCompile(`index/.+/thesaurus/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "thesaurus_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", indexResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_s3_path.0.bucket", bucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test/thesaurus.txt"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.ThesaurusStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraThesaurus_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkendra.ResourceThesaurus(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraThesaurus_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test/thesaurus.txt"),
+				),
+			},
+			{
+				Config: testAccThesaurusConfig_update(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "source_s3_path.0.key", "test/updated-thesaurus.txt"),
+					resource.TestCheckResourceAttr(resourceName, "status", kendra.ThesaurusStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKendraThesaurus_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_thesaurus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kendra.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kendra.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckThesaurusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThesaurusConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccThesaurusConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccThesaurusConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThesaurusExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckThesaurusDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kendra_thesaurus" {
+			continue
+		}
+
+		_, err := tfkendra.FindThesaurusByID(context.Background(), conn, rs.Primary.Attributes["thesaurus_id"], rs.Primary.Attributes["index_id"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kendra Thesaurus %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckThesaurusExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Thesaurus ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraConn
+
+		_, err := tfkendra.FindThesaurusByID(context.Background(), conn, rs.Primary.Attributes["thesaurus_id"], rs.Primary.Attributes["index_id"])
+
+		return err
+	}
+}
+
+func testAccThesaurusConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_baseS3(rName), `
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test/thesaurus.txt"
+  content = "Kendra,Amazon Kendra"
+}
+
+resource "aws_s3_object" "updated" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "test/updated-thesaurus.txt"
+  content = "Kendra,Amazon Kendra"
+}
+`)
+}
+
+func testAccThesaurusConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccThesaurusConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_thesaurus" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName))
+}
+
+func testAccThesaurusConfig_update(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccThesaurusConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_thesaurus" "test" {
+  index_id    = aws_kendra_index.test.id
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.updated.key
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName2))
+}
+
+func testAccThesaurusConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccThesaurusConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_thesaurus" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccThesaurusConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccThesaurusConfig_base(rName), fmt.Sprintf(`
+resource "aws_kendra_thesaurus" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  source_s3_path {
+    bucket = aws_s3_bucket.test.id
+    key    = aws_s3_object.test.key
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.s3]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
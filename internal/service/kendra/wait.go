@@ -0,0 +1,369 @@
+package kendra
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kendra"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitIndexCreated(ctx context.Context, conn *kendra.Kendra, id string, timeout time.Duration) (*kendra.DescribeIndexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.IndexStatusCreating},
+		Target:  []string{kendra.IndexStatusActive},
+		Refresh: statusIndex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexUpdated(ctx context.Context, conn *kendra.Kendra, id string, timeout time.Duration) (*kendra.DescribeIndexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.IndexStatusUpdating, kendra.IndexStatusSystemUpdating},
+		Target:  []string{kendra.IndexStatusActive},
+		Refresh: statusIndex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIndexDeleted(ctx context.Context, conn *kendra.Kendra, id string, timeout time.Duration) (*kendra.DescribeIndexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.IndexStatusDeleting},
+		Target:  []string{},
+		Refresh: statusIndex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeIndexOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceCreated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeDataSourceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.DataSourceStatusCreating},
+		Target:  []string{kendra.DataSourceStatusActive},
+		Refresh: statusDataSource(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceUpdated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeDataSourceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.DataSourceStatusUpdating},
+		Target:  []string{kendra.DataSourceStatusActive},
+		Refresh: statusDataSource(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeDataSourceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.DataSourceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDataSource(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeDataSourceOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperienceCreated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeExperienceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ExperienceStatusCreating},
+		Target:  []string{kendra.ExperienceStatusActive},
+		Refresh: statusExperience(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperienceUpdated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeExperienceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ExperienceStatusCreating},
+		Target:  []string{kendra.ExperienceStatusActive},
+		Refresh: statusExperience(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperienceDeleted(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeExperienceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ExperienceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusExperience(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFAQCreated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeFaqOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.FaqStatusCreating},
+		Target:  []string{kendra.FaqStatusActive},
+		Refresh: statusFAQ(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFAQDeleted(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeFaqOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.FaqStatusDeleting},
+		Target:  []string{},
+		Refresh: statusFAQ(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListCreated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusCreating},
+		Target:  []string{kendra.QuerySuggestionsBlockListStatusActive},
+		Refresh: statusQuerySuggestionsBlockList(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListUpdated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusUpdating},
+		Target:  []string{kendra.QuerySuggestionsBlockListStatusActive},
+		Refresh: statusQuerySuggestionsBlockList(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuerySuggestionsBlockListDeleted(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeQuerySuggestionsBlockListOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.QuerySuggestionsBlockListStatusDeleting},
+		Target:  []string{},
+		Refresh: statusQuerySuggestionsBlockList(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeQuerySuggestionsBlockListOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusCreated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusCreating},
+		Target:  []string{kendra.ThesaurusStatusActive},
+		Refresh: statusThesaurus(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusUpdated(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusUpdating},
+		Target:  []string{kendra.ThesaurusStatusActive},
+		Refresh: statusThesaurus(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitThesaurusDeleted(ctx context.Context, conn *kendra.Kendra, id, indexID string, timeout time.Duration) (*kendra.DescribeThesaurusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kendra.ThesaurusStatusDeleting},
+		Target:  []string{},
+		Refresh: statusThesaurus(ctx, conn, id, indexID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kendra.DescribeThesaurusOutput); ok {
+		if v := aws.StringValue(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
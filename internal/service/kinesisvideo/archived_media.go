@@ -0,0 +1,61 @@
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kinesisvideoarchivedmedia"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// archivedMediaConn returns a Kinesis Video Archived Media client for the data endpoint that serves apiName for a stream.
+// Archived media APIs must be called on the stream's own endpoint rather than the regional one.
+func archivedMediaConn(ctx context.Context, awsClient *conns.AWSClient, apiName, streamARN, streamName string) (*kinesisvideoarchivedmedia.KinesisVideoArchivedMedia, error) {
+	input := &kinesisvideo.GetDataEndpointInput{
+		APIName: aws.String(apiName),
+	}
+
+	if streamARN != "" {
+		input.StreamARN = aws.String(streamARN)
+	} else {
+		input.StreamName = aws.String(streamName)
+	}
+
+	output, err := awsClient.KinesisVideoConn.GetDataEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting Kinesis Video Stream data endpoint: %w", err)
+	}
+
+	if output == nil || output.DataEndpoint == nil {
+		return nil, fmt.Errorf("error getting Kinesis Video Stream data endpoint: empty response")
+	}
+
+	sess, err := session.NewSession(&awsClient.KinesisVideoArchivedMediaConn.Config)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kinesis Video Archived Media session: %w", err)
+	}
+
+	return kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: output.DataEndpoint})), nil
+}
+
+func expandTimestampRange(tfMap map[string]interface{}) (*time.Time, *time.Time) {
+	var start, end *time.Time
+
+	if v, ok := tfMap["start_timestamp"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		start = aws.Time(t)
+	}
+
+	if v, ok := tfMap["end_timestamp"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		end = aws.Time(t)
+	}
+
+	return start, end
+}
@@ -0,0 +1,36 @@
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindSignalingChannelByARN(ctx context.Context, conn *kinesisvideo.KinesisVideo, arn string) (*kinesisvideo.ChannelInfo, error) {
+	input := &kinesisvideo.DescribeSignalingChannelInput{
+		ChannelARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSignalingChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, kinesisvideo.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChannelInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChannelInfo, nil
+}
@@ -0,0 +1,258 @@
+package kinesisvideo
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSignalingChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSignalingChannelCreate,
+		ReadWithoutTimeout:   resourceSignalingChannelRead,
+		UpdateWithoutTimeout: resourceSignalingChannelUpdate,
+		DeleteWithoutTimeout: resourceSignalingChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      kinesisvideo.ChannelTypeSingleMaster,
+				ValidateFunc: validation.StringInSlice(kinesisvideo.ChannelType_Values(), false),
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"single_master_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_ttl_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(5, 120),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSignalingChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &kinesisvideo.CreateSignalingChannelInput{
+		ChannelName: aws.String(name),
+		ChannelType: aws.String(d.Get("channel_type").(string)),
+	}
+
+	if v, ok := d.GetOk("single_master_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SingleMasterConfiguration = expandSingleMasterConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = ResourceTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Video Signaling Channel: %s", input)
+	output, err := conn.CreateSignalingChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Kinesis Video Signaling Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ChannelARN))
+
+	if _, err := waitSignalingChannelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kinesis Video Signaling Channel (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceSignalingChannelRead(ctx, d, meta)
+}
+
+func resourceSignalingChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channel, err := FindSignalingChannelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Signaling Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", channel.ChannelARN)
+	d.Set("channel_type", channel.ChannelType)
+	if channel.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(channel.CreationTime).Format(time.RFC3339))
+	}
+	d.Set("name", channel.ChannelName)
+	if channel.SingleMasterConfiguration != nil {
+		if err := d.Set("single_master_configuration", []interface{}{flattenSingleMasterConfiguration(channel.SingleMasterConfiguration)}); err != nil {
+			return diag.Errorf("error setting single_master_configuration: %s", err)
+		}
+	} else {
+		d.Set("single_master_configuration", nil)
+	}
+	d.Set("version", channel.Version)
+
+	tags, err := ListResourceTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSignalingChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+
+	if d.HasChange("single_master_configuration") {
+		input := &kinesisvideo.UpdateSignalingChannelInput{
+			ChannelARN:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+		}
+
+		if v, ok := d.GetOk("single_master_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SingleMasterConfiguration = expandSingleMasterConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Signaling Channel: %s", input)
+		if _, err := conn.UpdateSignalingChannelWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSignalingChannelUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Kinesis Video Signaling Channel (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateResourceTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Kinesis Video Signaling Channel (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSignalingChannelRead(ctx, d, meta)
+}
+
+func resourceSignalingChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Signaling Channel: %s", d.Id())
+	_, err := conn.DeleteSignalingChannelWithContext(ctx, &kinesisvideo.DeleteSignalingChannelInput{
+		ChannelARN:     aws.String(d.Id()),
+		CurrentVersion: aws.String(d.Get("version").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kinesisvideo.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSignalingChannelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kinesis Video Signaling Channel (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSingleMasterConfiguration(tfMap map[string]interface{}) *kinesisvideo.SingleMasterConfiguration {
+	apiObject := &kinesisvideo.SingleMasterConfiguration{}
+
+	if v, ok := tfMap["message_ttl_seconds"].(int); ok && v != 0 {
+		apiObject.MessageTtlSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenSingleMasterConfiguration(apiObject *kinesisvideo.SingleMasterConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MessageTtlSeconds; v != nil {
+		tfMap["message_ttl_seconds"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,85 @@
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceSignalingChannelEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSignalingChannelEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"channel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(kinesisvideo.ChannelProtocol_Values(), false),
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kinesisvideo.ChannelRoleMaster,
+				ValidateFunc: validation.StringInSlice(kinesisvideo.ChannelRole_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceSignalingChannelEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KinesisVideoConn
+
+	arn := d.Get("channel_arn").(string)
+	input := &kinesisvideo.GetSignalingChannelEndpointInput{
+		ChannelARN: aws.String(arn),
+		SingleMasterChannelEndpointConfiguration: &kinesisvideo.SingleMasterChannelEndpointConfiguration{
+			Role: aws.String(d.Get("role").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("protocols"); ok && v.(*schema.Set).Len() > 0 {
+		input.SingleMasterChannelEndpointConfiguration.Protocols = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := conn.GetSignalingChannelEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error reading Kinesis Video Signaling Channel (%s) endpoints: %s", arn, err)
+	}
+
+	endpoints := make(map[string]interface{})
+	for _, v := range output.ResourceEndpointList {
+		if v == nil {
+			continue
+		}
+
+		endpoints[aws.StringValue(v.Protocol)] = aws.StringValue(v.ResourceEndpoint)
+	}
+
+	d.SetId(arn)
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return diag.Errorf("error setting endpoints: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package kinesisvideo_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKinesisVideoSignalingChannelEndpointDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_kinesisvideo_signaling_channel_endpoint.test"
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "channel_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "role", kinesisvideo.ChannelRoleViewer),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints.%", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "endpoints.WSS", regexp.MustCompile(`^wss://`)),
+					resource.TestMatchResourceAttr(dataSourceName, "endpoints.HTTPS", regexp.MustCompile(`^https://`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignalingChannelEndpointDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+}
+
+data "aws_kinesisvideo_signaling_channel_endpoint" "test" {
+  channel_arn = aws_kinesisvideo_signaling_channel.test.arn
+  protocols   = ["WSS", "HTTPS"]
+  role        = "VIEWER"
+}
+`, rName)
+}
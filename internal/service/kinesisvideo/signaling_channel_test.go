@@ -0,0 +1,239 @@
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKinesisVideoSignalingChannel_basic(t *testing.T) {
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSignalingChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kinesisvideo", regexp.MustCompile(fmt.Sprintf("channel/%s/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "channel_type", kinesisvideo.ChannelTypeSingleMaster),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "single_master_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_master_configuration.0.message_ttl_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_disappears(t *testing.T) {
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSignalingChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkinesisvideo.ResourceSignalingChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_tags(t *testing.T) {
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSignalingChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalingChannelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSignalingChannelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_messageTTL(t *testing.T) {
+	resourceName := "aws_kinesisvideo_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSignalingChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_messageTTL(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "single_master_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_master_configuration.0.message_ttl_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalingChannelConfig_messageTTL(rName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "single_master_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_master_configuration.0.message_ttl_seconds", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSignalingChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesisvideo_signaling_channel" {
+			continue
+		}
+
+		_, err := tfkinesisvideo.FindSignalingChannelByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Kinesis Video Signaling Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSignalingChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Video Signaling Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn
+
+		_, err := tfkinesisvideo.FindSignalingChannelByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSignalingChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSignalingChannelConfig_messageTTL(rName string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+
+  single_master_configuration {
+    message_ttl_seconds = %[2]d
+  }
+}
+`, rName, ttl)
+}
+
+func testAccSignalingChannelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSignalingChannelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesisvideo_signaling_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,26 @@
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusSignalingChannel(ctx context.Context, conn *kinesisvideo.KinesisVideo, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSignalingChannelByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ChannelStatus), nil
+	}
+}
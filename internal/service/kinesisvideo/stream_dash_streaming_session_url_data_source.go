@@ -0,0 +1,157 @@
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kinesisvideoarchivedmedia"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceStreamDASHStreamingSessionURL() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStreamDASHStreamingSessionURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"display_fragment_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kinesisvideoarchivedmedia.DASHDisplayFragmentNumber_Values(), false),
+			},
+			"display_fragment_timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kinesisvideoarchivedmedia.DASHDisplayFragmentTimestamp_Values(), false),
+			},
+			"expires": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(300, 43200),
+			},
+			"fragment_selector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"fragment_selector_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(kinesisvideoarchivedmedia.DASHFragmentSelectorType_Values(), false),
+						},
+						"start_timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+					},
+				},
+			},
+			"max_manifest_fragment_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"playback_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kinesisvideoarchivedmedia.DASHPlaybackModeLive,
+				ValidateFunc: validation.StringInSlice(kinesisvideoarchivedmedia.DASHPlaybackMode_Values(), false),
+			},
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"stream_arn", "stream_name"},
+			},
+			"stream_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"stream_arn", "stream_name"},
+			},
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceStreamDASHStreamingSessionURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	streamARN := d.Get("stream_arn").(string)
+	streamName := d.Get("stream_name").(string)
+
+	conn, err := archivedMediaConn(ctx, meta.(*conns.AWSClient), kinesisvideo.APINameGetDashStreamingSessionUrl, streamARN, streamName)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &kinesisvideoarchivedmedia.GetDASHStreamingSessionURLInput{
+		PlaybackMode: aws.String(d.Get("playback_mode").(string)),
+	}
+
+	if streamARN != "" {
+		input.StreamARN = aws.String(streamARN)
+	} else {
+		input.StreamName = aws.String(streamName)
+	}
+
+	if v, ok := d.GetOk("display_fragment_number"); ok {
+		input.DisplayFragmentNumber = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_fragment_timestamp"); ok {
+		input.DisplayFragmentTimestamp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expires"); ok {
+		input.Expires = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("fragment_selector"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		start, end := expandTimestampRange(tfMap)
+
+		input.DASHFragmentSelector = &kinesisvideoarchivedmedia.DASHFragmentSelector{
+			FragmentSelectorType: aws.String(tfMap["fragment_selector_type"].(string)),
+		}
+
+		if start != nil || end != nil {
+			input.DASHFragmentSelector.TimestampRange = &kinesisvideoarchivedmedia.DASHTimestampRange{
+				EndTimestamp:   end,
+				StartTimestamp: start,
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("max_manifest_fragment_results"); ok {
+		input.MaxManifestFragmentResults = aws.Int64(int64(v.(int)))
+	}
+
+	output, err := conn.GetDASHStreamingSessionURLWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error getting Kinesis Video Stream DASH streaming session URL: %s", err)
+	}
+
+	if streamARN != "" {
+		d.SetId(streamARN)
+	} else {
+		d.SetId(streamName)
+	}
+	d.Set("url", output.DASHStreamingSessionURL)
+
+	return nil
+}
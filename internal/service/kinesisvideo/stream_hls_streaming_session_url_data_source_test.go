@@ -0,0 +1,45 @@
+package kinesisvideo_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// A new stream has no media, so the session URL can't be issued.
+// The expected error shows that the request reached the stream's archived media endpoint.
+func TestAccKinesisVideoStreamHLSStreamingSessionURLDataSource_noFragments(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, kinesisvideo.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStreamHLSStreamingSessionURLDataSourceConfig_basic(rName),
+				ExpectError: regexp.MustCompile(`ResourceNotFoundException`),
+			},
+		},
+	})
+}
+
+func testAccStreamHLSStreamingSessionURLDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+data "aws_kinesisvideo_stream_hls_streaming_session_url" "test" {
+  stream_arn    = aws_kinesis_video_stream.test.arn
+  playback_mode = "LIVE"
+  expires       = 300
+}
+`, rName)
+}
@@ -0,0 +1,70 @@
+//go:build sweep
+// +build sweep
+
+package kinesisvideo
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_kinesisvideo_signaling_channel", &resource.Sweeper{
+		Name: "aws_kinesisvideo_signaling_channel",
+		F:    sweepSignalingChannels,
+	})
+}
+
+func sweepSignalingChannels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).KinesisVideoConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &kinesisvideo.ListSignalingChannelsInput{}
+
+	err = conn.ListSignalingChannelsPagesWithContext(context.Background(), input, func(page *kinesisvideo.ListSignalingChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ChannelInfoList {
+			r := ResourceSignalingChannel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChannelARN))
+			d.Set("version", v.Version)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Kinesis Video Signaling Channels sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Kinesis Video Signaling Channels (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Kinesis Video Signaling Channels (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
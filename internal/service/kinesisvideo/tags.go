@@ -0,0 +1,79 @@
+// Custom Kinesis Video tag service functions for signaling channels, using the same format as generated code.
+// Signaling channels are tagged with the generic resource operations instead of the stream-specific ones.
+
+package kinesisvideo
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// ListResourceTags lists kinesisvideo service tags for a resource other than a stream.
+func ListResourceTags(conn *kinesisvideo.KinesisVideo, identifier string) (tftags.KeyValueTags, error) {
+	input := &kinesisvideo.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.Tags), nil
+}
+
+// []*SERVICE.Tag handling
+
+// ResourceTags returns kinesisvideo service tags for a resource other than a stream.
+func ResourceTags(tags tftags.KeyValueTags) []*kinesisvideo.Tag {
+	result := make([]*kinesisvideo.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &kinesisvideo.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// UpdateResourceTags updates kinesisvideo service tags for a resource other than a stream.
+func UpdateResourceTags(conn *kinesisvideo.KinesisVideo, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &kinesisvideo.UntagResourceInput{
+			ResourceARN: aws.String(identifier),
+			TagKeyList:  aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &kinesisvideo.TagResourceInput{
+			ResourceARN: aws.String(identifier),
+			Tags:        ResourceTags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
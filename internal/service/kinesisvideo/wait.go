@@ -0,0 +1,60 @@
+package kinesisvideo
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitSignalingChannelCreated(ctx context.Context, conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) (*kinesisvideo.ChannelInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisvideo.StatusCreating},
+		Target:  []string{kinesisvideo.StatusActive},
+		Refresh: statusSignalingChannel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelUpdated(ctx context.Context, conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) (*kinesisvideo.ChannelInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisvideo.StatusUpdating},
+		Target:  []string{kinesisvideo.StatusActive},
+		Refresh: statusSignalingChannel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelDeleted(ctx context.Context, conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) (*kinesisvideo.ChannelInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisvideo.StatusDeleting, kinesisvideo.StatusActive},
+		Target:  []string{},
+		Refresh: statusSignalingChannel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,10 +1,13 @@
 package lambda
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindEventSourceMappingConfigurationByID returns the event source mapping corresponding to the specified ID.
@@ -43,3 +46,34 @@ func FindEventSourceMappingConfiguration(conn *lambda.Lambda, input *lambda.GetE
 
 	return output, nil
 }
+
+// FindFunctionURLByNameAndQualifier returns the function URL configuration corresponding to the specified function name and qualifier.
+// Returns NotFoundError if no function URL configuration is found.
+func FindFunctionURLByNameAndQualifier(ctx context.Context, conn *lambda.Lambda, name, qualifier string) (*lambda.GetFunctionUrlConfigOutput, error) {
+	input := &lambda.GetFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetFunctionUrlConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
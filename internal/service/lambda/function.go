@@ -42,10 +42,7 @@ func ResourceFunction() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				d.Set("function_name", d.Id())
-				return []*schema.ResourceData{d}, nil
-			},
+			State: resourceFunctionImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -208,6 +205,26 @@ func ResourceFunction() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(lambda.Runtime_Values(), false),
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"update_runtime_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lambda.UpdateRuntimeOn_Values(), false),
+						},
+					},
+				},
+			},
 			"timeout": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -304,6 +321,22 @@ func ResourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"ephemeral_storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(512, 10240),
+						},
+					},
+				},
+			},
 			"tracing_config": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -327,12 +360,31 @@ func ResourceFunction() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"snap_start": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_on": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{lambda.SnapStartApplyOnPublishedVersions}, false),
+						},
+						"optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkRuntimeManagementConfig,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -350,6 +402,32 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+func checkRuntimeManagementConfig(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("runtime_management_config") {
+		return nil
+	}
+
+	v, ok := d.GetOk("runtime_management_config")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	updateRuntimeOn := tfMap["update_runtime_on"].(string)
+	runtimeVersionARN := tfMap["runtime_version_arn"].(string)
+
+	if updateRuntimeOn == lambda.UpdateRuntimeOnManual && runtimeVersionARN == "" && d.NewValueKnown("runtime_management_config.0.runtime_version_arn") {
+		return fmt.Errorf("runtime_management_config.0.runtime_version_arn must be set when update_runtime_on is %s", lambda.UpdateRuntimeOnManual)
+	}
+
+	if updateRuntimeOn != lambda.UpdateRuntimeOnManual && runtimeVersionARN != "" {
+		return fmt.Errorf("runtime_management_config.0.runtime_version_arn can only be set when update_runtime_on is %s", lambda.UpdateRuntimeOnManual)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -381,7 +459,9 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 		d.HasChange("vpc_config.0.security_group_ids") ||
 		d.HasChange("vpc_config.0.subnet_ids") ||
 		d.HasChange("runtime") ||
-		d.HasChange("environment")
+		d.HasChange("environment") ||
+		d.HasChange("ephemeral_storage") ||
+		d.HasChange("snap_start")
 }
 
 // resourceAwsLambdaFunction maps to:
@@ -438,6 +518,14 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// The runtime management configuration can only be set once the function exists,
+	// and versions published with SnapStart enabled must be waited on until they are active,
+	// so in either case defer publishing the first version until after creation.
+	publish := d.Get("publish").(bool)
+	runtimeManagementConfig, hasRuntimeManagementConfig := d.GetOk("runtime_management_config")
+	_, hasSnapStart := d.GetOk("snap_start")
+	publishOnCreate := publish && !hasRuntimeManagementConfig && !hasSnapStart
+
 	packageType := d.Get("package_type").(string)
 	params := &lambda.CreateFunctionInput{
 		Code:         functionCode,
@@ -446,7 +534,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		MemorySize:   aws.Int64(int64(d.Get("memory_size").(int))),
 		Role:         aws.String(iamRole),
 		Timeout:      aws.Int64(int64(d.Get("timeout").(int))),
-		Publish:      aws.Bool(d.Get("publish").(bool)),
+		Publish:      aws.Bool(publishOnCreate),
 		PackageType:  aws.String(packageType),
 	}
 
@@ -481,6 +569,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("ephemeral_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.EphemeralStorage = expandEphemeralStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("file_system_config"); ok && len(v.([]interface{})) > 0 {
 		params.FileSystemConfigs = expandFileSystemConfigs(v.([]interface{}))
 	}
@@ -489,6 +581,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.ImageConfig = expandImageConfigs(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.SnapStart = expandSnapStart(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 {
 		config := v.([]interface{})[0].(map[string]interface{})
 
@@ -599,6 +695,18 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for Lambda Function (%s) creation: %w", d.Id(), err)
 	}
 
+	if hasRuntimeManagementConfig && len(runtimeManagementConfig.([]interface{})) > 0 && runtimeManagementConfig.([]interface{})[0] != nil {
+		if err := putFunctionRuntimeManagementConfig(conn, d.Id(), runtimeManagementConfig.([]interface{})[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if publish && !publishOnCreate {
+		if err := publishFunctionVersion(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	if reservedConcurrentExecutions >= 0 {
 
 		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
@@ -705,6 +813,10 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting function description for Lambda Function: %w", err)
 	}
 
+	if err := d.Set("ephemeral_storage", flattenEphemeralStorage(function.EphemeralStorage)); err != nil {
+		return fmt.Errorf("error setting ephemeral_storage for Lambda Function (%s): %w", d.Id(), err)
+	}
+
 	if err := d.Set("handler", function.Handler); err != nil {
 		return fmt.Errorf("error setting handler for Lambda Function: %w", err)
 	}
@@ -733,6 +845,10 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting KMS key arn for Lambda Function: %w", err)
 	}
 
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return fmt.Errorf("error setting snap_start for Lambda Function (%s): %w", d.Id(), err)
+	}
+
 	if err := d.Set("source_code_hash", function.CodeSha256); err != nil {
 		return fmt.Errorf("error setting CodeSha256 for Lambda Function: %w", err)
 	}
@@ -845,6 +961,27 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	invokeArn := functionInvokeArn(*function.FunctionArn, meta)
 	d.Set("invoke_arn", invokeArn)
 
+	// Runtime management is only supported on zip packaged lambda functions.
+	// The configuration is only read when it is configured or already in state, so that
+	// callers without access to the API, or in partitions without it, are unaffected.
+	if aws.StringValue(function.PackageType) == lambda.PackageTypeZip {
+		if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 {
+			output, err := conn.GetRuntimeManagementConfig(&lambda.GetRuntimeManagementConfigInput{
+				FunctionName: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error getting Lambda Function (%s) runtime management config: %w", d.Id(), err)
+			}
+
+			if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+				return fmt.Errorf("error setting runtime_management_config for Lambda Function (%s): %w", d.Id(), err)
+			}
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
+
 	// Currently, this functionality is only enabled in AWS Commercial partition
 	// and other partitions return ambiguous error codes (e.g. AccessDeniedException
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
@@ -883,6 +1020,44 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceFunctionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	d.Set("function_name", d.Id())
+
+	// The runtime management configuration is only read when it is present in state,
+	// so record any non-default configuration here.
+	output, err := conn.GetRuntimeManagementConfig(&lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(d.Id()),
+	})
+
+	if runtimeManagementConfigUnsupported(err) || tfawserr.ErrCodeEquals(err, lambda.ErrCodeInvalidParameterValueException) {
+		log.Printf("[WARN] Unable to read Lambda Function (%s) runtime management config: %s", d.Id(), err)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lambda Function (%s) runtime management config: %w", d.Id(), err)
+	}
+
+	if aws.StringValue(output.UpdateRuntimeOn) != lambda.UpdateRuntimeOnAuto || aws.StringValue(output.RuntimeVersionArn) != "" {
+		if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+			return nil, fmt.Errorf("error setting runtime_management_config for Lambda Function (%s): %w", d.Id(), err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// runtimeManagementConfigUnsupported returns whether the error indicates that the runtime
+// management configuration can't be read, e.g. the action isn't allowed by the caller's
+// IAM policy or the API isn't available in the partition or Region.
+func runtimeManagementConfigUnsupported(err error) bool {
+	return tfawserr.ErrCodeContains(err, verify.ErrCodeAccessDenied) ||
+		tfawserr.ErrCodeContains(err, verify.ErrCodeUnknownOperationException) ||
+		tfawserr.ErrCodeContains(err, verify.ErrCodeUnsupportedOperation)
+}
+
 func listVersionsByFunctionPages(c *lambda.Lambda, input *lambda.ListVersionsByFunctionInput,
 	fn func(p *lambda.ListVersionsByFunctionOutput, lastPage bool) bool) error {
 	for {
@@ -987,6 +1162,11 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("handler") {
 		configReq.Handler = aws.String(d.Get("handler").(string))
 	}
+	if d.HasChange("ephemeral_storage") {
+		if v, ok := d.GetOk("ephemeral_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			configReq.EphemeralStorage = expandEphemeralStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
 	if d.HasChange("file_system_config") {
 		configReq.FileSystemConfigs = make([]*lambda.FileSystemConfig, 0)
 		if v, ok := d.GetOk("file_system_config"); ok && len(v.([]interface{})) > 0 {
@@ -1049,6 +1229,14 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("runtime") {
 		configReq.Runtime = aws.String(d.Get("runtime").(string))
 	}
+	if d.HasChange("snap_start") {
+		configReq.SnapStart = &lambda.SnapStart{
+			ApplyOn: aws.String(lambda.SnapStartApplyOnNone),
+		}
+		if v, ok := d.GetOk("snap_start"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			configReq.SnapStart = expandSnapStart(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
 	if d.HasChange("environment") {
 		if v, ok := d.GetOk("environment"); ok {
 			environments := v.([]interface{})
@@ -1221,48 +1409,95 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// The runtime management configuration is not part of the function configuration
+	// and is applied once any configuration and code updates have completed.
+	if d.HasChange("runtime_management_config") {
+		if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := putFunctionRuntimeManagementConfig(conn, d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
 	publish := d.Get("publish").(bool)
 	if publish && (codeUpdate || configUpdate || d.HasChange("publish")) {
-		versionReq := &lambda.PublishVersionInput{
-			FunctionName: aws.String(d.Id()),
+		if err := publishFunctionVersion(conn, d.Id()); err != nil {
+			return err
 		}
+	}
 
-		var output *lambda.FunctionConfiguration
-		err := resource.Retry(lambdaFunctionPublishTimeout, func() *resource.RetryError {
-			var err error
-			output, err = conn.PublishVersion(versionReq)
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "in progress") {
-				log.Printf("[DEBUG] Retrying publish of Lambda function (%s) version after error: %s", d.Id(), err)
-				return resource.RetryableError(err)
-			}
+	return resourceFunctionRead(d, meta)
+}
 
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
+// publishFunctionVersion publishes a new version of the function's $LATEST code and configuration
+// and waits for the published version to become ready for invocation.
+func publishFunctionVersion(conn *lambda.Lambda, functionName string) error {
+	versionReq := &lambda.PublishVersionInput{
+		FunctionName: aws.String(functionName),
+	}
 
-			return nil
-		})
+	var output *lambda.FunctionConfiguration
+	err := resource.Retry(lambdaFunctionPublishTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.PublishVersion(versionReq)
 
-		if tfresource.TimedOut(err) {
-			output, err = conn.PublishVersion(versionReq)
+		if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "in progress") {
+			log.Printf("[DEBUG] Retrying publish of Lambda function (%s) version after error: %s", functionName, err)
+			return resource.RetryableError(err)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error publishing Lambda Function (%s) version: %w", d.Id(), err)
+			return resource.NonRetryableError(err)
 		}
 
-		err = conn.WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
-			FunctionName: output.FunctionArn,
-			Qualifier:    output.Version,
-		})
+		return nil
+	})
 
-		if err != nil {
-			return fmt.Errorf("while waiting for function (%s) update: %w", d.Id(), err)
+	if tfresource.TimedOut(err) {
+		output, err = conn.PublishVersion(versionReq)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error publishing Lambda Function (%s) version: %w", functionName, err)
+	}
+
+	err = conn.WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
+		FunctionName: output.FunctionArn,
+		Qualifier:    output.Version,
+	})
+
+	if err != nil {
+		return fmt.Errorf("while waiting for function (%s) update: %w", functionName, err)
+	}
+
+	// Versions published with SnapStart enabled remain Pending while the snapshot is created.
+	if output.SnapStart != nil && aws.StringValue(output.SnapStart.ApplyOn) == lambda.SnapStartApplyOnPublishedVersions {
+		if err := waitForFunctionVersionActive(conn, aws.StringValue(output.FunctionArn), aws.StringValue(output.Version), lambdaFunctionPublishSnapStartTimeout); err != nil {
+			return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) to become active: %w", functionName, aws.StringValue(output.Version), err)
 		}
 	}
 
-	return resourceFunctionRead(d, meta)
+	return nil
+}
+
+func putFunctionRuntimeManagementConfig(conn *lambda.Lambda, functionName string, tfMap map[string]interface{}) error {
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: aws.String(tfMap["update_runtime_on"].(string)),
+	}
+
+	if v, ok := tfMap["runtime_version_arn"].(string); ok && v != "" {
+		input.RuntimeVersionArn = aws.String(v)
+	}
+
+	log.Printf("[DEBUG] Putting Lambda Function runtime management config: %s", input)
+	_, err := conn.PutRuntimeManagementConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Lambda Function (%s) runtime management config: %w", functionName, err)
+	}
+
+	return nil
 }
 
 // loadFileContent returns contents of a file in a given path
@@ -1324,11 +1559,19 @@ func refreshFunctionLastUpdateStatus(conn *lambda.Lambda, functionName string) r
 }
 
 func refreshFunctionState(conn *lambda.Lambda, functionName string) resource.StateRefreshFunc {
+	return refreshFunctionVersionState(conn, functionName, "")
+}
+
+func refreshFunctionVersionState(conn *lambda.Lambda, functionName, qualifier string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &lambda.GetFunctionInput{
 			FunctionName: aws.String(functionName),
 		}
 
+		if qualifier != "" {
+			input.Qualifier = aws.String(qualifier)
+		}
+
 		output, err := conn.GetFunction(input)
 
 		if err != nil {
@@ -1363,6 +1606,20 @@ func waitForFunctionCreation(conn *lambda.Lambda, functionName string, timeout t
 	return err
 }
 
+func waitForFunctionVersionActive(conn *lambda.Lambda, functionName, qualifier string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.StatePending},
+		Target:  []string{lambda.StateActive},
+		Refresh: refreshFunctionVersionState(conn, functionName, qualifier),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func waitForFunctionUpdate(conn *lambda.Lambda, functionName string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{lambda.LastUpdateStatusInProgress},
@@ -1444,3 +1701,74 @@ func expandImageConfigs(imageConfigMaps []interface{}) *lambda.ImageConfig {
 	}
 	return imageConfig
 }
+
+func expandEphemeralStorage(tfMap map[string]interface{}) *lambda.EphemeralStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.EphemeralStorage{}
+
+	if v, ok := tfMap["size"].(int); ok && v != 0 {
+		apiObject.Size = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenEphemeralStorage(apiObject *lambda.EphemeralStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"size": aws.Int64Value(apiObject.Size),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSnapStart(tfMap map[string]interface{}) *lambda.SnapStart {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.SnapStart{}
+
+	if v, ok := tfMap["apply_on"].(string); ok && v != "" {
+		apiObject.ApplyOn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSnapStart(apiObject *lambda.SnapStartResponse) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	// SnapStart is reported as applying to no versions when it has not been enabled.
+	if aws.StringValue(apiObject.ApplyOn) == lambda.SnapStartApplyOnNone {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"apply_on":            aws.StringValue(apiObject.ApplyOn),
+		"optimization_status": aws.StringValue(apiObject.OptimizationStatus),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"runtime_version_arn": aws.StringValue(apiObject.RuntimeVersionArn),
+		"update_runtime_on":   aws.StringValue(apiObject.UpdateRuntimeOn),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -86,6 +86,22 @@ func DataSourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_runtime_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"timeout": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -155,6 +171,18 @@ func DataSourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"ephemeral_storage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tracing_config": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -171,6 +199,22 @@ func DataSourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"snap_start": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"optimization_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags": tftags.TagsSchemaComputed(),
 			"signing_profile_version_arn": {
 				Type:     schema.TypeString,
@@ -257,6 +301,10 @@ func dataSourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting environment: %w", err)
 	}
 
+	if err := d.Set("ephemeral_storage", flattenEphemeralStorage(function.EphemeralStorage)); err != nil {
+		return fmt.Errorf("error setting ephemeral_storage: %w", err)
+	}
+
 	d.Set("handler", function.Handler)
 	d.Set("invoke_arn", functionInvokeArn(aws.StringValue(function.FunctionArn), meta))
 	d.Set("kms_key_arn", function.KMSKeyArn)
@@ -291,6 +339,38 @@ func dataSourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
+
+	// Runtime management is only supported on zip packaged lambda functions.
+	if aws.StringValue(function.PackageType) == lambda.PackageTypeZip {
+		runtimeManagementConfigInput := &lambda.GetRuntimeManagementConfigInput{
+			FunctionName: function.FunctionName,
+		}
+
+		if v, ok := d.GetOk("qualifier"); ok {
+			runtimeManagementConfigInput.Qualifier = aws.String(v.(string))
+		}
+
+		runtimeManagementConfig, err := conn.GetRuntimeManagementConfig(runtimeManagementConfigInput)
+
+		switch {
+		case runtimeManagementConfigUnsupported(err):
+			log.Printf("[WARN] Unable to read Lambda Function (%s) runtime management config: %s", functionName, err)
+			d.Set("runtime_management_config", nil)
+		case err != nil:
+			return fmt.Errorf("error getting Lambda Function (%s) runtime management config: %w", functionName, err)
+		default:
+			if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(runtimeManagementConfig)); err != nil {
+				return fmt.Errorf("error setting runtime_management_config: %w", err)
+			}
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
+
+	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
+		return fmt.Errorf("error setting snap_start: %w", err)
+	}
+
 	d.Set("source_code_hash", function.CodeSha256)
 	d.Set("source_code_size", function.CodeSize)
 
@@ -0,0 +1,400 @@
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLambdaFunctionURL_basic(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_arn"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "function_url", regexp.MustCompile(`^https://.+\.lambda-url\..+$`)),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", lambda.InvokeModeBuffered),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_disappears(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tflambda.ResourceFunctionURL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_cors(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_cors(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_headers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "date"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "keep-alive"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "date"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "keep-alive"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "86400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLConfig_corsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_headers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "x-custom-header"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "GET"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "https://www.example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "http://localhost:60905"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "date"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "72000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_corsInvalidMethod(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionURLConfig_corsInvalidMethod(rName),
+				ExpectError: regexp.MustCompile(`expected cors.0.allow_methods.\d+ to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_invokeMode(rName, lambda.InvokeModeResponseStream),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", lambda.InvokeModeResponseStream),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLConfig_invokeMode(rName, lambda.InvokeModeBuffered),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "invoke_mode", lambda.InvokeModeBuffered),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_alias(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_alias(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", "live"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionURLExists(n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Function URL ID is set")
+		}
+
+		name, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+		output, err := tflambda.FindFunctionURLByNameAndQualifier(context.Background(), conn, name, qualifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionURLDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_function_url" {
+			continue
+		}
+
+		name, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindFunctionURLByNameAndQualifier(context.Background(), conn, name, qualifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Function URL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFunctionURLBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"
+  role       = aws_iam_role.test.id
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
+
+func testAccFunctionURLConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+}
+`)
+}
+
+func testAccFunctionURLConfig_cors(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_credentials = true
+    allow_origins     = ["*"]
+    allow_methods     = ["*"]
+    allow_headers     = ["date", "keep-alive"]
+    expose_headers    = ["keep-alive", "date"]
+    max_age           = 86400
+  }
+}
+`)
+}
+
+func testAccFunctionURLConfig_corsUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+
+  cors {
+    allow_credentials = false
+    allow_origins     = ["https://www.example.com", "http://localhost:60905"]
+    allow_methods     = ["GET", "POST"]
+    allow_headers     = ["x-custom-header"]
+    expose_headers    = ["date"]
+    max_age           = 72000
+  }
+}
+`)
+}
+
+func testAccFunctionURLConfig_corsInvalidMethod(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+
+  cors {
+    allow_origins = ["*"]
+    allow_methods = ["FETCH"]
+  }
+}
+`)
+}
+
+func testAccFunctionURLConfig_invokeMode(rName, invokeMode string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), fmt.Sprintf(`
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+  invoke_mode        = %[1]q
+}
+`, invokeMode))
+}
+
+func testAccFunctionURLConfig_alias(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  qualifier          = aws_lambda_alias.test.name
+  authorization_type = "NONE"
+}
+`)
+}
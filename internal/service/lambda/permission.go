@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -14,11 +15,14 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const permissionActionInvokeFunctionURL = "lambda:InvokeFunctionUrl"
+
 var LambdaFunctionRegexp = `^(arn:[\w-]+:lambda:)?([a-z]{2}-(?:[a-z]+-){1,2}\d{1}:)?(\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\$LATEST|[a-zA-Z0-9-_]+))?$`
 
 func ResourcePermission() *schema.Resource {
@@ -49,6 +53,12 @@ func ResourcePermission() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validFunctionName(),
 			},
+			"function_url_auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lambda.FunctionUrlAuthType_Values(), false),
+			},
 			"principal": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -88,7 +98,26 @@ func ResourcePermission() *schema.Resource {
 				ValidateFunc:  validPolicyStatementID(),
 			},
 		},
+
+		CustomizeDiff: customizeDiffPermissionFunctionURLAuthType,
+	}
+}
+
+// customizeDiffPermissionFunctionURLAuthType ensures that function_url_auth_type is only used
+// with the lambda:InvokeFunctionUrl action, as the API rejects it for any other action.
+func customizeDiffPermissionFunctionURLAuthType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("action") {
+		return nil
+	}
+
+	action := diff.Get("action").(string)
+	authType := diff.Get("function_url_auth_type").(string)
+
+	if authType != "" && action != permissionActionInvokeFunctionURL {
+		return fmt.Errorf("function_url_auth_type can only be set when action is %q", permissionActionInvokeFunctionURL)
 	}
+
+	return nil
 }
 
 func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -121,6 +150,9 @@ func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("event_source_token"); ok {
 		input.EventSourceToken = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("function_url_auth_type"); ok {
+		input.FunctionUrlAuthType = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("qualifier"); ok {
 		input.Qualifier = aws.String(v.(string))
 	}
@@ -299,6 +331,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 	if stringEquals, ok := statement.Condition["StringEquals"]; ok {
 		d.Set("source_account", stringEquals["AWS:SourceAccount"])
 		d.Set("event_source_token", stringEquals["lambda:EventSourceToken"])
+		d.Set("function_url_auth_type", stringEquals["lambda:FunctionUrlAuthType"])
 	}
 
 	if arnLike, ok := statement.Condition["ArnLike"]; ok {
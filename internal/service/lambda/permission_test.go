@@ -558,6 +558,57 @@ func TestAccLambdaPermission_withIAMRole(t *testing.T) {
 	})
 }
 
+func TestAccLambdaPermission_functionURLs(t *testing.T) {
+	var statement tflambda.PolicyStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permission.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig_functionURLs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(resourceName, &statement),
+					resource.TestCheckResourceAttr(resourceName, "action", "lambda:InvokeFunctionUrl"),
+					resource.TestCheckResourceAttr(resourceName, "principal", "*"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "AllowExecutionWithIAM"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "function_url_auth_type", lambda.FunctionUrlAuthTypeAwsIam),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCPermissionImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermission_functionURLAuthTypeInvalidAction(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPermissionConfig_functionURLAuthTypeInvalidAction(rName),
+				ExpectError: regexp.MustCompile(`function_url_auth_type can only be set when action is "lambda:InvokeFunctionUrl"`),
+			},
+		},
+	})
+}
+
 func testAccPermissionDisappears(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1156,6 +1207,62 @@ EOF
 `, funcName, roleName)
 }
 
+func testAccPermissionFunctionURLBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.handler"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccPermissionConfig_functionURLs(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionFunctionURLBaseConfig(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id           = "AllowExecutionWithIAM"
+  action                 = "lambda:InvokeFunctionUrl"
+  function_name          = aws_lambda_function.test.function_name
+  principal              = "*"
+  function_url_auth_type = "AWS_IAM"
+}
+`)
+}
+
+func testAccPermissionConfig_functionURLAuthTypeInvalidAction(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionFunctionURLBaseConfig(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id           = "AllowExecutionWithIAM"
+  action                 = "lambda:InvokeFunction"
+  function_name          = aws_lambda_function.test.function_name
+  principal              = "*"
+  function_url_auth_type = "AWS_IAM"
+}
+`)
+}
+
 // lintignore:AWSAT003,AWSAT005 // unit test
 var testLambdaPolicy = []byte(`{
   "Version": "2012-10-17",
@@ -8,14 +8,15 @@ import (
 )
 
 const (
-	eventSourceMappingCreateTimeout      = 10 * time.Minute
-	eventSourceMappingUpdateTimeout      = 10 * time.Minute
-	eventSourceMappingDeleteTimeout      = 5 * time.Minute
-	lambdaFunctionCreateTimeout          = 5 * time.Minute
-	lambdaFunctionUpdateTimeout          = 5 * time.Minute
-	lambdaFunctionPublishTimeout         = 5 * time.Minute
-	lambdaFunctionPutConcurrencyTimeout  = 1 * time.Minute
-	lambdaFunctionExtraThrottlingTimeout = 9 * time.Minute
+	eventSourceMappingCreateTimeout       = 10 * time.Minute
+	eventSourceMappingUpdateTimeout       = 10 * time.Minute
+	eventSourceMappingDeleteTimeout       = 5 * time.Minute
+	lambdaFunctionCreateTimeout           = 5 * time.Minute
+	lambdaFunctionUpdateTimeout           = 5 * time.Minute
+	lambdaFunctionPublishTimeout          = 5 * time.Minute
+	lambdaFunctionPublishSnapStartTimeout = 20 * time.Minute
+	lambdaFunctionPutConcurrencyTimeout   = 1 * time.Minute
+	lambdaFunctionExtraThrottlingTimeout  = 9 * time.Minute
 
 	eventSourceMappingPropagationTimeout = 5 * time.Minute
 )
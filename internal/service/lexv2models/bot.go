@@ -0,0 +1,274 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotCreate,
+		ReadWithoutTimeout:   resourceBotRead,
+		UpdateWithoutTimeout: resourceBotUpdate,
+		DeleteWithoutTimeout: resourceBotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_privacy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_directed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"test_bot_alias_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateBotInput{
+		BotName:                 aws.String(name),
+		DataPrivacy:             expandDataPrivacy(d.Get("data_privacy").([]interface{})),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.BotTags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("test_bot_alias_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		input.TestBotAliasTags = Tags(tftags.New(v).IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot: %s", input)
+	output, err := conn.CreateBotWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Bot (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BotId))
+
+	if _, err := waitBotAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceBotRead(ctx, d, meta)
+}
+
+func resourceBotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	bot, err := FindBotByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Bot (%s): %s", d.Id(), err)
+	}
+
+	arn := botARN(meta.(*conns.AWSClient), d.Id())
+	d.Set("arn", arn)
+	if err := d.Set("data_privacy", flattenDataPrivacy(bot.DataPrivacy)); err != nil {
+		return diag.Errorf("error setting data_privacy: %s", err)
+	}
+	d.Set("description", bot.Description)
+	d.Set("idle_session_ttl_in_seconds", bot.IdleSessionTTLInSeconds)
+	d.Set("name", bot.BotName)
+	d.Set("role_arn", bot.RoleArn)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Lex V2 Bot (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &lexmodelsv2.UpdateBotInput{
+			BotId:                   aws.String(d.Id()),
+			BotName:                 aws.String(d.Get("name").(string)),
+			DataPrivacy:             expandDataPrivacy(d.Get("data_privacy").([]interface{})),
+			IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+			RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Lex V2 Bot: %s", input)
+		_, err := conn.UpdateBotWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Lex V2 Bot (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBotAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Lex V2 Bot (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Lex V2 Bot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBotRead(ctx, d, meta)
+}
+
+func resourceBotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot: %s", d.Id())
+	_, err := conn.DeleteBotWithContext(ctx, &lexmodelsv2.DeleteBotInput{
+		BotId:                  aws.String(d.Id()),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Bot (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func botARN(client *conns.AWSClient, botID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "lex",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("bot/%s", botID),
+	}.String()
+}
+
+func expandDataPrivacy(tfList []interface{}) *lexmodelsv2.DataPrivacy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.DataPrivacy{
+		ChildDirected: aws.Bool(tfMap["child_directed"].(bool)),
+	}
+}
+
+func flattenDataPrivacy(apiObject *lexmodelsv2.DataPrivacy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"child_directed": aws.BoolValue(apiObject.ChildDirected),
+	}
+
+	return []interface{}{tfMap}
+}
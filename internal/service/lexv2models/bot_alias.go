@@ -0,0 +1,438 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBotAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotAliasCreate,
+		ReadWithoutTimeout:   resourceBotAliasRead,
+		UpdateWithoutTimeout: resourceBotAliasUpdate,
+		DeleteWithoutTimeout: resourceBotAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_alias_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_alias_locale_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook_specification": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda_code_hook": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"code_hook_interface_version": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 5),
+												},
+												"lambda_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"sentiment_analysis_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"detect_sentiment": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	botID := d.Get("bot_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateBotAliasInput{
+		BotAliasName: aws.String(name),
+		BotId:        aws.String(botID),
+	}
+
+	if v, ok := d.GetOk("bot_alias_locale_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.BotAliasLocaleSettings = expandBotAliasLocaleSettings(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("bot_version"); ok {
+		input.BotVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sentiment_analysis_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SentimentAnalysisSettings = expandSentimentAnalysisSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot Alias: %s", input)
+	output, err := conn.CreateBotAliasWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Bot Alias (%s): %s", name, err)
+	}
+
+	botAliasID := aws.StringValue(output.BotAliasId)
+	d.SetId(BotAliasCreateResourceID(botAliasID, botID))
+
+	if _, err := waitBotAliasAvailable(ctx, conn, botAliasID, botID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Alias (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceBotAliasRead(ctx, d, meta)
+}
+
+func resourceBotAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	botAliasID, botID, err := BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alias, err := FindBotAliasByTwoPartKey(ctx, conn, botAliasID, botID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	arn := botAliasARN(meta.(*conns.AWSClient), botAliasID, botID)
+	d.Set("arn", arn)
+	d.Set("bot_alias_id", alias.BotAliasId)
+	if err := d.Set("bot_alias_locale_settings", flattenBotAliasLocaleSettings(alias.BotAliasLocaleSettings)); err != nil {
+		return diag.Errorf("error setting bot_alias_locale_settings: %s", err)
+	}
+	d.Set("bot_id", alias.BotId)
+	d.Set("bot_version", alias.BotVersion)
+	d.Set("description", alias.Description)
+	d.Set("name", alias.BotAliasName)
+	if err := d.Set("sentiment_analysis_settings", flattenSentimentAnalysisSettings(alias.SentimentAnalysisSettings)); err != nil {
+		return diag.Errorf("error setting sentiment_analysis_settings: %s", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Lex V2 Bot Alias (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		botAliasID, botID, err := BotAliasParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &lexmodelsv2.UpdateBotAliasInput{
+			BotAliasId:   aws.String(botAliasID),
+			BotAliasName: aws.String(d.Get("name").(string)),
+			BotId:        aws.String(botID),
+		}
+
+		if v, ok := d.GetOk("bot_alias_locale_settings"); ok && v.(*schema.Set).Len() > 0 {
+			input.BotAliasLocaleSettings = expandBotAliasLocaleSettings(v.(*schema.Set).List())
+		}
+
+		if v, ok := d.GetOk("bot_version"); ok {
+			input.BotVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("sentiment_analysis_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SentimentAnalysisSettings = expandSentimentAnalysisSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Lex V2 Bot Alias: %s", input)
+		_, err = conn.UpdateBotAliasWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Lex V2 Bot Alias (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBotAliasAvailable(ctx, conn, botAliasID, botID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Lex V2 Bot Alias (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Lex V2 Bot Alias (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBotAliasRead(ctx, d, meta)
+}
+
+func resourceBotAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botAliasID, botID, err := BotAliasParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Alias: %s", d.Id())
+	_, err = conn.DeleteBotAliasWithContext(ctx, &lexmodelsv2.DeleteBotAliasInput{
+		BotAliasId:             aws.String(botAliasID),
+		BotId:                  aws.String(botID),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Bot Alias (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotAliasDeleted(ctx, conn, botAliasID, botID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Alias (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func botAliasARN(client *conns.AWSClient, botAliasID, botID string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   "lex",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("bot-alias/%s/%s", botID, botAliasID),
+	}.String()
+}
+
+func expandBotAliasLocaleSettings(tfList []interface{}) map[string]*lexmodelsv2.BotAliasLocaleSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := map[string]*lexmodelsv2.BotAliasLocaleSettings{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		settings := &lexmodelsv2.BotAliasLocaleSettings{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["code_hook_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			settings.CodeHookSpecification = expandCodeHookSpecification(v[0].(map[string]interface{}))
+		}
+
+		apiObject[tfMap["locale_id"].(string)] = settings
+	}
+
+	return apiObject
+}
+
+func expandCodeHookSpecification(tfMap map[string]interface{}) *lexmodelsv2.CodeHookSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.CodeHookSpecification{}
+
+	if v, ok := tfMap["lambda_code_hook"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.LambdaCodeHook = &lexmodelsv2.LambdaCodeHook{
+			CodeHookInterfaceVersion: aws.String(tfMap["code_hook_interface_version"].(string)),
+			LambdaARN:                aws.String(tfMap["lambda_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSentimentAnalysisSettings(tfMap map[string]interface{}) *lexmodelsv2.SentimentAnalysisSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &lexmodelsv2.SentimentAnalysisSettings{
+		DetectSentiment: aws.Bool(tfMap["detect_sentiment"].(bool)),
+	}
+}
+
+func flattenBotAliasLocaleSettings(apiObject map[string]*lexmodelsv2.BotAliasLocaleSettings) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for localeID, settings := range apiObject {
+		if settings == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"enabled":   aws.BoolValue(settings.Enabled),
+			"locale_id": localeID,
+		}
+
+		if v := settings.CodeHookSpecification; v != nil && v.LambdaCodeHook != nil {
+			tfMap["code_hook_specification"] = []interface{}{
+				map[string]interface{}{
+					"lambda_code_hook": []interface{}{
+						map[string]interface{}{
+							"code_hook_interface_version": aws.StringValue(v.LambdaCodeHook.CodeHookInterfaceVersion),
+							"lambda_arn":                  aws.StringValue(v.LambdaCodeHook.LambdaARN),
+						},
+					},
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSentimentAnalysisSettings(apiObject *lexmodelsv2.SentimentAnalysisSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"detect_sentiment": aws.BoolValue(apiObject.DetectSentiment),
+	}
+
+	return []interface{}{tfMap}
+}
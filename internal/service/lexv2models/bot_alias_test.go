@@ -0,0 +1,297 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsBotAlias_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot-alias/.+/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bot_alias_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_alias_locale_settings.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_version", "aws_lexv2models_bot_version.test", "bot_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceBotAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_codeHook(t *testing.T) {
+	var v lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasCodeHookConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bot_alias_locale_settings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bot_alias_locale_settings.*", map[string]string{
+						"enabled":                   "true",
+						"locale_id":                 "en_US",
+						"code_hook_specification.#": "1",
+						"code_hook_specification.0.lambda_code_hook.#":                             "1",
+						"code_hook_specification.0.lambda_code_hook.0.code_hook_interface_version": "1.0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "bot_alias_locale_settings.*.code_hook_specification.0.lambda_code_hook.0.lambda_arn", "aws_lambda_function.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_tags(t *testing.T) {
+	var v lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBotAliasTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBotAliasTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBotAliasExists(n string, v *lexmodelsv2.DescribeBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Alias ID is set")
+		}
+
+		botAliasID, botID, err := tflexv2models.BotAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindBotAliasByTwoPartKey(context.Background(), conn, botAliasID, botID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBotAliasDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot_alias" {
+			continue
+		}
+
+		botAliasID, botID, err := tflexv2models.BotAliasParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflexv2models.FindBotAliasByTwoPartKey(context.Background(), conn, botAliasID, botID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot Alias %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBotAliasConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBotVersionConfig(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot_version.test.bot_id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+}
+`, rName))
+}
+
+func testAccBotAliasCodeHookConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBotVersionConfig(rName), fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "lambdatest.handler"
+  role          = aws_iam_role.lambda.arn
+  runtime       = "nodejs16.x"
+}
+
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot_version.test.bot_id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+
+  bot_alias_locale_settings {
+    locale_id = "en_US"
+
+    code_hook_specification {
+      lambda_code_hook {
+        code_hook_interface_version = "1.0"
+        lambda_arn                  = aws_lambda_function.test.arn
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccBotAliasTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccBotVersionConfig(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot_version.test.bot_id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccBotAliasTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccBotVersionConfig(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_id      = aws_lexv2models_bot_version.test.bot_id
+  bot_version = aws_lexv2models_bot_version.test.bot_version
+  name        = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,266 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const draftBotVersion = "DRAFT"
+
+func ResourceBotLocale() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotLocaleCreate,
+		ReadWithoutTimeout:   resourceBotLocaleRead,
+		UpdateWithoutTimeout: resourceBotLocaleUpdate,
+		DeleteWithoutTimeout: resourceBotLocaleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  draftBotVersion,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nlu_intent_confidence_threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      lexmodelsv2.VoiceEngineStandard,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.VoiceEngine_Values(), false),
+						},
+						"voice_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotLocaleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	id := BotLocaleCreateResourceID(botID, botVersion, localeID)
+	input := &lexmodelsv2.CreateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VoiceSettings = expandVoiceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot Locale: %s", input)
+	_, err := conn.CreateBotLocaleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Bot Locale (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitBotLocaleCreated(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Locale (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceBotLocaleRead(ctx, d, meta)
+}
+
+func resourceBotLocaleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	locale, err := FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Locale (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", locale.BotId)
+	d.Set("bot_version", locale.BotVersion)
+	d.Set("description", locale.Description)
+	d.Set("locale_id", locale.LocaleId)
+	d.Set("name", locale.LocaleName)
+	d.Set("nlu_intent_confidence_threshold", locale.NluIntentConfidenceThreshold)
+	d.Set("status", locale.BotLocaleStatus)
+	if err := d.Set("voice_settings", flattenVoiceSettings(locale.VoiceSettings)); err != nil {
+		return diag.Errorf("error setting voice_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBotLocaleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &lexmodelsv2.UpdateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VoiceSettings = expandVoiceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Bot Locale: %s", input)
+	_, err = conn.UpdateBotLocaleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Lex V2 Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotLocaleCreated(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Locale (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceBotLocaleRead(ctx, d, meta)
+}
+
+func resourceBotLocaleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Locale: %s", d.Id())
+	_, err = conn.DeleteBotLocaleWithContext(ctx, &lexmodelsv2.DeleteBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotLocaleDeleted(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Locale (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVoiceSettings(tfMap map[string]interface{}) *lexmodelsv2.VoiceSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.VoiceSettings{}
+
+	if v, ok := tfMap["engine"].(string); ok && v != "" {
+		apiObject.Engine = aws.String(v)
+	}
+
+	if v, ok := tfMap["voice_id"].(string); ok && v != "" {
+		apiObject.VoiceId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVoiceSettings(apiObject *lexmodelsv2.VoiceSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"engine":   aws.StringValue(apiObject.Engine),
+		"voice_id": aws.StringValue(apiObject.VoiceId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,281 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsBot_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "lex", regexp.MustCompile(`bot/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "data_privacy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_privacy.0.child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_bot_alias_tags"},
+			},
+			{
+				Config: testAccBotUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBot_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceBot(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBot_tags(t *testing.T) {
+	var v lexmodelsv2.DescribeBotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_bot_alias_tags"},
+			},
+			{
+				Config: testAccBotTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccBotTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBotExists(n string, v *lexmodelsv2.DescribeBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindBotByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBotDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot" {
+			continue
+		}
+
+		_, err := tflexv2models.FindBotByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	input := &lexmodelsv2.ListBotsInput{}
+
+	_, err := conn.ListBots(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccBotConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lexv2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBotConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 300
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+}
+`, rName))
+}
+
+func testAccBotUpdatedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  description                 = "updated"
+  idle_session_ttl_in_seconds = 600
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+}
+`, rName))
+}
+
+func testAccBotTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 300
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccBotTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccBotConfigBase(rName), fmt.Sprintf(`
+resource "aws_lexv2models_bot" "test" {
+  name                        = %[1]q
+  idle_session_ttl_in_seconds = 300
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = false
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,206 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBotVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotVersionCreate,
+		ReadWithoutTimeout:   resourceBotVersionRead,
+		DeleteWithoutTimeout: resourceBotVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_specification": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"locale_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"source_bot_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  draftBotVersion,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	localeSpecification := expandBotVersionLocaleSpecification(d.Get("locale_specification").(*schema.Set).List())
+
+	// Locales must be built from the draft version before a version can be created from them.
+	for localeID, v := range localeSpecification {
+		if aws.StringValue(v.SourceBotVersion) != draftBotVersion {
+			continue
+		}
+
+		log.Printf("[DEBUG] Building Lex V2 Bot Locale: %s", BotLocaleCreateResourceID(botID, draftBotVersion, localeID))
+		_, err := conn.BuildBotLocaleWithContext(ctx, &lexmodelsv2.BuildBotLocaleInput{
+			BotId:      aws.String(botID),
+			BotVersion: aws.String(draftBotVersion),
+			LocaleId:   aws.String(localeID),
+		})
+
+		if err != nil {
+			return diag.Errorf("error building Lex V2 Bot Locale (%s): %s", BotLocaleCreateResourceID(botID, draftBotVersion, localeID), err)
+		}
+
+		if _, err := waitBotLocaleBuilt(ctx, conn, botID, draftBotVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error waiting for Lex V2 Bot Locale (%s) build: %s", BotLocaleCreateResourceID(botID, draftBotVersion, localeID), err)
+		}
+	}
+
+	input := &lexmodelsv2.CreateBotVersionInput{
+		BotId:                         aws.String(botID),
+		BotVersionLocaleSpecification: localeSpecification,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Bot Version: %s", input)
+	output, err := conn.CreateBotVersionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Bot (%s) Version: %s", botID, err)
+	}
+
+	botVersion := aws.StringValue(output.BotVersion)
+	d.SetId(BotVersionCreateResourceID(botID, botVersion))
+
+	if _, err := waitBotVersionAvailable(ctx, conn, botID, botVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Version (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceBotVersionRead(ctx, d, meta)
+}
+
+func resourceBotVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	version, err := FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Bot Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Bot Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", version.BotId)
+	d.Set("bot_version", version.BotVersion)
+	d.Set("description", version.Description)
+
+	return nil
+}
+
+func resourceBotVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID, botVersion, err := BotVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Bot Version: %s", d.Id())
+	_, err = conn.DeleteBotVersionWithContext(ctx, &lexmodelsv2.DeleteBotVersionInput{
+		BotId:                  aws.String(botID),
+		BotVersion:             aws.String(botVersion),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Bot Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotVersionDeleted(ctx, conn, botID, botVersion, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Lex V2 Bot Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBotVersionLocaleSpecification(tfList []interface{}) map[string]*lexmodelsv2.BotVersionLocaleDetails {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := map[string]*lexmodelsv2.BotVersionLocaleDetails{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap["locale_id"].(string)] = &lexmodelsv2.BotVersionLocaleDetails{
+			SourceBotVersion: aws.String(tfMap["source_bot_version"].(string)),
+		}
+	}
+
+	return apiObject
+}
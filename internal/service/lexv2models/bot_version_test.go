@@ -0,0 +1,148 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsBotVersion_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeBotVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotVersionExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first version"),
+					resource.TestCheckResourceAttr(resourceName, "locale_specification.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "locale_specification.*", map[string]string{
+						"locale_id":          "en_US",
+						"source_bot_version": "DRAFT",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locale_specification"},
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotVersion_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeBotVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBotVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotVersionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotVersionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceBotVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBotVersionExists(n string, v *lexmodelsv2.DescribeBotVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Bot Version ID is set")
+		}
+
+		botID, botVersion, err := tflexv2models.BotVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindBotVersionByTwoPartKey(context.Background(), conn, botID, botVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBotVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_bot_version" {
+			continue
+		}
+
+		botID, botVersion, err := tflexv2models.BotVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflexv2models.FindBotVersionByTwoPartKey(context.Background(), conn, botID, botVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Bot Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBotVersionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccIntentConfig(rName), `
+resource "aws_lexv2models_bot_version" "test" {
+  bot_id      = aws_lexv2models_intent.test.bot_id
+  description = "first version"
+
+  locale_specification {
+    locale_id = aws_lexv2models_intent.test.locale_id
+  }
+}
+`)
+}
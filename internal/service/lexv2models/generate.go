@@ -0,0 +1,5 @@
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceARN -ServiceTagsMap -TagInIDElem=ResourceARN -UpdateTags
+
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package lexv2models
@@ -0,0 +1,92 @@
+package lexv2models
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+func BotLocaleCreateResourceID(botID, botVersion, localeID string) string {
+	return strings.Join([]string{botID, botVersion, localeID}, resourceIDSeparator)
+}
+
+func BotLocaleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT-ID%[2]sBOT-VERSION%[2]sLOCALE-ID", id, resourceIDSeparator)
+}
+
+func BotVersionCreateResourceID(botID, botVersion string) string {
+	return strings.Join([]string{botID, botVersion}, resourceIDSeparator)
+}
+
+func BotVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT-ID%[2]sBOT-VERSION", id, resourceIDSeparator)
+}
+
+func BotAliasCreateResourceID(botAliasID, botID string) string {
+	return strings.Join([]string{botAliasID, botID}, resourceIDSeparator)
+}
+
+func BotAliasParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BOT-ALIAS-ID%[2]sBOT-ID", id, resourceIDSeparator)
+}
+
+func IntentCreateResourceID(intentID, botID, botVersion, localeID string) string {
+	return strings.Join([]string{intentID, botID, botVersion, localeID}, resourceIDSeparator)
+}
+
+func IntentParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected INTENT-ID%[2]sBOT-ID%[2]sBOT-VERSION%[2]sLOCALE-ID", id, resourceIDSeparator)
+}
+
+func SlotTypeCreateResourceID(slotTypeID, botID, botVersion, localeID string) string {
+	return strings.Join([]string{slotTypeID, botID, botVersion, localeID}, resourceIDSeparator)
+}
+
+func SlotTypeParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SLOT-TYPE-ID%[2]sBOT-ID%[2]sBOT-VERSION%[2]sLOCALE-ID", id, resourceIDSeparator)
+}
+
+func SlotCreateResourceID(slotID, botID, botVersion, localeID, intentID string) string {
+	return strings.Join([]string{slotID, botID, botVersion, localeID, intentID}, resourceIDSeparator)
+}
+
+func SlotParseResourceID(id string) (string, string, string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 5 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" && parts[4] != "" {
+		return parts[0], parts[1], parts[2], parts[3], parts[4], nil
+	}
+
+	return "", "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SLOT-ID%[2]sBOT-ID%[2]sBOT-VERSION%[2]sLOCALE-ID%[2]sINTENT-ID", id, resourceIDSeparator)
+}
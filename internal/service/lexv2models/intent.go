@@ -0,0 +1,513 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceIntent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntentCreate,
+		ReadWithoutTimeout:   resourceIntentRead,
+		UpdateWithoutTimeout: resourceIntentUpdate,
+		DeleteWithoutTimeout: resourceIntentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  draftBotVersion,
+			},
+			"closing_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"closing_response": func() *schema.Schema {
+							v := responseSpecificationSchema()
+							v.Required = true
+							v.Optional = false
+							return v
+						}(),
+					},
+				},
+			},
+			"confirmation_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"declination_response": responseSpecificationSchema(),
+						"prompt_specification": promptSpecificationSchema(),
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"fulfillment_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sample_utterances": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot_priority": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"slot_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIntentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentName: aws.String(name),
+		LocaleId:   aws.String(localeID),
+	}
+
+	if v, ok := d.GetOk("closing_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentClosingSetting = expandIntentClosingSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("confirmation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentConfirmationSetting = expandIntentConfirmationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DialogCodeHook = expandDialogCodeHookSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FulfillmentCodeHook = expandFulfillmentCodeHookSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterances"); ok && len(v.([]interface{})) > 0 {
+		input.SampleUtterances = expandSampleUtterances(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Intent: %s", input)
+	output, err := conn.CreateIntentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Intent (%s): %s", name, err)
+	}
+
+	d.SetId(IntentCreateResourceID(aws.StringValue(output.IntentId), botID, botVersion, localeID))
+
+	return resourceIntentRead(ctx, d, meta)
+}
+
+func resourceIntentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	intent, err := FindIntentByFourPartKey(ctx, conn, intentID, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", intent.BotId)
+	d.Set("bot_version", intent.BotVersion)
+	if err := d.Set("closing_setting", flattenIntentClosingSetting(intent.IntentClosingSetting)); err != nil {
+		return diag.Errorf("error setting closing_setting: %s", err)
+	}
+	if err := d.Set("confirmation_setting", flattenIntentConfirmationSetting(intent.IntentConfirmationSetting)); err != nil {
+		return diag.Errorf("error setting confirmation_setting: %s", err)
+	}
+	d.Set("description", intent.Description)
+	if err := d.Set("dialog_code_hook", flattenDialogCodeHookSettings(intent.DialogCodeHook)); err != nil {
+		return diag.Errorf("error setting dialog_code_hook: %s", err)
+	}
+	if err := d.Set("fulfillment_code_hook", flattenFulfillmentCodeHookSettings(intent.FulfillmentCodeHook)); err != nil {
+		return diag.Errorf("error setting fulfillment_code_hook: %s", err)
+	}
+	d.Set("intent_id", intent.IntentId)
+	d.Set("locale_id", intent.LocaleId)
+	d.Set("name", intent.IntentName)
+	d.Set("parent_intent_signature", intent.ParentIntentSignature)
+	if err := d.Set("sample_utterances", flattenSampleUtterances(intent.SampleUtterances)); err != nil {
+		return diag.Errorf("error setting sample_utterances: %s", err)
+	}
+	if err := d.Set("slot_priority", flattenSlotPriorities(intent.SlotPriorities)); err != nil {
+		return diag.Errorf("error setting slot_priority: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIntentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// UpdateIntent replaces the whole intent definition, so send the full configuration.
+	input := &lexmodelsv2.UpdateIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		IntentName: aws.String(d.Get("name").(string)),
+		LocaleId:   aws.String(localeID),
+	}
+
+	if v, ok := d.GetOk("closing_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentClosingSetting = expandIntentClosingSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("confirmation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IntentConfirmationSetting = expandIntentConfirmationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dialog_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DialogCodeHook = expandDialogCodeHookSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("fulfillment_code_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FulfillmentCodeHook = expandFulfillmentCodeHookSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterances"); ok && len(v.([]interface{})) > 0 {
+		input.SampleUtterances = expandSampleUtterances(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("slot_priority"); ok && len(v.([]interface{})) > 0 {
+		input.SlotPriorities = expandSlotPriorities(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Intent: %s", input)
+	_, err = conn.UpdateIntentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	return resourceIntentRead(ctx, d, meta)
+}
+
+func resourceIntentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	intentID, botID, botVersion, localeID, err := IntentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Intent: %s", d.Id())
+	_, err = conn.DeleteIntentWithContext(ctx, &lexmodelsv2.DeleteIntentInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Intent (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIntentClosingSetting(tfMap map[string]interface{}) *lexmodelsv2.IntentClosingSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.IntentClosingSetting{}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["closing_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ClosingResponse = expandResponseSpecification(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandIntentConfirmationSetting(tfMap map[string]interface{}) *lexmodelsv2.IntentConfirmationSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.IntentConfirmationSetting{}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["declination_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeclinationResponse = expandResponseSpecification(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["prompt_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PromptSpecification = expandPromptSpecification(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDialogCodeHookSettings(tfMap map[string]interface{}) *lexmodelsv2.DialogCodeHookSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &lexmodelsv2.DialogCodeHookSettings{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+}
+
+func expandFulfillmentCodeHookSettings(tfMap map[string]interface{}) *lexmodelsv2.FulfillmentCodeHookSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.FulfillmentCodeHookSettings{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandSlotPriorities(tfList []interface{}) []*lexmodelsv2.SlotPriority {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*lexmodelsv2.SlotPriority
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SlotPriority{
+			Priority: aws.Int64(int64(tfMap["priority"].(int))),
+			SlotId:   aws.String(tfMap["slot_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenIntentClosingSetting(apiObject *lexmodelsv2.IntentClosingSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active":           aws.BoolValue(apiObject.Active),
+		"closing_response": flattenResponseSpecification(apiObject.ClosingResponse),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIntentConfirmationSetting(apiObject *lexmodelsv2.IntentConfirmationSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active":               aws.BoolValue(apiObject.Active),
+		"declination_response": flattenResponseSpecification(apiObject.DeclinationResponse),
+		"prompt_specification": flattenPromptSpecification(apiObject.PromptSpecification),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDialogCodeHookSettings(apiObject *lexmodelsv2.DialogCodeHookSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFulfillmentCodeHookSettings(apiObject *lexmodelsv2.FulfillmentCodeHookSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active":  aws.BoolValue(apiObject.Active),
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSlotPriorities(apiObjects []*lexmodelsv2.SlotPriority) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"priority": aws.Int64Value(apiObject.Priority),
+			"slot_id":  aws.StringValue(apiObject.SlotId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,234 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsIntent_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "intent_id"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.0", "I want to order flowers"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.1", "Order some flowers"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsIntent_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceIntent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsIntent_settings(t *testing.T) {
+	var v lexmodelsv2.DescribeIntentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntentSettingsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.prompt_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.prompt_specification.0.max_retries", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.prompt_specification.0.message_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value", "Shall I place the order?"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.declination_response.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_setting.0.declination_response.0.message_group.0.message.0.plain_text_message.0.value", "Okay, the order is cancelled."),
+					resource.TestCheckResourceAttr(resourceName, "dialog_code_hook.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dialog_code_hook.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_code_hook.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_code_hook.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIntentExists(n string, v *lexmodelsv2.DescribeIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Intent ID is set")
+		}
+
+		intentID, botID, botVersion, localeID, err := tflexv2models.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindIntentByFourPartKey(context.Background(), conn, intentID, botID, botVersion, localeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIntentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_intent" {
+			continue
+		}
+
+		intentID, botID, botVersion, localeID, err := tflexv2models.IntentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflexv2models.FindIntentByFourPartKey(context.Background(), conn, intentID, botID, botVersion, localeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Intent %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccIntentConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig(rName, 0.7), fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot_locale.test.bot_id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = %[1]q
+
+  sample_utterances = [
+    "I want to order flowers",
+    "Order some flowers",
+  ]
+}
+`, rName))
+}
+
+func testAccIntentSettingsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig(rName, 0.7), fmt.Sprintf(`
+resource "aws_lexv2models_intent" "test" {
+  bot_id    = aws_lexv2models_bot_locale.test.bot_id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = %[1]q
+
+  sample_utterances = [
+    "I want to order flowers",
+  ]
+
+  confirmation_setting {
+    prompt_specification {
+      max_retries = 1
+
+      message_group {
+        message {
+          plain_text_message {
+            value = "Shall I place the order?"
+          }
+        }
+      }
+    }
+
+    declination_response {
+      message_group {
+        message {
+          plain_text_message {
+            value = "Okay, the order is cancelled."
+          }
+        }
+      }
+    }
+  }
+
+  dialog_code_hook {
+    enabled = false
+  }
+
+  fulfillment_code_hook {
+    enabled = true
+  }
+}
+`, rName))
+}
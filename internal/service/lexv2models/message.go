@@ -0,0 +1,324 @@
+package lexv2models
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Prompts and responses share the same message group structure across intents and slots.
+
+func promptSpecificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allow_interrupt": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 5),
+				},
+				"message_group": messageGroupSchema(),
+				"message_selection_strategy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(lexmodelsv2.MessageSelectionStrategy_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func responseSpecificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allow_interrupt": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+				"message_group": messageGroupSchema(),
+			},
+		},
+	}
+}
+
+func messageGroupSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem:     messageResource(),
+				},
+				"variation": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 2,
+					Elem:     messageResource(),
+				},
+			},
+		},
+	}
+}
+
+func messageResource() *schema.Resource {
+	valueSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"custom_payload":     valueSchema(),
+			"plain_text_message": valueSchema(),
+			"ssml_message":       valueSchema(),
+		},
+	}
+}
+
+func expandPromptSpecification(tfMap map[string]interface{}) *lexmodelsv2.PromptSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.PromptSpecification{
+		MaxRetries: aws.Int64(int64(tfMap["max_retries"].(int))),
+	}
+
+	if v, ok := tfMap["allow_interrupt"].(bool); ok {
+		apiObject.AllowInterrupt = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["message_group"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MessageGroups = expandMessageGroups(v)
+	}
+
+	if v, ok := tfMap["message_selection_strategy"].(string); ok && v != "" {
+		apiObject.MessageSelectionStrategy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandResponseSpecification(tfMap map[string]interface{}) *lexmodelsv2.ResponseSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.ResponseSpecification{}
+
+	if v, ok := tfMap["allow_interrupt"].(bool); ok {
+		apiObject.AllowInterrupt = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["message_group"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MessageGroups = expandMessageGroups(v)
+	}
+
+	return apiObject
+}
+
+func expandMessageGroups(tfList []interface{}) []*lexmodelsv2.MessageGroup {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*lexmodelsv2.MessageGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.MessageGroup{}
+
+		if v, ok := tfMap["message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Message = expandMessage(v[0].(map[string]interface{}))
+		}
+
+		for _, v := range tfMap["variation"].([]interface{}) {
+			if v, ok := v.(map[string]interface{}); ok {
+				apiObject.Variations = append(apiObject.Variations, expandMessage(v))
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMessage(tfMap map[string]interface{}) *lexmodelsv2.Message {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.Message{}
+
+	if v, ok := tfMap["custom_payload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomPayload = &lexmodelsv2.CustomPayload{
+			Value: aws.String(v[0].(map[string]interface{})["value"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["plain_text_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PlainTextMessage = &lexmodelsv2.PlainTextMessage{
+			Value: aws.String(v[0].(map[string]interface{})["value"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["ssml_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SsmlMessage = &lexmodelsv2.SSMLMessage{
+			Value: aws.String(v[0].(map[string]interface{})["value"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPromptSpecification(apiObject *lexmodelsv2.PromptSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_interrupt":            aws.BoolValue(apiObject.AllowInterrupt),
+		"max_retries":                aws.Int64Value(apiObject.MaxRetries),
+		"message_group":              flattenMessageGroups(apiObject.MessageGroups),
+		"message_selection_strategy": aws.StringValue(apiObject.MessageSelectionStrategy),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenResponseSpecification(apiObject *lexmodelsv2.ResponseSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_interrupt": aws.BoolValue(apiObject.AllowInterrupt),
+		"message_group":   flattenMessageGroups(apiObject.MessageGroups),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMessageGroups(apiObjects []*lexmodelsv2.MessageGroup) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var variations []interface{}
+
+		for _, v := range apiObject.Variations {
+			if v != nil {
+				variations = append(variations, flattenMessage(v))
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"message":   []interface{}{flattenMessage(apiObject.Message)},
+			"variation": variations,
+		})
+	}
+
+	return tfList
+}
+
+func flattenMessage(apiObject *lexmodelsv2.Message) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomPayload; v != nil {
+		tfMap["custom_payload"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v.Value)}}
+	}
+
+	if v := apiObject.PlainTextMessage; v != nil {
+		tfMap["plain_text_message"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v.Value)}}
+	}
+
+	if v := apiObject.SsmlMessage; v != nil {
+		tfMap["ssml_message"] = []interface{}{map[string]interface{}{"value": aws.StringValue(v.Value)}}
+	}
+
+	return tfMap
+}
+
+func expandSampleUtterances(tfList []interface{}) []*lexmodelsv2.SampleUtterance {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*lexmodelsv2.SampleUtterance
+
+	for _, v := range tfList {
+		if v, ok := v.(string); ok && v != "" {
+			apiObjects = append(apiObjects, &lexmodelsv2.SampleUtterance{
+				Utterance: aws.String(v),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenSampleUtterances(apiObjects []*lexmodelsv2.SampleUtterance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject != nil {
+			tfList = append(tfList, aws.StringValue(apiObject.Utterance))
+		}
+	}
+
+	return tfList
+}
@@ -0,0 +1,400 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSlot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlotCreate,
+		ReadWithoutTimeout:   resourceSlotRead,
+		UpdateWithoutTimeout: resourceSlotUpdate,
+		DeleteWithoutTimeout: resourceSlotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  draftBotVersion,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"intent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"multiple_values_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_multiple_values": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"obfuscation_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"obfuscation_setting_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.ObfuscationSettingType_Values(), false),
+						},
+					},
+				},
+			},
+			"slot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value_elicitation_setting": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 202),
+							},
+						},
+						"prompt_specification": func() *schema.Schema {
+							v := promptSpecificationSchema()
+							v.Required = false
+							v.Optional = true
+							return v
+						}(),
+						"sample_utterances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+						"slot_constraint": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotConstraint_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSlotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	intentID := d.Get("intent_id").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotName:   aws.String(name),
+		SlotTypeId: aws.String(d.Get("slot_type_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multiple_values_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MultipleValuesSetting = expandMultipleValuesSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("obfuscation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ObfuscationSetting = expandObfuscationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_elicitation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueElicitationSetting = expandSlotValueElicitationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Slot: %s", input)
+	output, err := conn.CreateSlotWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Slot (%s): %s", name, err)
+	}
+
+	d.SetId(SlotCreateResourceID(aws.StringValue(output.SlotId), botID, botVersion, localeID, intentID))
+
+	return resourceSlotRead(ctx, d, meta)
+}
+
+func resourceSlotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slot, err := FindSlotByFivePartKey(ctx, conn, slotID, botID, botVersion, localeID, intentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Slot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", slot.BotId)
+	d.Set("bot_version", slot.BotVersion)
+	d.Set("description", slot.Description)
+	d.Set("intent_id", slot.IntentId)
+	d.Set("locale_id", slot.LocaleId)
+	if err := d.Set("multiple_values_setting", flattenMultipleValuesSetting(slot.MultipleValuesSetting)); err != nil {
+		return diag.Errorf("error setting multiple_values_setting: %s", err)
+	}
+	d.Set("name", slot.SlotName)
+	if err := d.Set("obfuscation_setting", flattenObfuscationSetting(slot.ObfuscationSetting)); err != nil {
+		return diag.Errorf("error setting obfuscation_setting: %s", err)
+	}
+	d.Set("slot_id", slot.SlotId)
+	d.Set("slot_type_id", slot.SlotTypeId)
+	if err := d.Set("value_elicitation_setting", flattenSlotValueElicitationSetting(slot.ValueElicitationSetting)); err != nil {
+		return diag.Errorf("error setting value_elicitation_setting: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSlotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &lexmodelsv2.UpdateSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotId:     aws.String(slotID),
+		SlotName:   aws.String(d.Get("name").(string)),
+		SlotTypeId: aws.String(d.Get("slot_type_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multiple_values_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MultipleValuesSetting = expandMultipleValuesSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("obfuscation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ObfuscationSetting = expandObfuscationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_elicitation_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueElicitationSetting = expandSlotValueElicitationSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Slot: %s", input)
+	_, err = conn.UpdateSlotWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	return resourceSlotRead(ctx, d, meta)
+}
+
+func resourceSlotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotID, botID, botVersion, localeID, intentID, err := SlotParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Slot: %s", d.Id())
+	_, err = conn.DeleteSlotWithContext(ctx, &lexmodelsv2.DeleteSlotInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		IntentId:   aws.String(intentID),
+		LocaleId:   aws.String(localeID),
+		SlotId:     aws.String(slotID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Slot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMultipleValuesSetting(tfMap map[string]interface{}) *lexmodelsv2.MultipleValuesSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &lexmodelsv2.MultipleValuesSetting{
+		AllowMultipleValues: aws.Bool(tfMap["allow_multiple_values"].(bool)),
+	}
+}
+
+func expandObfuscationSetting(tfMap map[string]interface{}) *lexmodelsv2.ObfuscationSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &lexmodelsv2.ObfuscationSetting{
+		ObfuscationSettingType: aws.String(tfMap["obfuscation_setting_type"].(string)),
+	}
+}
+
+func expandSlotValueElicitationSetting(tfMap map[string]interface{}) *lexmodelsv2.SlotValueElicitationSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.SlotValueElicitationSetting{
+		SlotConstraint: aws.String(tfMap["slot_constraint"].(string)),
+	}
+
+	if v, ok := tfMap["default_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DefaultValueSpecification = &lexmodelsv2.SlotDefaultValueSpecification{}
+
+		for _, v := range v {
+			if v, ok := v.(string); ok && v != "" {
+				apiObject.DefaultValueSpecification.DefaultValueList = append(apiObject.DefaultValueSpecification.DefaultValueList, &lexmodelsv2.SlotDefaultValue{
+					DefaultValue: aws.String(v),
+				})
+			}
+		}
+	}
+
+	if v, ok := tfMap["prompt_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PromptSpecification = expandPromptSpecification(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sample_utterances"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SampleUtterances = expandSampleUtterances(v)
+	}
+
+	return apiObject
+}
+
+func flattenMultipleValuesSetting(apiObject *lexmodelsv2.MultipleValuesSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_multiple_values": aws.BoolValue(apiObject.AllowMultipleValues),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenObfuscationSetting(apiObject *lexmodelsv2.ObfuscationSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"obfuscation_setting_type": aws.StringValue(apiObject.ObfuscationSettingType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSlotValueElicitationSetting(apiObject *lexmodelsv2.SlotValueElicitationSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"prompt_specification": flattenPromptSpecification(apiObject.PromptSpecification),
+		"sample_utterances":    flattenSampleUtterances(apiObject.SampleUtterances),
+		"slot_constraint":      aws.StringValue(apiObject.SlotConstraint),
+	}
+
+	if v := apiObject.DefaultValueSpecification; v != nil {
+		var defaultValues []interface{}
+
+		for _, v := range v.DefaultValueList {
+			if v != nil {
+				defaultValues = append(defaultValues, aws.StringValue(v.DefaultValue))
+			}
+		}
+
+		tfMap["default_values"] = defaultValues
+	}
+
+	return []interface{}{tfMap}
+}
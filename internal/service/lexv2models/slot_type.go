@@ -0,0 +1,343 @@
+package lexv2models
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSlotType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlotTypeCreate,
+		ReadWithoutTimeout:   resourceSlotTypeRead,
+		UpdateWithoutTimeout: resourceSlotTypeUpdate,
+		DeleteWithoutTimeout: resourceSlotTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  draftBotVersion,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"parent_slot_type_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_value": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sample_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+						"synonyms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 140),
+							},
+						},
+					},
+				},
+			},
+			"value_selection_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resolution_strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotValueResolutionStrategy_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSlotTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(botVersion),
+		LocaleId:     aws.String(localeID),
+		SlotTypeName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_value"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandSlotTypeValues(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_selection_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueSelectionSetting = expandSlotValueSelectionSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lex V2 Slot Type: %s", input)
+	output, err := conn.CreateSlotTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Lex V2 Slot Type (%s): %s", name, err)
+	}
+
+	d.SetId(SlotTypeCreateResourceID(aws.StringValue(output.SlotTypeId), botID, botVersion, localeID))
+
+	return resourceSlotTypeRead(ctx, d, meta)
+}
+
+func resourceSlotTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotTypeID, botID, botVersion, localeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slotType, err := FindSlotTypeByFourPartKey(ctx, conn, slotTypeID, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Slot Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Lex V2 Slot Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", slotType.BotId)
+	d.Set("bot_version", slotType.BotVersion)
+	d.Set("description", slotType.Description)
+	d.Set("locale_id", slotType.LocaleId)
+	d.Set("name", slotType.SlotTypeName)
+	d.Set("parent_slot_type_signature", slotType.ParentSlotTypeSignature)
+	d.Set("slot_type_id", slotType.SlotTypeId)
+	if err := d.Set("slot_type_value", flattenSlotTypeValues(slotType.SlotTypeValues)); err != nil {
+		return diag.Errorf("error setting slot_type_value: %s", err)
+	}
+	if err := d.Set("value_selection_setting", flattenSlotValueSelectionSetting(slotType.ValueSelectionSetting)); err != nil {
+		return diag.Errorf("error setting value_selection_setting: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSlotTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotTypeID, botID, botVersion, localeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &lexmodelsv2.UpdateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(botVersion),
+		LocaleId:     aws.String(localeID),
+		SlotTypeId:   aws.String(slotTypeID),
+		SlotTypeName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_value"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandSlotTypeValues(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_selection_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueSelectionSetting = expandSlotValueSelectionSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Lex V2 Slot Type: %s", input)
+	_, err = conn.UpdateSlotTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Lex V2 Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return resourceSlotTypeRead(ctx, d, meta)
+}
+
+func resourceSlotTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn
+
+	slotTypeID, botID, botVersion, localeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Slot Type: %s", d.Id())
+	_, err = conn.DeleteSlotTypeWithContext(ctx, &lexmodelsv2.DeleteSlotTypeInput{
+		BotId:                  aws.String(botID),
+		BotVersion:             aws.String(botVersion),
+		LocaleId:               aws.String(localeID),
+		SkipResourceInUseCheck: aws.Bool(true),
+		SlotTypeId:             aws.String(slotTypeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Lex V2 Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSlotTypeValues(tfList []interface{}) []*lexmodelsv2.SlotTypeValue {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*lexmodelsv2.SlotTypeValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.SlotTypeValue{
+			SampleValue: &lexmodelsv2.SampleValue{
+				Value: aws.String(tfMap["sample_value"].(string)),
+			},
+		}
+
+		for _, v := range tfMap["synonyms"].([]interface{}) {
+			if v, ok := v.(string); ok && v != "" {
+				apiObject.Synonyms = append(apiObject.Synonyms, &lexmodelsv2.SampleValue{
+					Value: aws.String(v),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSlotValueSelectionSetting(tfMap map[string]interface{}) *lexmodelsv2.SlotValueSelectionSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &lexmodelsv2.SlotValueSelectionSetting{
+		ResolutionStrategy: aws.String(tfMap["resolution_strategy"].(string)),
+	}
+}
+
+func flattenSlotTypeValues(apiObjects []*lexmodelsv2.SlotTypeValue) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.SampleValue; v != nil {
+			tfMap["sample_value"] = aws.StringValue(v.Value)
+		}
+
+		var synonyms []interface{}
+
+		for _, v := range apiObject.Synonyms {
+			if v != nil {
+				synonyms = append(synonyms, aws.StringValue(v.Value))
+			}
+		}
+
+		tfMap["synonyms"] = synonyms
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSlotValueSelectionSetting(apiObject *lexmodelsv2.SlotValueSelectionSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"resolution_strategy": aws.StringValue(apiObject.ResolutionStrategy),
+	}
+
+	return []interface{}{tfMap}
+}
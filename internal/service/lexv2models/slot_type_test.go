@@ -0,0 +1,168 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsSlotType_basic(t *testing.T) {
+	var v lexmodelsv2.DescribeSlotTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotTypeConfig(rName, "OriginalValue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "slot_type_id"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_value.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_value.0.sample_value", "roses"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_value.0.synonyms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_value.0.synonyms.0", "rose"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_value.1.sample_value", "tulips"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_setting.0.resolution_strategy", "OriginalValue"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlotTypeConfig(rName, "TopResolution"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotTypeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_setting.0.resolution_strategy", "TopResolution"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsSlotType_disappears(t *testing.T) {
+	var v lexmodelsv2.DescribeSlotTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotTypeConfig(rName, "OriginalValue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotTypeExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tflexv2models.ResourceSlotType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSlotTypeExists(n string, v *lexmodelsv2.DescribeSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Slot Type ID is set")
+		}
+
+		slotTypeID, botID, botVersion, localeID, err := tflexv2models.SlotTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+		output, err := tflexv2models.FindSlotTypeByFourPartKey(context.Background(), conn, slotTypeID, botID, botVersion, localeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSlotTypeDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lexv2models_slot_type" {
+			continue
+		}
+
+		slotTypeID, botID, botVersion, localeID, err := tflexv2models.SlotTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflexv2models.FindSlotTypeByFourPartKey(context.Background(), conn, slotTypeID, botID, botVersion, localeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex V2 Slot Type %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSlotTypeConfig(rName, resolutionStrategy string) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig(rName, 0.7), fmt.Sprintf(`
+resource "aws_lexv2models_slot_type" "test" {
+  bot_id    = aws_lexv2models_bot_locale.test.bot_id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = %[1]q
+
+  slot_type_value {
+    sample_value = "roses"
+    synonyms     = ["rose"]
+  }
+
+  slot_type_value {
+    sample_value = "tulips"
+  }
+
+  value_selection_setting {
+    resolution_strategy = %[2]q
+  }
+}
+`, rName, resolutionStrategy))
+}
@@ -0,0 +1,74 @@
+package lexv2models
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusBot(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotStatus), nil
+	}
+}
+
+func statusBotLocale(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotLocaleStatus), nil
+	}
+}
+
+func statusBotVersion(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotVersionByTwoPartKey(ctx, conn, botID, botVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotStatus), nil
+	}
+}
+
+func statusBotAlias(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotAliasByTwoPartKey(ctx, conn, botAliasID, botID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotAliasStatus), nil
+	}
+}
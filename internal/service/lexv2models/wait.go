@@ -0,0 +1,190 @@
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitBotAvailable(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusUpdating, lexmodelsv2.BotStatusVersioning},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: statusBot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotLocaleCreated(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusCreating, lexmodelsv2.BotLocaleStatusBuilding},
+		Target:  []string{lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitBotLocaleBuilt waits for a BuildBotLocale request to complete.
+// Locales are left in ReadyExpressTesting while the full build continues in the background.
+func waitBotLocaleBuilt(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusBuilding, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Target:  []string{lexmodelsv2.BotLocaleStatusBuilt},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotVersionAvailable(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusVersioning},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: statusBotVersion(ctx, conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotVersionDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion string, timeout time.Duration) (*lexmodelsv2.DescribeBotVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotVersion(ctx, conn, botID, botVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		tfresource.SetLastError(err, failureReasonsError(output.FailureReasons))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotAliasAvailable(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotAliasStatusCreating},
+		Target:  []string{lexmodelsv2.BotAliasStatusAvailable},
+		Refresh: statusBotAlias(ctx, conn, botAliasID, botID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotAliasDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botAliasID, botID string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotAliasStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotAlias(ctx, conn, botAliasID, botID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func failureReasonsError(apiObjects []*string) error {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(aws.StringValueSlice(apiObjects), "; "))
+}
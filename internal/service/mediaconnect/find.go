@@ -0,0 +1,36 @@
+package mediaconnect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFlowByARN(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
@@ -0,0 +1,1280 @@
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"entitlement_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_allow_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"output_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"source_failover_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failover_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.FailoverMode_Values(), false),
+						},
+						"primary_source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"recovery_window": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.State_Values(), false),
+						},
+					},
+				},
+			},
+			"start_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.NetworkInterfaceType_Values(), false),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddOutputRequests(v.([]interface{}))
+	}
+
+	if sources := expandSetSourceRequests(d.Get("source").([]interface{})); len(sources) == 1 {
+		input.Source = sources[0]
+	} else {
+		input.Sources = sources
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		input.VpcInterfaces = expandVPCInterfaceRequests(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConnect Flow: %s", input)
+	output, err := conn.CreateFlowWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := waitFlowCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for MediaConnect Flow (%s) create: %s", d.Id(), err)
+	}
+
+	if len(tags) > 0 {
+		if err := UpdateTags(conn, d.Id(), nil, tags); err != nil {
+			return diag.Errorf("error adding MediaConnect Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("start_flow").(bool) {
+		if err := startFlow(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", flattenEntitlements(flow.Entitlements)); err != nil {
+		return diag.Errorf("error setting entitlement: %s", err)
+	}
+	d.Set("name", flow.Name)
+	if err := d.Set("output", flattenOutputs(flow.Outputs)); err != nil {
+		return diag.Errorf("error setting output: %s", err)
+	}
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []*mediaconnect.Source{flow.Source}
+	}
+	if err := d.Set("source", flattenSources(sources)); err != nil {
+		return diag.Errorf("error setting source: %s", err)
+	}
+	if err := d.Set("source_failover_config", flattenFailoverConfig(flow.SourceFailoverConfig)); err != nil {
+		return diag.Errorf("error setting source_failover_config: %s", err)
+	}
+	d.Set("status", flow.Status)
+	if err := d.Set("vpc_interface", flattenVPCInterfaces(flow.VpcInterfaces)); err != nil {
+		return diag.Errorf("error setting vpc_interface: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+
+	// VPC interfaces that have been added must exist before any source or output references them,
+	// and removed interfaces can only be deleted once nothing references them any more.
+	var addVPCInterfaces, removeVPCInterfaces []interface{}
+	if d.HasChange("vpc_interface") {
+		o, n := d.GetChange("vpc_interface")
+		var update [][2]map[string]interface{}
+		addVPCInterfaces, removeVPCInterfaces, update = diffFlowBlocksByName(o.([]interface{}), n.([]interface{}))
+
+		// VPC interfaces can't be modified in place.
+		for _, v := range update {
+			if err := removeFlowVPCInterface(ctx, conn, d.Id(), v[0]["name"].(string)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			addVPCInterfaces = append(addVPCInterfaces, v[1])
+		}
+	}
+
+	if len(addVPCInterfaces) > 0 {
+		input := &mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn:       aws.String(d.Id()),
+			VpcInterfaces: expandVPCInterfaceRequests(addVPCInterfaces),
+		}
+
+		log.Printf("[DEBUG] Adding MediaConnect Flow VPC interfaces: %s", input)
+		if _, err := conn.AddFlowVpcInterfacesWithContext(ctx, input); err != nil {
+			return diag.Errorf("error adding MediaConnect Flow (%s) VPC interfaces: %s", d.Id(), err)
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	var removeSources []interface{}
+	if d.HasChange("source") {
+		o, n := d.GetChange("source")
+		add, remove, update := diffFlowBlocksByName(o.([]interface{}), n.([]interface{}))
+		removeSources = remove
+
+		if len(add) > 0 {
+			input := &mediaconnect.AddFlowSourcesInput{
+				FlowArn: aws.String(d.Id()),
+				Sources: expandSetSourceRequests(add),
+			}
+
+			log.Printf("[DEBUG] Adding MediaConnect Flow sources: %s", input)
+			if _, err := conn.AddFlowSourcesWithContext(ctx, input); err != nil {
+				return diag.Errorf("error adding MediaConnect Flow (%s) sources: %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range update {
+			input := expandUpdateFlowSourceInput(v[1])
+			input.FlowArn = aws.String(d.Id())
+			input.SourceArn = aws.String(v[0]["source_arn"].(string))
+
+			log.Printf("[DEBUG] Updating MediaConnect Flow source: %s", input)
+			if _, err := conn.UpdateFlowSourceWithContext(ctx, input); err != nil {
+				return diag.Errorf("error updating MediaConnect Flow (%s) source (%s): %s", d.Id(), v[1]["name"], err)
+			}
+		}
+	}
+
+	if d.HasChange("source_failover_config") {
+		input := &mediaconnect.UpdateFlowInput{
+			FlowArn: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			apiObject := expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+			input.SourceFailoverConfig = &mediaconnect.UpdateFailoverConfig{
+				FailoverMode:   apiObject.FailoverMode,
+				RecoveryWindow: apiObject.RecoveryWindow,
+				SourcePriority: apiObject.SourcePriority,
+				State:          apiObject.State,
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow: %s", input)
+		if _, err := conn.UpdateFlowWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating MediaConnect Flow (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFlowUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	for _, tfMapRaw := range removeSources {
+		input := &mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(d.Id()),
+			SourceArn: aws.String(tfMapRaw.(map[string]interface{})["source_arn"].(string)),
+		}
+
+		log.Printf("[DEBUG] Removing MediaConnect Flow source: %s", input)
+		_, err := conn.RemoveFlowSourceWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return diag.Errorf("error removing MediaConnect Flow (%s) source (%s): %s", d.Id(), tfMapRaw.(map[string]interface{})["name"], err)
+		}
+	}
+
+	if d.HasChange("output") {
+		o, n := d.GetChange("output")
+		add, remove, update := diffFlowBlocksByName(o.([]interface{}), n.([]interface{}))
+
+		for _, tfMapRaw := range remove {
+			input := &mediaconnect.RemoveFlowOutputInput{
+				FlowArn:   aws.String(d.Id()),
+				OutputArn: aws.String(tfMapRaw.(map[string]interface{})["output_arn"].(string)),
+			}
+
+			log.Printf("[DEBUG] Removing MediaConnect Flow output: %s", input)
+			_, err := conn.RemoveFlowOutputWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return diag.Errorf("error removing MediaConnect Flow (%s) output (%s): %s", d.Id(), tfMapRaw.(map[string]interface{})["name"], err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &mediaconnect.AddFlowOutputsInput{
+				FlowArn: aws.String(d.Id()),
+				Outputs: expandAddOutputRequests(add),
+			}
+
+			log.Printf("[DEBUG] Adding MediaConnect Flow outputs: %s", input)
+			if _, err := conn.AddFlowOutputsWithContext(ctx, input); err != nil {
+				return diag.Errorf("error adding MediaConnect Flow (%s) outputs: %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range update {
+			input := expandUpdateFlowOutputInput(v[1])
+			input.FlowArn = aws.String(d.Id())
+			input.OutputArn = aws.String(v[0]["output_arn"].(string))
+
+			log.Printf("[DEBUG] Updating MediaConnect Flow output: %s", input)
+			if _, err := conn.UpdateFlowOutputWithContext(ctx, input); err != nil {
+				return diag.Errorf("error updating MediaConnect Flow (%s) output (%s): %s", d.Id(), v[1]["name"], err)
+			}
+		}
+	}
+
+	if d.HasChange("entitlement") {
+		o, n := d.GetChange("entitlement")
+		add, remove, update := diffFlowBlocksByName(o.([]interface{}), n.([]interface{}))
+
+		for _, tfMapRaw := range remove {
+			input := &mediaconnect.RevokeFlowEntitlementInput{
+				EntitlementArn: aws.String(tfMapRaw.(map[string]interface{})["entitlement_arn"].(string)),
+				FlowArn:        aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Revoking MediaConnect Flow entitlement: %s", input)
+			_, err := conn.RevokeFlowEntitlementWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return diag.Errorf("error revoking MediaConnect Flow (%s) entitlement (%s): %s", d.Id(), tfMapRaw.(map[string]interface{})["name"], err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &mediaconnect.GrantFlowEntitlementsInput{
+				Entitlements: expandGrantEntitlementRequests(add),
+				FlowArn:      aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Granting MediaConnect Flow entitlements: %s", input)
+			if _, err := conn.GrantFlowEntitlementsWithContext(ctx, input); err != nil {
+				return diag.Errorf("error granting MediaConnect Flow (%s) entitlements: %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range update {
+			input := &mediaconnect.UpdateFlowEntitlementInput{
+				EntitlementArn: aws.String(v[0]["entitlement_arn"].(string)),
+				FlowArn:        aws.String(d.Id()),
+				Subscribers:    flex.ExpandStringSet(v[1]["subscribers"].(*schema.Set)),
+			}
+
+			if v, ok := v[1]["description"].(string); ok && v != "" {
+				input.Description = aws.String(v)
+			}
+
+			if v, ok := v[1]["entitlement_status"].(string); ok && v != "" {
+				input.EntitlementStatus = aws.String(v)
+			}
+
+			log.Printf("[DEBUG] Updating MediaConnect Flow entitlement: %s", input)
+			if _, err := conn.UpdateFlowEntitlementWithContext(ctx, input); err != nil {
+				return diag.Errorf("error updating MediaConnect Flow (%s) entitlement (%s): %s", d.Id(), v[1]["name"], err)
+			}
+		}
+	}
+
+	for _, tfMapRaw := range removeVPCInterfaces {
+		if err := removeFlowVPCInterface(ctx, conn, d.Id(), tfMapRaw.(map[string]interface{})["name"].(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("start_flow") {
+		if d.Get("start_flow").(bool) {
+			if err := startFlow(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := stopFlow(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating MediaConnect Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFlowRead(ctx, d, meta)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaConnectConn
+
+	flow, err := FindFlowByARN(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+		if err := stopFlow(ctx, conn, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlowWithContext(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for MediaConnect Flow (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) error {
+	log.Printf("[DEBUG] Starting MediaConnect Flow: %s", arn)
+	_, err := conn.StartFlowWithContext(ctx, &mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStarted(ctx, conn, arn); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopFlow(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) error {
+	log.Printf("[DEBUG] Stopping MediaConnect Flow: %s", arn)
+	_, err := conn.StopFlowWithContext(ctx, &mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaConnect Flow (%s): %w", arn, err)
+	}
+
+	if _, err := waitFlowStopped(ctx, conn, arn); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func removeFlowVPCInterface(ctx context.Context, conn *mediaconnect.MediaConnect, arn, name string) error {
+	input := &mediaconnect.RemoveFlowVpcInterfaceInput{
+		FlowArn:          aws.String(arn),
+		VpcInterfaceName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Removing MediaConnect Flow VPC interface: %s", input)
+	_, err := conn.RemoveFlowVpcInterfaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing MediaConnect Flow (%s) VPC interface (%s): %w", arn, name, err)
+	}
+
+	if _, err := waitFlowUpdated(ctx, conn, arn); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) update: %w", arn, err)
+	}
+
+	return nil
+}
+
+// diffFlowBlocksByName compares old and new lists of name-keyed blocks.
+// It returns the blocks to add, the blocks to remove and the [old, new] pairs of blocks that have changed.
+func diffFlowBlocksByName(o, n []interface{}) ([]interface{}, []interface{}, [][2]map[string]interface{}) {
+	oldByName := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			oldByName[tfMap["name"].(string)] = tfMap
+		}
+	}
+
+	var add, remove []interface{}
+	var update [][2]map[string]interface{}
+	newNames := make(map[string]struct{})
+
+	for _, tfMapRaw := range n {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		newNames[name] = struct{}{}
+
+		oldTfMap, ok := oldByName[name]
+
+		if !ok {
+			add = append(add, tfMap)
+			continue
+		}
+
+		if !flowBlocksEqual(oldTfMap, tfMap) {
+			update = append(update, [2]map[string]interface{}{oldTfMap, tfMap})
+		}
+	}
+
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if _, ok := newNames[tfMap["name"].(string)]; !ok {
+				remove = append(remove, tfMap)
+			}
+		}
+	}
+
+	return add, remove, update
+}
+
+// flowBlocksEqual compares two blocks, ignoring computed-only ARN and network attributes.
+func flowBlocksEqual(o, n map[string]interface{}) bool {
+	for k, nv := range n {
+		switch k {
+		case "entitlement_arn", "ingest_ip", "network_interface_ids", "output_arn", "source_arn":
+			continue
+		}
+
+		ov := o[k]
+
+		if os, ok := ov.(*schema.Set); ok {
+			if ns, ok := nv.(*schema.Set); ok && os.Equal(ns) {
+				continue
+			}
+
+			return false
+		}
+
+		if !reflect.DeepEqual(ov, nv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandSetSourceRequests(tfList []interface{}) []*mediaconnect.SetSourceRequest {
+	var apiObjects []*mediaconnect.SetSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.SetSourceRequest{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+			apiObject.EntitlementArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+			apiObject.IngestPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+			apiObject.MaxBitrate = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+			apiObject.MaxLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+			apiObject.MinLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["protocol"].(string); ok && v != "" {
+			apiObject.Protocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+			apiObject.StreamId = aws.String(v)
+		}
+
+		if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+			apiObject.VpcInterfaceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+			apiObject.WhitelistCidr = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandUpdateFlowSourceInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowSourceInput {
+	apiObject := &mediaconnect.UpdateFlowSourceInput{}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAddOutputRequests(tfList []interface{}) []*mediaconnect.AddOutputRequest {
+	var apiObjects []*mediaconnect.AddOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.AddOutputRequest{
+			Name:     aws.String(tfMap["name"].(string)),
+			Protocol: aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.CidrAllowList = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination"].(string); ok && v != "" {
+			apiObject.Destination = aws.String(v)
+		}
+
+		if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+			apiObject.MaxLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+			apiObject.MinLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			apiObject.Port = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+			apiObject.RemoteId = aws.String(v)
+		}
+
+		if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+			apiObject.SmoothingLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+			apiObject.StreamId = aws.String(v)
+		}
+
+		if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+			apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+				VpcInterfaceName: aws.String(v),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandUpdateFlowOutputInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowOutputInput {
+	apiObject := &mediaconnect.UpdateFlowOutputInput{
+		Protocol: aws.String(tfMap["protocol"].(string)),
+	}
+
+	if v, ok := tfMap["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceAttachment = &mediaconnect.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandGrantEntitlementRequests(tfList []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var apiObjects []*mediaconnect.GrantEntitlementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.GrantEntitlementRequest{
+			Name:        aws.String(tfMap["name"].(string)),
+			Subscribers: flex.ExpandStringSet(tfMap["subscribers"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+			apiObject.EntitlementStatus = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVPCInterfaceRequests(tfList []interface{}) []*mediaconnect.VpcInterfaceRequest {
+	var apiObjects []*mediaconnect.VpcInterfaceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.VpcInterfaceRequest{
+			Name:             aws.String(tfMap["name"].(string)),
+			RoleArn:          aws.String(tfMap["role_arn"].(string)),
+			SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+			SubnetId:         aws.String(tfMap["subnet_id"].(string)),
+		}
+
+		if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+			apiObject.NetworkInterfaceType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *mediaconnect.FailoverConfig {
+	apiObject := &mediaconnect.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["primary_source"].(string); ok && v != "" {
+		apiObject.SourcePriority = &mediaconnect.SourcePriority{
+			PrimarySource: aws.String(v),
+		}
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSources(apiObjects []*mediaconnect.Source) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description":        aws.StringValue(apiObject.Description),
+			"entitlement_arn":    aws.StringValue(apiObject.EntitlementArn),
+			"ingest_ip":          aws.StringValue(apiObject.IngestIp),
+			"ingest_port":        aws.Int64Value(apiObject.IngestPort),
+			"name":               aws.StringValue(apiObject.Name),
+			"source_arn":         aws.StringValue(apiObject.SourceArn),
+			"vpc_interface_name": aws.StringValue(apiObject.VpcInterfaceName),
+			"whitelist_cidr":     aws.StringValue(apiObject.WhitelistCidr),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenOutputs(apiObjects []*mediaconnect.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"destination": aws.StringValue(apiObject.Destination),
+			"name":        aws.StringValue(apiObject.Name),
+			"output_arn":  aws.StringValue(apiObject.OutputArn),
+			"port":        aws.Int64Value(apiObject.Port),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["min_latency"] = aws.Int64Value(v.MinLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+			tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		if v := apiObject.VpcInterfaceAttachment; v != nil {
+			tfMap["vpc_interface_name"] = aws.StringValue(v.VpcInterfaceName)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description":        aws.StringValue(apiObject.Description),
+			"entitlement_arn":    aws.StringValue(apiObject.EntitlementArn),
+			"entitlement_status": aws.StringValue(apiObject.EntitlementStatus),
+			"name":               aws.StringValue(apiObject.Name),
+			"subscribers":        aws.StringValueSlice(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVPCInterfaces(apiObjects []*mediaconnect.VpcInterface) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                   aws.StringValue(apiObject.Name),
+			"network_interface_ids":  aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+			"network_interface_type": aws.StringValue(apiObject.NetworkInterfaceType),
+			"role_arn":               aws.StringValue(apiObject.RoleArn),
+			"security_group_ids":     aws.StringValueSlice(apiObject.SecurityGroupIds),
+			"subnet_id":              aws.StringValue(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenFailoverConfig(apiObject *mediaconnect.FailoverConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover_mode":   aws.StringValue(apiObject.FailoverMode),
+		"recovery_window": aws.Int64Value(apiObject.RecoveryWindow),
+		"state":           aws.StringValue(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil {
+		tfMap["primary_source"] = aws.StringValue(v.PrimarySource)
+	}
+
+	return []interface{}{tfMap}
+}
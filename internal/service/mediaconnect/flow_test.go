@@ -0,0 +1,411 @@
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaConnectFlow_basic(t *testing.T) {
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mediaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediaconnect", regexp.MustCompile(`flow:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", "rtp"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.ingest_port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.24.34.0/23"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.source_arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_interface.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_flow"},
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_disappears(t *testing.T) {
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mediaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmediaconnect.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_tags(t *testing.T) {
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mediaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_flow"},
+			},
+			{
+				Config: testAccFlowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_outputsAndEntitlements(t *testing.T) {
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mediaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, "output1", "first output", "entitlement1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.description", "first output"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "10.0.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5010"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.protocol", "rtp"),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.output_arn"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.subscribers.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement.0.entitlement_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_flow"},
+			},
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, "output1", "updated output", "entitlement2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.description", "updated output"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_sourceFailover(t *testing.T) {
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mediaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_sourceFailover(rName, mediaconnect.StateEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.failover_mode", mediaconnect.FailoverModeMerge),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.recovery_window", "200"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.state", mediaconnect.StateEnabled),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_flow"},
+			},
+			{
+				Config: testAccFlowConfig_sourceFailover(rName, mediaconnect.StateDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.state", mediaconnect.StateDisabled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_start(t *testing.T) {
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(mediaconnect.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, mediaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_startFlow(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusActive),
+				),
+			},
+			{
+				Config: testAccFlowConfig_startFlow(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediaconnect_flow" {
+			continue
+		}
+
+		_, err := tfmediaconnect.FindFlowByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConnect Flow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFlowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectConn
+
+		_, err := tfmediaconnect.FindFlowByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFlowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccFlowConfig_outputsAndEntitlements(rName, outputName, outputDescription, entitlementName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  output {
+    name        = %[2]q
+    description = %[3]q
+    destination = "10.0.0.10"
+    port        = 5010
+    protocol    = "rtp"
+  }
+
+  entitlement {
+    name        = %[4]q
+    subscribers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName, outputName, outputDescription, entitlementName)
+}
+
+func testAccFlowConfig_sourceFailover(rName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source1"
+    protocol       = "rtp-fec"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  source {
+    name           = "source2"
+    protocol       = "rtp-fec"
+    ingest_port    = 5002
+    whitelist_cidr = "10.24.34.0/23"
+  }
+
+  source_failover_config {
+    failover_mode   = "MERGE"
+    recovery_window = 200
+    state           = %[2]q
+  }
+}
+`, rName, state)
+}
+
+func testAccFlowConfig_startFlow(rName string, start bool) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name       = %[1]q
+  start_flow = %[2]t
+
+  source {
+    name           = "source1"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.24.34.0/23"
+  }
+}
+`, rName, start)
+}
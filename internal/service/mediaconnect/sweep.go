@@ -0,0 +1,69 @@
+//go:build sweep
+// +build sweep
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mediaconnect_flow", &resource.Sweeper{
+		Name: "aws_mediaconnect_flow",
+		F:    sweepFlows,
+	})
+}
+
+func sweepFlows(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaConnectConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &mediaconnect.ListFlowsInput{}
+
+	err = conn.ListFlowsPagesWithContext(context.Background(), input, func(page *mediaconnect.ListFlowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Flows {
+			r := ResourceFlow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.FlowArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConnect Flows sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaConnect Flows (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaConnect Flows (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,100 @@
+package mediaconnect
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	flowStartedTimeout = 10 * time.Minute
+	flowStoppedTimeout = 10 * time.Minute
+	flowUpdatedTimeout = 5 * time.Minute
+)
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby, mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: flowUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStarted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStarting},
+		Target:  []string{mediaconnect.StatusActive},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: flowStartedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStopping},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: flowStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting, mediaconnect.StatusStandby},
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,1135 @@
+package medialive
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceChannelCreate,
+		ReadWithoutTimeout:   resourceChannelRead,
+		UpdateWithoutTimeout: resourceChannelUpdate,
+		DeleteWithoutTimeout: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cdi_input_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resolution": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.CdiInputResolution_Values(), false),
+						},
+					},
+				},
+			},
+			"channel_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      medialive.ChannelClassStandard,
+				ValidateFunc: validation.StringInSlice(medialive.ChannelClass_Values(), false),
+			},
+			"destinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_package_settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multiplex_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"multiplex_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"program_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encoder_settings": channelEncoderSettingsSchema(),
+			"input_attachments": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatic_input_failover_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_clear_time_msec": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"input_preference": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputPreference_Values(), false),
+									},
+									"secondary_input_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_selector": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"selector_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"audio_language_selection": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"language_code": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"language_selection_policy": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: validation.StringInSlice(medialive.AudioLanguageSelectionPolicy_Values(), false),
+																		},
+																	},
+																},
+															},
+															"audio_pid_selection": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"pid": {
+																			Type:     schema.TypeInt,
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"deblock_filter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputDeblockFilter_Values(), false),
+									},
+									"denoise_filter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputDenoiseFilter_Values(), false),
+									},
+									"filter_strength": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 5),
+									},
+									"input_filter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputFilter_Values(), false),
+									},
+									"network_input_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"hls_input_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bandwidth": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"buffer_segments": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"retries": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"retry_interval": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"scte35_source": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(medialive.HlsScte35SourceType_Values(), false),
+															},
+														},
+													},
+												},
+												"server_validation": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(medialive.NetworkInputServerValidation_Values(), false),
+												},
+											},
+										},
+									},
+									"source_end_behavior": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.InputSourceEndBehavior_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputCodec_Values(), false),
+						},
+						"input_resolution": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputResolution_Values(), false),
+						},
+						"maximum_bitrate": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputMaximumBitrate_Values(), false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(medialive.LogLevel_Values(), false),
+			},
+			"maintenance": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maintenance_day": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.MaintenanceDay_Values(), false),
+						},
+						"maintenance_start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"start_channel": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"public_address_allocation_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateChannelInput{
+		ChannelClass:       aws.String(d.Get("channel_class").(string)),
+		Destinations:       expandChannelDestinations(d.Get("destinations").(*schema.Set).List()),
+		EncoderSettings:    expandEncoderSettings(d.Get("encoder_settings").([]interface{})),
+		InputAttachments:   expandChannelInputAttachments(d.Get("input_attachments").([]interface{})),
+		InputSpecification: expandChannelInputSpecification(d.Get("input_specification").([]interface{})),
+		Name:               aws.String(name),
+		RequestId:          aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("cdi_input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CdiInputSpecification = &medialive.CdiInputSpecification{
+			Resolution: aws.String(v.([]interface{})[0].(map[string]interface{})["resolution"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("log_level"); ok {
+		input.LogLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.Maintenance = &medialive.MaintenanceCreateSettings{
+			MaintenanceDay:       aws.String(tfMap["maintenance_day"].(string)),
+			MaintenanceStartTime: aws.String(tfMap["maintenance_start_time"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Vpc = expandChannelVPC(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	output, err := conn.CreateChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MediaLive Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Id))
+
+	if _, err := waitChannelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for MediaLive Channel (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("start_channel").(bool) {
+		if err := startChannel(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindChannelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if output.CdiInputSpecification != nil {
+		if err := d.Set("cdi_input_specification", []interface{}{map[string]interface{}{
+			"resolution": aws.StringValue(output.CdiInputSpecification.Resolution),
+		}}); err != nil {
+			return diag.Errorf("error setting cdi_input_specification: %s", err)
+		}
+	} else {
+		d.Set("cdi_input_specification", nil)
+	}
+	d.Set("channel_class", output.ChannelClass)
+	if err := d.Set("destinations", flattenChannelDestinations(output.Destinations)); err != nil {
+		return diag.Errorf("error setting destinations: %s", err)
+	}
+	if err := d.Set("encoder_settings", flattenEncoderSettings(output.EncoderSettings)); err != nil {
+		return diag.Errorf("error setting encoder_settings: %s", err)
+	}
+	if err := d.Set("input_attachments", flattenChannelInputAttachments(output.InputAttachments)); err != nil {
+		return diag.Errorf("error setting input_attachments: %s", err)
+	}
+	if err := d.Set("input_specification", flattenChannelInputSpecification(output.InputSpecification)); err != nil {
+		return diag.Errorf("error setting input_specification: %s", err)
+	}
+	d.Set("log_level", output.LogLevel)
+	if output.Maintenance != nil {
+		if err := d.Set("maintenance", []interface{}{map[string]interface{}{
+			"maintenance_day":        aws.StringValue(output.Maintenance.MaintenanceDay),
+			"maintenance_start_time": aws.StringValue(output.Maintenance.MaintenanceStartTime),
+		}}); err != nil {
+			return diag.Errorf("error setting maintenance: %s", err)
+		}
+	} else {
+		d.Set("maintenance", nil)
+	}
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("vpc", flattenChannelVPC(output.Vpc, d.Get("vpc.0.public_address_allocation_ids").([]interface{}))); err != nil {
+		return diag.Errorf("error setting vpc: %s", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChangesExcept("start_channel", "tags", "tags_all") {
+		output, err := FindChannelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading MediaLive Channel (%s): %s", d.Id(), err)
+		}
+
+		// A running channel must be stopped before it can be updated.
+		if aws.StringValue(output.State) == medialive.ChannelStateRunning {
+			if err := stopChannel(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		input := &medialive.UpdateChannelInput{
+			ChannelId: aws.String(d.Id()),
+			Name:      aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("cdi_input_specification") {
+			if v, ok := d.GetOk("cdi_input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CdiInputSpecification = &medialive.CdiInputSpecification{
+					Resolution: aws.String(v.([]interface{})[0].(map[string]interface{})["resolution"].(string)),
+				}
+			}
+		}
+
+		if d.HasChange("destinations") {
+			input.Destinations = expandChannelDestinations(d.Get("destinations").(*schema.Set).List())
+		}
+
+		if d.HasChange("encoder_settings") {
+			input.EncoderSettings = expandEncoderSettings(d.Get("encoder_settings").([]interface{}))
+		}
+
+		if d.HasChange("input_attachments") {
+			input.InputAttachments = expandChannelInputAttachments(d.Get("input_attachments").([]interface{}))
+		}
+
+		if d.HasChange("input_specification") {
+			input.InputSpecification = expandChannelInputSpecification(d.Get("input_specification").([]interface{}))
+		}
+
+		if d.HasChange("log_level") {
+			input.LogLevel = aws.String(d.Get("log_level").(string))
+		}
+
+		if d.HasChange("maintenance") {
+			if v, ok := d.GetOk("maintenance"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+				input.Maintenance = &medialive.MaintenanceUpdateSettings{
+					MaintenanceDay:       aws.String(tfMap["maintenance_day"].(string)),
+					MaintenanceStartTime: aws.String(tfMap["maintenance_start_time"].(string)),
+				}
+			}
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		_, err = conn.UpdateChannelWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating MediaLive Channel (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitChannelUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for MediaLive Channel (%s) update: %s", d.Id(), err)
+		}
+
+		if d.Get("start_channel").(bool) {
+			if err := startChannel(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	} else if d.HasChange("start_channel") {
+		if d.Get("start_channel").(bool) {
+			if err := startChannel(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := stopChannel(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MediaLive Channel (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(ctx, d, meta)
+}
+
+func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	output, err := FindChannelByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.State) == medialive.ChannelStateRunning {
+		if err := stopChannel(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err = conn.DeleteChannelWithContext(ctx, &medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitChannelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for MediaLive Channel (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startChannel(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting MediaLive Channel: %s", id)
+	_, err := conn.StartChannelWithContext(ctx, &medialive.StartChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Channel (%s): %w", id, err)
+	}
+
+	if _, err := waitChannelRunning(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func stopChannel(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping MediaLive Channel: %s", id)
+	_, err := conn.StopChannelWithContext(ctx, &medialive.StopChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Channel (%s): %w", id, err)
+	}
+
+	if _, err := waitChannelStopped(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandChannelDestinations(tfList []interface{}) []*medialive.OutputDestination {
+	apiObjects := []*medialive.OutputDestination{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputDestination{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["media_package_settings"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				apiObject.MediaPackageSettings = append(apiObject.MediaPackageSettings, &medialive.MediaPackageOutputDestinationSettings{
+					ChannelId: aws.String(tfMap["channel_id"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["multiplex_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.MultiplexSettings = &medialive.MultiplexProgramChannelDestinationSettings{
+				MultiplexId: aws.String(tfMap["multiplex_id"].(string)),
+				ProgramName: aws.String(tfMap["program_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["settings"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				settings := &medialive.OutputDestinationSettings{}
+
+				if v, ok := tfMap["password_param"].(string); ok && v != "" {
+					settings.PasswordParam = aws.String(v)
+				}
+
+				if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+					settings.StreamName = aws.String(v)
+				}
+
+				if v, ok := tfMap["url"].(string); ok && v != "" {
+					settings.Url = aws.String(v)
+				}
+
+				if v, ok := tfMap["username"].(string); ok && v != "" {
+					settings.Username = aws.String(v)
+				}
+
+				apiObject.Settings = append(apiObject.Settings, settings)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandChannelInputAttachments(tfList []interface{}) []*medialive.InputAttachment {
+	apiObjects := []*medialive.InputAttachment{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputAttachment{
+			InputAttachmentName: aws.String(tfMap["input_attachment_name"].(string)),
+			InputId:             aws.String(tfMap["input_id"].(string)),
+		}
+
+		if v, ok := tfMap["automatic_input_failover_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			automaticInputFailoverSettings := &medialive.AutomaticInputFailoverSettings{
+				SecondaryInputId: aws.String(tfMap["secondary_input_id"].(string)),
+			}
+
+			if v, ok := tfMap["error_clear_time_msec"].(int); ok && v != 0 {
+				automaticInputFailoverSettings.ErrorClearTimeMsec = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["input_preference"].(string); ok && v != "" {
+				automaticInputFailoverSettings.InputPreference = aws.String(v)
+			}
+
+			apiObject.AutomaticInputFailoverSettings = automaticInputFailoverSettings
+		}
+
+		if v, ok := tfMap["input_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.InputSettings = expandChannelInputSettings(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandChannelInputSettings(tfMap map[string]interface{}) *medialive.InputSettings {
+	apiObject := &medialive.InputSettings{}
+
+	if v, ok := tfMap["audio_selector"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			audioSelector := &medialive.AudioSelector{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["selector_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				selectorSettings := &medialive.AudioSelectorSettings{}
+
+				if v, ok := tfMap["audio_language_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					tfMap := v[0].(map[string]interface{})
+					selectorSettings.AudioLanguageSelection = &medialive.AudioLanguageSelection{
+						LanguageCode: aws.String(tfMap["language_code"].(string)),
+					}
+
+					if v, ok := tfMap["language_selection_policy"].(string); ok && v != "" {
+						selectorSettings.AudioLanguageSelection.LanguageSelectionPolicy = aws.String(v)
+					}
+				}
+
+				if v, ok := tfMap["audio_pid_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					selectorSettings.AudioPidSelection = &medialive.AudioPidSelection{
+						Pid: aws.Int64(int64(v[0].(map[string]interface{})["pid"].(int))),
+					}
+				}
+
+				audioSelector.SelectorSettings = selectorSettings
+			}
+
+			apiObject.AudioSelectors = append(apiObject.AudioSelectors, audioSelector)
+		}
+	}
+
+	if v, ok := tfMap["deblock_filter"].(string); ok && v != "" {
+		apiObject.DeblockFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["denoise_filter"].(string); ok && v != "" {
+		apiObject.DenoiseFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter_strength"].(int); ok && v != 0 {
+		apiObject.FilterStrength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["input_filter"].(string); ok && v != "" {
+		apiObject.InputFilter = aws.String(v)
+	}
+
+	if v, ok := tfMap["network_input_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		networkInputSettings := &medialive.NetworkInputSettings{}
+
+		if v, ok := tfMap["hls_input_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			hlsInputSettings := &medialive.HlsInputSettings{}
+
+			if v, ok := tfMap["bandwidth"].(int); ok && v != 0 {
+				hlsInputSettings.Bandwidth = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["buffer_segments"].(int); ok && v != 0 {
+				hlsInputSettings.BufferSegments = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["retries"].(int); ok && v != 0 {
+				hlsInputSettings.Retries = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["retry_interval"].(int); ok && v != 0 {
+				hlsInputSettings.RetryInterval = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["scte35_source"].(string); ok && v != "" {
+				hlsInputSettings.Scte35Source = aws.String(v)
+			}
+
+			networkInputSettings.HlsInputSettings = hlsInputSettings
+		}
+
+		if v, ok := tfMap["server_validation"].(string); ok && v != "" {
+			networkInputSettings.ServerValidation = aws.String(v)
+		}
+
+		apiObject.NetworkInputSettings = networkInputSettings
+	}
+
+	if v, ok := tfMap["source_end_behavior"].(string); ok && v != "" {
+		apiObject.SourceEndBehavior = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandChannelInputSpecification(tfList []interface{}) *medialive.InputSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &medialive.InputSpecification{
+		Codec:          aws.String(tfMap["codec"].(string)),
+		MaximumBitrate: aws.String(tfMap["maximum_bitrate"].(string)),
+		Resolution:     aws.String(tfMap["input_resolution"].(string)),
+	}
+}
+
+func expandChannelVPC(tfMap map[string]interface{}) *medialive.VpcOutputSettings {
+	apiObject := &medialive.VpcOutputSettings{
+		SubnetIds: flex.ExpandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["public_address_allocation_ids"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PublicAddressAllocationIds = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenChannelDestinations(apiObjects []*medialive.OutputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id": aws.StringValue(apiObject.Id),
+		}
+
+		var mediaPackageSettings []interface{}
+		for _, v := range apiObject.MediaPackageSettings {
+			mediaPackageSettings = append(mediaPackageSettings, map[string]interface{}{
+				"channel_id": aws.StringValue(v.ChannelId),
+			})
+		}
+		tfMap["media_package_settings"] = mediaPackageSettings
+
+		if v := apiObject.MultiplexSettings; v != nil {
+			tfMap["multiplex_settings"] = []interface{}{map[string]interface{}{
+				"multiplex_id": aws.StringValue(v.MultiplexId),
+				"program_name": aws.StringValue(v.ProgramName),
+			}}
+		}
+
+		var settings []interface{}
+		for _, v := range apiObject.Settings {
+			settings = append(settings, map[string]interface{}{
+				"password_param": aws.StringValue(v.PasswordParam),
+				"stream_name":    aws.StringValue(v.StreamName),
+				"url":            aws.StringValue(v.Url),
+				"username":       aws.StringValue(v.Username),
+			})
+		}
+		tfMap["settings"] = settings
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenChannelInputAttachments(apiObjects []*medialive.InputAttachment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"input_attachment_name": aws.StringValue(apiObject.InputAttachmentName),
+			"input_id":              aws.StringValue(apiObject.InputId),
+		}
+
+		if v := apiObject.AutomaticInputFailoverSettings; v != nil {
+			tfMap["automatic_input_failover_settings"] = []interface{}{map[string]interface{}{
+				"error_clear_time_msec": aws.Int64Value(v.ErrorClearTimeMsec),
+				"input_preference":      aws.StringValue(v.InputPreference),
+				"secondary_input_id":    aws.StringValue(v.SecondaryInputId),
+			}}
+		}
+
+		if v := apiObject.InputSettings; v != nil {
+			tfMap["input_settings"] = flattenChannelInputSettings(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenChannelInputSettings(apiObject *medialive.InputSettings) []interface{} {
+	tfMap := map[string]interface{}{
+		"deblock_filter":      aws.StringValue(apiObject.DeblockFilter),
+		"denoise_filter":      aws.StringValue(apiObject.DenoiseFilter),
+		"filter_strength":     aws.Int64Value(apiObject.FilterStrength),
+		"input_filter":        aws.StringValue(apiObject.InputFilter),
+		"source_end_behavior": aws.StringValue(apiObject.SourceEndBehavior),
+	}
+
+	var audioSelectors []interface{}
+	for _, v := range apiObject.AudioSelectors {
+		audioSelector := map[string]interface{}{
+			"name": aws.StringValue(v.Name),
+		}
+
+		if v := v.SelectorSettings; v != nil {
+			selectorSettings := map[string]interface{}{}
+
+			if v := v.AudioLanguageSelection; v != nil {
+				selectorSettings["audio_language_selection"] = []interface{}{map[string]interface{}{
+					"language_code":             aws.StringValue(v.LanguageCode),
+					"language_selection_policy": aws.StringValue(v.LanguageSelectionPolicy),
+				}}
+			}
+
+			if v := v.AudioPidSelection; v != nil {
+				selectorSettings["audio_pid_selection"] = []interface{}{map[string]interface{}{
+					"pid": aws.Int64Value(v.Pid),
+				}}
+			}
+
+			audioSelector["selector_settings"] = []interface{}{selectorSettings}
+		}
+
+		audioSelectors = append(audioSelectors, audioSelector)
+	}
+	tfMap["audio_selector"] = audioSelectors
+
+	if v := apiObject.NetworkInputSettings; v != nil {
+		networkInputSettings := map[string]interface{}{
+			"server_validation": aws.StringValue(v.ServerValidation),
+		}
+
+		if v := v.HlsInputSettings; v != nil {
+			networkInputSettings["hls_input_settings"] = []interface{}{map[string]interface{}{
+				"bandwidth":       aws.Int64Value(v.Bandwidth),
+				"buffer_segments": aws.Int64Value(v.BufferSegments),
+				"retries":         aws.Int64Value(v.Retries),
+				"retry_interval":  aws.Int64Value(v.RetryInterval),
+				"scte35_source":   aws.StringValue(v.Scte35Source),
+			}}
+		}
+
+		tfMap["network_input_settings"] = []interface{}{networkInputSettings}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenChannelInputSpecification(apiObject *medialive.InputSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"codec":            aws.StringValue(apiObject.Codec),
+		"input_resolution": aws.StringValue(apiObject.Resolution),
+		"maximum_bitrate":  aws.StringValue(apiObject.MaximumBitrate),
+	}}
+}
+
+// The API does not return the public address allocation IDs, so the configured values are carried over.
+func flattenChannelVPC(apiObject *medialive.VpcOutputSettingsDescription, publicAddressAllocationIDs []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"availability_zones":            aws.StringValueSlice(apiObject.AvailabilityZones),
+		"network_interface_ids":         aws.StringValueSlice(apiObject.NetworkInterfaceIds),
+		"public_address_allocation_ids": publicAddressAllocationIDs,
+		"security_group_ids":            aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":                    aws.StringValueSlice(apiObject.SubnetIds),
+	}}
+}
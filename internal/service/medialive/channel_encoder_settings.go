@@ -0,0 +1,1944 @@
+package medialive
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func channelEncoderSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_descriptions": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"audio_selector_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"audio_type_control": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.AudioDescriptionAudioTypeControl_Values(), false),
+							},
+							"codec_settings": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"aac_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"bitrate": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"coding_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacCodingMode_Values(), false),
+													},
+													"input_type": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacInputType_Values(), false),
+													},
+													"profile": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacProfile_Values(), false),
+													},
+													"rate_control_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacRateControlMode_Values(), false),
+													},
+													"raw_format": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacRawFormat_Values(), false),
+													},
+													"sample_rate": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"spec": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AacSpec_Values(), false),
+													},
+												},
+											},
+										},
+										"ac3_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"bitrate": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"bitstream_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3BitstreamMode_Values(), false),
+													},
+													"coding_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3CodingMode_Values(), false),
+													},
+													"dialnorm": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.IntBetween(1, 31),
+													},
+													"drc_profile": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3DrcProfile_Values(), false),
+													},
+													"lfe_filter": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3LfeFilter_Values(), false),
+													},
+													"metadata_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.Ac3MetadataControl_Values(), false),
+													},
+												},
+											},
+										},
+										"pass_through_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem:     &schema.Resource{},
+										},
+									},
+								},
+							},
+							"language_code": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"language_code_control": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.AudioDescriptionLanguageCodeControl_Values(), false),
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"stream_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"output_groups": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"output_group_settings": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"archive_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": channelOutputLocationRefSchema(),
+													"rollover_interval": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+										"frame_capture_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": channelOutputLocationRefSchema(),
+												},
+											},
+										},
+										"hls_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": channelOutputLocationRefSchema(),
+													"directory_structure": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsDirectoryStructure_Values(), false),
+													},
+													"input_loss_action": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.InputLossActionForHlsOut_Values(), false),
+													},
+													"keep_segments": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"manifest_compression": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsManifestCompression_Values(), false),
+													},
+													"mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.HlsMode_Values(), false),
+													},
+													"segment_length": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"segments_per_subdirectory": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+										"media_package_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"destination": channelOutputLocationRefSchema(),
+												},
+											},
+										},
+										"rtmp_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"authentication_scheme": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.AuthenticationScheme_Values(), false),
+													},
+													"cache_full_behavior": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.RtmpCacheFullBehavior_Values(), false),
+													},
+													"cache_length": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"caption_data": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.RtmpCaptionData_Values(), false),
+													},
+													"input_loss_action": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.InputLossActionForRtmpOut_Values(), false),
+													},
+													"restart_delay": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+										"udp_group_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"input_loss_action": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.InputLossActionForUdpOut_Values(), false),
+													},
+													"timed_metadata_id3_frame": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.UdpTimedMetadataId3Frame_Values(), false),
+													},
+													"timed_metadata_id3_period": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"outputs": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"audio_description_names": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"caption_description_names": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"output_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+											Computed: true,
+										},
+										"output_settings": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"archive_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"container_settings": {
+																	Type:     schema.TypeList,
+																	Required: true,
+																	MaxItems: 1,
+																	Elem: &schema.Resource{
+																		Schema: map[string]*schema.Schema{
+																			"m2ts_settings": channelM2tsSettingsSchema(),
+																		},
+																	},
+																},
+																"extension": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																	Computed: true,
+																},
+																"name_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+															},
+														},
+													},
+													"frame_capture_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+															},
+														},
+													},
+													"hls_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"hls_settings": {
+																	Type:     schema.TypeList,
+																	Required: true,
+																	MaxItems: 1,
+																	Elem: &schema.Resource{
+																		Schema: map[string]*schema.Schema{
+																			"standard_hls_settings": {
+																				Type:     schema.TypeList,
+																				Optional: true,
+																				MaxItems: 1,
+																				Elem: &schema.Resource{
+																					Schema: map[string]*schema.Schema{
+																						"audio_rendition_sets": {
+																							Type:     schema.TypeString,
+																							Optional: true,
+																							Computed: true,
+																						},
+																						"m3u8_settings": {
+																							Type:     schema.TypeList,
+																							Required: true,
+																							MaxItems: 1,
+																							Elem: &schema.Resource{
+																								Schema: map[string]*schema.Schema{
+																									"audio_frames_per_pes": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"audio_pids": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"pcr_control": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8PcrControl_Values(), false),
+																									},
+																									"pmt_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"program_num": {
+																										Type:     schema.TypeInt,
+																										Optional: true,
+																										Computed: true,
+																									},
+																									"scte35_behavior": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8Scte35Behavior_Values(), false),
+																									},
+																									"timed_metadata_behavior": {
+																										Type:         schema.TypeString,
+																										Optional:     true,
+																										Computed:     true,
+																										ValidateFunc: validation.StringInSlice(medialive.M3u8TimedMetadataBehavior_Values(), false),
+																									},
+																									"video_pid": {
+																										Type:     schema.TypeString,
+																										Optional: true,
+																										Computed: true,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+																"name_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+																"segment_modifier": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+															},
+														},
+													},
+													"media_package_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem:     &schema.Resource{},
+													},
+													"rtmp_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"certificate_mode": {
+																	Type:         schema.TypeString,
+																	Optional:     true,
+																	Computed:     true,
+																	ValidateFunc: validation.StringInSlice(medialive.RtmpOutputCertificateMode_Values(), false),
+																},
+																"connection_retry_interval": {
+																	Type:     schema.TypeInt,
+																	Optional: true,
+																	Computed: true,
+																},
+																"destination": channelOutputLocationRefSchema(),
+																"num_retries": {
+																	Type:     schema.TypeInt,
+																	Optional: true,
+																	Computed: true,
+																},
+															},
+														},
+													},
+													"udp_output_settings": {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"buffer_msec": {
+																	Type:     schema.TypeInt,
+																	Optional: true,
+																	Computed: true,
+																},
+																"container_settings": {
+																	Type:     schema.TypeList,
+																	Required: true,
+																	MaxItems: 1,
+																	Elem: &schema.Resource{
+																		Schema: map[string]*schema.Schema{
+																			"m2ts_settings": channelM2tsSettingsSchema(),
+																		},
+																	},
+																},
+																"destination": channelOutputLocationRefSchema(),
+															},
+														},
+													},
+												},
+											},
+										},
+										"video_description_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"timecode_config": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"source": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(medialive.TimecodeConfigSource_Values(), false),
+							},
+							"sync_threshold": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 1000000),
+							},
+						},
+					},
+				},
+				"video_descriptions": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"codec_settings": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"frame_capture_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"capture_interval": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.IntAtLeast(1),
+													},
+													"capture_interval_units": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.FrameCaptureIntervalUnit_Values(), false),
+													},
+												},
+											},
+										},
+										"h264_settings": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"adaptive_quantization": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264AdaptiveQuantization_Values(), false),
+													},
+													"bitrate": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.IntAtLeast(1000),
+													},
+													"buf_size": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"entropy_encoding": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264EntropyEncoding_Values(), false),
+													},
+													"framerate_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264FramerateControl_Values(), false),
+													},
+													"framerate_denominator": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"framerate_numerator": {
+														Type:     schema.TypeInt,
+														Optional: true,
+														Computed: true,
+													},
+													"gop_size": {
+														Type:     schema.TypeFloat,
+														Optional: true,
+														Computed: true,
+													},
+													"gop_size_units": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264GopSizeUnits_Values(), false),
+													},
+													"level": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264Level_Values(), false),
+													},
+													"max_bitrate": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.IntAtLeast(1000),
+													},
+													"par_control": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264ParControl_Values(), false),
+													},
+													"profile": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264Profile_Values(), false),
+													},
+													"qvbr_quality_level": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.IntBetween(1, 10),
+													},
+													"rate_control_mode": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264RateControlMode_Values(), false),
+													},
+													"scene_change_detect": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														Computed:     true,
+														ValidateFunc: validation.StringInSlice(medialive.H264SceneChangeDetect_Values(), false),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"height": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"respond_to_afd": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.VideoDescriptionRespondToAfd_Values(), false),
+							},
+							"scaling_behavior": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(medialive.VideoDescriptionScalingBehavior_Values(), false),
+							},
+							"sharpness": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(0, 100),
+							},
+							"width": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func channelOutputLocationRefSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_ref_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func channelM2tsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_frames_per_pes": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"bitrate": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"buffer_model": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsBufferModel_Values(), false),
+				},
+				"pcr_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsPcrControl_Values(), false),
+				},
+				"pmt_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"program_num": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"rate_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsRateMode_Values(), false),
+				},
+				"scte35_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsScte35Control_Values(), false),
+				},
+				"transport_stream_id": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"video_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandEncoderSettings(tfList []interface{}) *medialive.EncoderSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &medialive.EncoderSettings{}
+
+	if v, ok := tfMap["audio_descriptions"].(*schema.Set); ok {
+		apiObject.AudioDescriptions = expandAudioDescriptions(v.List())
+	}
+
+	if v, ok := tfMap["output_groups"].([]interface{}); ok {
+		apiObject.OutputGroups = expandOutputGroups(v)
+	}
+
+	if v, ok := tfMap["timecode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TimecodeConfig = &medialive.TimecodeConfig{
+			Source: aws.String(tfMap["source"].(string)),
+		}
+
+		if v, ok := tfMap["sync_threshold"].(int); ok && v != 0 {
+			apiObject.TimecodeConfig.SyncThreshold = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["video_descriptions"].([]interface{}); ok {
+		apiObject.VideoDescriptions = expandVideoDescriptions(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioDescriptions(tfList []interface{}) []*medialive.AudioDescription {
+	apiObjects := []*medialive.AudioDescription{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.AudioDescription{
+			AudioSelectorName: aws.String(tfMap["audio_selector_name"].(string)),
+			Name:              aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["audio_type_control"].(string); ok && v != "" {
+			apiObject.AudioTypeControl = aws.String(v)
+		}
+
+		if v, ok := tfMap["codec_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CodecSettings = expandAudioCodecSettings(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["language_code"].(string); ok && v != "" {
+			apiObject.LanguageCode = aws.String(v)
+		}
+
+		if v, ok := tfMap["language_code_control"].(string); ok && v != "" {
+			apiObject.LanguageCodeControl = aws.String(v)
+		}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAudioCodecSettings(tfMap map[string]interface{}) *medialive.AudioCodecSettings {
+	apiObject := &medialive.AudioCodecSettings{}
+
+	if v, ok := tfMap["aac_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		aacSettings := &medialive.AacSettings{}
+
+		if v, ok := tfMap["bitrate"].(float64); ok && v != 0 {
+			aacSettings.Bitrate = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["coding_mode"].(string); ok && v != "" {
+			aacSettings.CodingMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["input_type"].(string); ok && v != "" {
+			aacSettings.InputType = aws.String(v)
+		}
+
+		if v, ok := tfMap["profile"].(string); ok && v != "" {
+			aacSettings.Profile = aws.String(v)
+		}
+
+		if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+			aacSettings.RateControlMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["raw_format"].(string); ok && v != "" {
+			aacSettings.RawFormat = aws.String(v)
+		}
+
+		if v, ok := tfMap["sample_rate"].(float64); ok && v != 0 {
+			aacSettings.SampleRate = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["spec"].(string); ok && v != "" {
+			aacSettings.Spec = aws.String(v)
+		}
+
+		apiObject.AacSettings = aacSettings
+	}
+
+	if v, ok := tfMap["ac3_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		ac3Settings := &medialive.Ac3Settings{}
+
+		if v, ok := tfMap["bitrate"].(float64); ok && v != 0 {
+			ac3Settings.Bitrate = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["bitstream_mode"].(string); ok && v != "" {
+			ac3Settings.BitstreamMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["coding_mode"].(string); ok && v != "" {
+			ac3Settings.CodingMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["dialnorm"].(int); ok && v != 0 {
+			ac3Settings.Dialnorm = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["drc_profile"].(string); ok && v != "" {
+			ac3Settings.DrcProfile = aws.String(v)
+		}
+
+		if v, ok := tfMap["lfe_filter"].(string); ok && v != "" {
+			ac3Settings.LfeFilter = aws.String(v)
+		}
+
+		if v, ok := tfMap["metadata_control"].(string); ok && v != "" {
+			ac3Settings.MetadataControl = aws.String(v)
+		}
+
+		apiObject.Ac3Settings = ac3Settings
+	}
+
+	if v, ok := tfMap["pass_through_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PassThroughSettings = &medialive.PassThroughSettings{}
+	}
+
+	return apiObject
+}
+
+func expandOutputGroups(tfList []interface{}) []*medialive.OutputGroup {
+	apiObjects := []*medialive.OutputGroup{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputGroup{
+			OutputGroupSettings: expandOutputGroupSettings(tfMap["output_group_settings"].([]interface{})),
+			Outputs:             expandOutputs(tfMap["outputs"].([]interface{})),
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputGroupSettings(tfList []interface{}) *medialive.OutputGroupSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &medialive.OutputGroupSettings{}
+
+	if v, ok := tfMap["archive_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ArchiveGroupSettings = &medialive.ArchiveGroupSettings{
+			Destination: expandOutputLocationRef(tfMap["destination"].([]interface{})),
+		}
+
+		if v, ok := tfMap["rollover_interval"].(int); ok && v != 0 {
+			apiObject.ArchiveGroupSettings.RolloverInterval = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["frame_capture_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.FrameCaptureGroupSettings = &medialive.FrameCaptureGroupSettings{
+			Destination: expandOutputLocationRef(tfMap["destination"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["hls_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		hlsGroupSettings := &medialive.HlsGroupSettings{
+			Destination: expandOutputLocationRef(tfMap["destination"].([]interface{})),
+		}
+
+		if v, ok := tfMap["directory_structure"].(string); ok && v != "" {
+			hlsGroupSettings.DirectoryStructure = aws.String(v)
+		}
+
+		if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+			hlsGroupSettings.InputLossAction = aws.String(v)
+		}
+
+		if v, ok := tfMap["keep_segments"].(int); ok && v != 0 {
+			hlsGroupSettings.KeepSegments = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["manifest_compression"].(string); ok && v != "" {
+			hlsGroupSettings.ManifestCompression = aws.String(v)
+		}
+
+		if v, ok := tfMap["mode"].(string); ok && v != "" {
+			hlsGroupSettings.Mode = aws.String(v)
+		}
+
+		if v, ok := tfMap["segment_length"].(int); ok && v != 0 {
+			hlsGroupSettings.SegmentLength = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["segments_per_subdirectory"].(int); ok && v != 0 {
+			hlsGroupSettings.SegmentsPerSubdirectory = aws.Int64(int64(v))
+		}
+
+		apiObject.HlsGroupSettings = hlsGroupSettings
+	}
+
+	if v, ok := tfMap["media_package_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MediaPackageGroupSettings = &medialive.MediaPackageGroupSettings{
+			Destination: expandOutputLocationRef(tfMap["destination"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["rtmp_group_settings"].([]interface{}); ok && len(v) > 0 {
+		rtmpGroupSettings := &medialive.RtmpGroupSettings{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["authentication_scheme"].(string); ok && v != "" {
+				rtmpGroupSettings.AuthenticationScheme = aws.String(v)
+			}
+
+			if v, ok := tfMap["cache_full_behavior"].(string); ok && v != "" {
+				rtmpGroupSettings.CacheFullBehavior = aws.String(v)
+			}
+
+			if v, ok := tfMap["cache_length"].(int); ok && v != 0 {
+				rtmpGroupSettings.CacheLength = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["caption_data"].(string); ok && v != "" {
+				rtmpGroupSettings.CaptionData = aws.String(v)
+			}
+
+			if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+				rtmpGroupSettings.InputLossAction = aws.String(v)
+			}
+
+			if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+				rtmpGroupSettings.RestartDelay = aws.Int64(int64(v))
+			}
+		}
+
+		apiObject.RtmpGroupSettings = rtmpGroupSettings
+	}
+
+	if v, ok := tfMap["udp_group_settings"].([]interface{}); ok && len(v) > 0 {
+		udpGroupSettings := &medialive.UdpGroupSettings{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+				udpGroupSettings.InputLossAction = aws.String(v)
+			}
+
+			if v, ok := tfMap["timed_metadata_id3_frame"].(string); ok && v != "" {
+				udpGroupSettings.TimedMetadataId3Frame = aws.String(v)
+			}
+
+			if v, ok := tfMap["timed_metadata_id3_period"].(int); ok && v != 0 {
+				udpGroupSettings.TimedMetadataId3Period = aws.Int64(int64(v))
+			}
+		}
+
+		apiObject.UdpGroupSettings = udpGroupSettings
+	}
+
+	return apiObject
+}
+
+func expandOutputs(tfList []interface{}) []*medialive.Output {
+	apiObjects := []*medialive.Output{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.Output{
+			OutputSettings: expandOutputSettings(tfMap["output_settings"].([]interface{})),
+		}
+
+		if v, ok := tfMap["audio_description_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AudioDescriptionNames = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["caption_description_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.CaptionDescriptionNames = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["output_name"].(string); ok && v != "" {
+			apiObject.OutputName = aws.String(v)
+		}
+
+		if v, ok := tfMap["video_description_name"].(string); ok && v != "" {
+			apiObject.VideoDescriptionName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputSettings(tfList []interface{}) *medialive.OutputSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &medialive.OutputSettings{}
+
+	if v, ok := tfMap["archive_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		archiveOutputSettings := &medialive.ArchiveOutputSettings{
+			ContainerSettings: &medialive.ArchiveContainerSettings{},
+		}
+
+		if v, ok := tfMap["container_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			archiveOutputSettings.ContainerSettings.M2tsSettings = expandM2tsSettings(v[0].(map[string]interface{})["m2ts_settings"].([]interface{}))
+		}
+
+		if v, ok := tfMap["extension"].(string); ok && v != "" {
+			archiveOutputSettings.Extension = aws.String(v)
+		}
+
+		if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+			archiveOutputSettings.NameModifier = aws.String(v)
+		}
+
+		apiObject.ArchiveOutputSettings = archiveOutputSettings
+	}
+
+	if v, ok := tfMap["frame_capture_output_settings"].([]interface{}); ok && len(v) > 0 {
+		frameCaptureOutputSettings := &medialive.FrameCaptureOutputSettings{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+				frameCaptureOutputSettings.NameModifier = aws.String(v)
+			}
+		}
+
+		apiObject.FrameCaptureOutputSettings = frameCaptureOutputSettings
+	}
+
+	if v, ok := tfMap["hls_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		hlsOutputSettings := &medialive.HlsOutputSettings{
+			HlsSettings: &medialive.HlsSettings{},
+		}
+
+		if v, ok := tfMap["hls_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["standard_hls_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				standardHlsSettings := &medialive.StandardHlsSettings{
+					M3u8Settings: expandM3u8Settings(tfMap["m3u8_settings"].([]interface{})),
+				}
+
+				if v, ok := tfMap["audio_rendition_sets"].(string); ok && v != "" {
+					standardHlsSettings.AudioRenditionSets = aws.String(v)
+				}
+
+				hlsOutputSettings.HlsSettings.StandardHlsSettings = standardHlsSettings
+			}
+		}
+
+		if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+			hlsOutputSettings.NameModifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["segment_modifier"].(string); ok && v != "" {
+			hlsOutputSettings.SegmentModifier = aws.String(v)
+		}
+
+		apiObject.HlsOutputSettings = hlsOutputSettings
+	}
+
+	if v, ok := tfMap["media_package_output_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaPackageOutputSettings = &medialive.MediaPackageOutputSettings{}
+	}
+
+	if v, ok := tfMap["rtmp_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		rtmpOutputSettings := &medialive.RtmpOutputSettings{
+			Destination: expandOutputLocationRef(tfMap["destination"].([]interface{})),
+		}
+
+		if v, ok := tfMap["certificate_mode"].(string); ok && v != "" {
+			rtmpOutputSettings.CertificateMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+			rtmpOutputSettings.ConnectionRetryInterval = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+			rtmpOutputSettings.NumRetries = aws.Int64(int64(v))
+		}
+
+		apiObject.RtmpOutputSettings = rtmpOutputSettings
+	}
+
+	if v, ok := tfMap["udp_output_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		udpOutputSettings := &medialive.UdpOutputSettings{
+			ContainerSettings: &medialive.UdpContainerSettings{},
+			Destination:       expandOutputLocationRef(tfMap["destination"].([]interface{})),
+		}
+
+		if v, ok := tfMap["buffer_msec"].(int); ok && v != 0 {
+			udpOutputSettings.BufferMsec = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["container_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			udpOutputSettings.ContainerSettings.M2tsSettings = expandM2tsSettings(v[0].(map[string]interface{})["m2ts_settings"].([]interface{}))
+		}
+
+		apiObject.UdpOutputSettings = udpOutputSettings
+	}
+
+	return apiObject
+}
+
+func expandOutputLocationRef(tfList []interface{}) *medialive.OutputLocationRef {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &medialive.OutputLocationRef{
+		DestinationRefId: aws.String(tfMap["destination_ref_id"].(string)),
+	}
+}
+
+func expandM2tsSettings(tfList []interface{}) *medialive.M2tsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.M2tsSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["audio_frames_per_pes"].(int); ok && v != 0 {
+		apiObject.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["audio_pids"].(string); ok && v != "" {
+		apiObject.AudioPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["buffer_model"].(string); ok && v != "" {
+		apiObject.BufferModel = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_control"].(string); ok && v != "" {
+		apiObject.PcrControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["pmt_pid"].(string); ok && v != "" {
+		apiObject.PmtPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_num"].(int); ok && v != 0 {
+		apiObject.ProgramNum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rate_mode"].(string); ok && v != "" {
+		apiObject.RateMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_control"].(string); ok && v != "" {
+		apiObject.Scte35Control = aws.String(v)
+	}
+
+	if v, ok := tfMap["transport_stream_id"].(int); ok && v != 0 {
+		apiObject.TransportStreamId = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["video_pid"].(string); ok && v != "" {
+		apiObject.VideoPid = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandM3u8Settings(tfList []interface{}) *medialive.M3u8Settings {
+	apiObject := &medialive.M3u8Settings{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["audio_frames_per_pes"].(int); ok && v != 0 {
+		apiObject.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["audio_pids"].(string); ok && v != "" {
+		apiObject.AudioPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_control"].(string); ok && v != "" {
+		apiObject.PcrControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["pmt_pid"].(string); ok && v != "" {
+		apiObject.PmtPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_num"].(int); ok && v != 0 {
+		apiObject.ProgramNum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scte35_behavior"].(string); ok && v != "" {
+		apiObject.Scte35Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_behavior"].(string); ok && v != "" {
+		apiObject.TimedMetadataBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["video_pid"].(string); ok && v != "" {
+		apiObject.VideoPid = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandVideoDescriptions(tfList []interface{}) []*medialive.VideoDescription {
+	apiObjects := []*medialive.VideoDescription{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.VideoDescription{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["codec_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CodecSettings = expandVideoCodecSettings(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["height"].(int); ok && v != 0 {
+			apiObject.Height = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["respond_to_afd"].(string); ok && v != "" {
+			apiObject.RespondToAfd = aws.String(v)
+		}
+
+		if v, ok := tfMap["scaling_behavior"].(string); ok && v != "" {
+			apiObject.ScalingBehavior = aws.String(v)
+		}
+
+		if v, ok := tfMap["sharpness"].(int); ok && v != 0 {
+			apiObject.Sharpness = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["width"].(int); ok && v != 0 {
+			apiObject.Width = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVideoCodecSettings(tfMap map[string]interface{}) *medialive.VideoCodecSettings {
+	apiObject := &medialive.VideoCodecSettings{}
+
+	if v, ok := tfMap["frame_capture_settings"].([]interface{}); ok && len(v) > 0 {
+		frameCaptureSettings := &medialive.FrameCaptureSettings{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["capture_interval"].(int); ok && v != 0 {
+				frameCaptureSettings.CaptureInterval = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["capture_interval_units"].(string); ok && v != "" {
+				frameCaptureSettings.CaptureIntervalUnits = aws.String(v)
+			}
+		}
+
+		apiObject.FrameCaptureSettings = frameCaptureSettings
+	}
+
+	if v, ok := tfMap["h264_settings"].([]interface{}); ok && len(v) > 0 {
+		h264Settings := &medialive.H264Settings{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["adaptive_quantization"].(string); ok && v != "" {
+				h264Settings.AdaptiveQuantization = aws.String(v)
+			}
+
+			if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+				h264Settings.Bitrate = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["buf_size"].(int); ok && v != 0 {
+				h264Settings.BufSize = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["entropy_encoding"].(string); ok && v != "" {
+				h264Settings.EntropyEncoding = aws.String(v)
+			}
+
+			if v, ok := tfMap["framerate_control"].(string); ok && v != "" {
+				h264Settings.FramerateControl = aws.String(v)
+			}
+
+			if v, ok := tfMap["framerate_denominator"].(int); ok && v != 0 {
+				h264Settings.FramerateDenominator = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["framerate_numerator"].(int); ok && v != 0 {
+				h264Settings.FramerateNumerator = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["gop_size"].(float64); ok && v != 0 {
+				h264Settings.GopSize = aws.Float64(v)
+			}
+
+			if v, ok := tfMap["gop_size_units"].(string); ok && v != "" {
+				h264Settings.GopSizeUnits = aws.String(v)
+			}
+
+			if v, ok := tfMap["level"].(string); ok && v != "" {
+				h264Settings.Level = aws.String(v)
+			}
+
+			if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+				h264Settings.MaxBitrate = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["par_control"].(string); ok && v != "" {
+				h264Settings.ParControl = aws.String(v)
+			}
+
+			if v, ok := tfMap["profile"].(string); ok && v != "" {
+				h264Settings.Profile = aws.String(v)
+			}
+
+			if v, ok := tfMap["qvbr_quality_level"].(int); ok && v != 0 {
+				h264Settings.QvbrQualityLevel = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["rate_control_mode"].(string); ok && v != "" {
+				h264Settings.RateControlMode = aws.String(v)
+			}
+
+			if v, ok := tfMap["scene_change_detect"].(string); ok && v != "" {
+				h264Settings.SceneChangeDetect = aws.String(v)
+			}
+		}
+
+		apiObject.H264Settings = h264Settings
+	}
+
+	return apiObject
+}
+
+func flattenEncoderSettings(apiObject *medialive.EncoderSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_descriptions": flattenAudioDescriptions(apiObject.AudioDescriptions),
+		"output_groups":      flattenOutputGroups(apiObject.OutputGroups),
+		"video_descriptions": flattenVideoDescriptions(apiObject.VideoDescriptions),
+	}
+
+	if v := apiObject.TimecodeConfig; v != nil {
+		tfMap["timecode_config"] = []interface{}{map[string]interface{}{
+			"source":         aws.StringValue(v.Source),
+			"sync_threshold": aws.Int64Value(v.SyncThreshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAudioDescriptions(apiObjects []*medialive.AudioDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"audio_selector_name":   aws.StringValue(apiObject.AudioSelectorName),
+			"audio_type_control":    aws.StringValue(apiObject.AudioTypeControl),
+			"language_code":         aws.StringValue(apiObject.LanguageCode),
+			"language_code_control": aws.StringValue(apiObject.LanguageCodeControl),
+			"name":                  aws.StringValue(apiObject.Name),
+			"stream_name":           aws.StringValue(apiObject.StreamName),
+		}
+
+		if v := apiObject.CodecSettings; v != nil {
+			tfMap["codec_settings"] = flattenAudioCodecSettings(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAudioCodecSettings(apiObject *medialive.AudioCodecSettings) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AacSettings; v != nil {
+		tfMap["aac_settings"] = []interface{}{map[string]interface{}{
+			"bitrate":           aws.Float64Value(v.Bitrate),
+			"coding_mode":       aws.StringValue(v.CodingMode),
+			"input_type":        aws.StringValue(v.InputType),
+			"profile":           aws.StringValue(v.Profile),
+			"rate_control_mode": aws.StringValue(v.RateControlMode),
+			"raw_format":        aws.StringValue(v.RawFormat),
+			"sample_rate":       aws.Float64Value(v.SampleRate),
+			"spec":              aws.StringValue(v.Spec),
+		}}
+	}
+
+	if v := apiObject.Ac3Settings; v != nil {
+		tfMap["ac3_settings"] = []interface{}{map[string]interface{}{
+			"bitrate":          aws.Float64Value(v.Bitrate),
+			"bitstream_mode":   aws.StringValue(v.BitstreamMode),
+			"coding_mode":      aws.StringValue(v.CodingMode),
+			"dialnorm":         aws.Int64Value(v.Dialnorm),
+			"drc_profile":      aws.StringValue(v.DrcProfile),
+			"lfe_filter":       aws.StringValue(v.LfeFilter),
+			"metadata_control": aws.StringValue(v.MetadataControl),
+		}}
+	}
+
+	if apiObject.PassThroughSettings != nil {
+		tfMap["pass_through_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOutputGroups(apiObjects []*medialive.OutputGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                  aws.StringValue(apiObject.Name),
+			"output_group_settings": flattenOutputGroupSettings(apiObject.OutputGroupSettings),
+			"outputs":               flattenOutputs(apiObject.Outputs),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputGroupSettings(apiObject *medialive.OutputGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ArchiveGroupSettings; v != nil {
+		tfMap["archive_group_settings"] = []interface{}{map[string]interface{}{
+			"destination":       flattenOutputLocationRef(v.Destination),
+			"rollover_interval": aws.Int64Value(v.RolloverInterval),
+		}}
+	}
+
+	if v := apiObject.FrameCaptureGroupSettings; v != nil {
+		tfMap["frame_capture_group_settings"] = []interface{}{map[string]interface{}{
+			"destination": flattenOutputLocationRef(v.Destination),
+		}}
+	}
+
+	if v := apiObject.HlsGroupSettings; v != nil {
+		tfMap["hls_group_settings"] = []interface{}{map[string]interface{}{
+			"destination":               flattenOutputLocationRef(v.Destination),
+			"directory_structure":       aws.StringValue(v.DirectoryStructure),
+			"input_loss_action":         aws.StringValue(v.InputLossAction),
+			"keep_segments":             aws.Int64Value(v.KeepSegments),
+			"manifest_compression":      aws.StringValue(v.ManifestCompression),
+			"mode":                      aws.StringValue(v.Mode),
+			"segment_length":            aws.Int64Value(v.SegmentLength),
+			"segments_per_subdirectory": aws.Int64Value(v.SegmentsPerSubdirectory),
+		}}
+	}
+
+	if v := apiObject.MediaPackageGroupSettings; v != nil {
+		tfMap["media_package_group_settings"] = []interface{}{map[string]interface{}{
+			"destination": flattenOutputLocationRef(v.Destination),
+		}}
+	}
+
+	if v := apiObject.RtmpGroupSettings; v != nil {
+		tfMap["rtmp_group_settings"] = []interface{}{map[string]interface{}{
+			"authentication_scheme": aws.StringValue(v.AuthenticationScheme),
+			"cache_full_behavior":   aws.StringValue(v.CacheFullBehavior),
+			"cache_length":          aws.Int64Value(v.CacheLength),
+			"caption_data":          aws.StringValue(v.CaptionData),
+			"input_loss_action":     aws.StringValue(v.InputLossAction),
+			"restart_delay":         aws.Int64Value(v.RestartDelay),
+		}}
+	}
+
+	if v := apiObject.UdpGroupSettings; v != nil {
+		tfMap["udp_group_settings"] = []interface{}{map[string]interface{}{
+			"input_loss_action":         aws.StringValue(v.InputLossAction),
+			"timed_metadata_id3_frame":  aws.StringValue(v.TimedMetadataId3Frame),
+			"timed_metadata_id3_period": aws.Int64Value(v.TimedMetadataId3Period),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOutputs(apiObjects []*medialive.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"audio_description_names":   aws.StringValueSlice(apiObject.AudioDescriptionNames),
+			"caption_description_names": aws.StringValueSlice(apiObject.CaptionDescriptionNames),
+			"output_name":               aws.StringValue(apiObject.OutputName),
+			"output_settings":           flattenOutputSettings(apiObject.OutputSettings),
+			"video_description_name":    aws.StringValue(apiObject.VideoDescriptionName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSettings(apiObject *medialive.OutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ArchiveOutputSettings; v != nil {
+		archiveOutputSettings := map[string]interface{}{
+			"extension":     aws.StringValue(v.Extension),
+			"name_modifier": aws.StringValue(v.NameModifier),
+		}
+
+		if v := v.ContainerSettings; v != nil {
+			archiveOutputSettings["container_settings"] = []interface{}{map[string]interface{}{
+				"m2ts_settings": flattenM2tsSettings(v.M2tsSettings),
+			}}
+		}
+
+		tfMap["archive_output_settings"] = []interface{}{archiveOutputSettings}
+	}
+
+	if v := apiObject.FrameCaptureOutputSettings; v != nil {
+		tfMap["frame_capture_output_settings"] = []interface{}{map[string]interface{}{
+			"name_modifier": aws.StringValue(v.NameModifier),
+		}}
+	}
+
+	if v := apiObject.HlsOutputSettings; v != nil {
+		hlsOutputSettings := map[string]interface{}{
+			"name_modifier":    aws.StringValue(v.NameModifier),
+			"segment_modifier": aws.StringValue(v.SegmentModifier),
+		}
+
+		if v := v.HlsSettings; v != nil {
+			hlsSettings := map[string]interface{}{}
+
+			if v := v.StandardHlsSettings; v != nil {
+				hlsSettings["standard_hls_settings"] = []interface{}{map[string]interface{}{
+					"audio_rendition_sets": aws.StringValue(v.AudioRenditionSets),
+					"m3u8_settings":        flattenM3u8Settings(v.M3u8Settings),
+				}}
+			}
+
+			hlsOutputSettings["hls_settings"] = []interface{}{hlsSettings}
+		}
+
+		tfMap["hls_output_settings"] = []interface{}{hlsOutputSettings}
+	}
+
+	if apiObject.MediaPackageOutputSettings != nil {
+		tfMap["media_package_output_settings"] = []interface{}{map[string]interface{}{}}
+	}
+
+	if v := apiObject.RtmpOutputSettings; v != nil {
+		tfMap["rtmp_output_settings"] = []interface{}{map[string]interface{}{
+			"certificate_mode":          aws.StringValue(v.CertificateMode),
+			"connection_retry_interval": aws.Int64Value(v.ConnectionRetryInterval),
+			"destination":               flattenOutputLocationRef(v.Destination),
+			"num_retries":               aws.Int64Value(v.NumRetries),
+		}}
+	}
+
+	if v := apiObject.UdpOutputSettings; v != nil {
+		udpOutputSettings := map[string]interface{}{
+			"buffer_msec": aws.Int64Value(v.BufferMsec),
+			"destination": flattenOutputLocationRef(v.Destination),
+		}
+
+		if v := v.ContainerSettings; v != nil {
+			udpOutputSettings["container_settings"] = []interface{}{map[string]interface{}{
+				"m2ts_settings": flattenM2tsSettings(v.M2tsSettings),
+			}}
+		}
+
+		tfMap["udp_output_settings"] = []interface{}{udpOutputSettings}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOutputLocationRef(apiObject *medialive.OutputLocationRef) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"destination_ref_id": aws.StringValue(apiObject.DestinationRefId),
+	}}
+}
+
+func flattenM2tsSettings(apiObject *medialive.M2tsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"audio_frames_per_pes": aws.Int64Value(apiObject.AudioFramesPerPes),
+		"audio_pids":           aws.StringValue(apiObject.AudioPids),
+		"bitrate":              aws.Int64Value(apiObject.Bitrate),
+		"buffer_model":         aws.StringValue(apiObject.BufferModel),
+		"pcr_control":          aws.StringValue(apiObject.PcrControl),
+		"pmt_pid":              aws.StringValue(apiObject.PmtPid),
+		"program_num":          aws.Int64Value(apiObject.ProgramNum),
+		"rate_mode":            aws.StringValue(apiObject.RateMode),
+		"scte35_control":       aws.StringValue(apiObject.Scte35Control),
+		"transport_stream_id":  aws.Int64Value(apiObject.TransportStreamId),
+		"video_pid":            aws.StringValue(apiObject.VideoPid),
+	}}
+}
+
+func flattenM3u8Settings(apiObject *medialive.M3u8Settings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"audio_frames_per_pes":    aws.Int64Value(apiObject.AudioFramesPerPes),
+		"audio_pids":              aws.StringValue(apiObject.AudioPids),
+		"pcr_control":             aws.StringValue(apiObject.PcrControl),
+		"pmt_pid":                 aws.StringValue(apiObject.PmtPid),
+		"program_num":             aws.Int64Value(apiObject.ProgramNum),
+		"scte35_behavior":         aws.StringValue(apiObject.Scte35Behavior),
+		"timed_metadata_behavior": aws.StringValue(apiObject.TimedMetadataBehavior),
+		"video_pid":               aws.StringValue(apiObject.VideoPid),
+	}}
+}
+
+func flattenVideoDescriptions(apiObjects []*medialive.VideoDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"height":           aws.Int64Value(apiObject.Height),
+			"name":             aws.StringValue(apiObject.Name),
+			"respond_to_afd":   aws.StringValue(apiObject.RespondToAfd),
+			"scaling_behavior": aws.StringValue(apiObject.ScalingBehavior),
+			"sharpness":        aws.Int64Value(apiObject.Sharpness),
+			"width":            aws.Int64Value(apiObject.Width),
+		}
+
+		if v := apiObject.CodecSettings; v != nil {
+			codecSettings := map[string]interface{}{}
+
+			if v := v.FrameCaptureSettings; v != nil {
+				codecSettings["frame_capture_settings"] = []interface{}{map[string]interface{}{
+					"capture_interval":       aws.Int64Value(v.CaptureInterval),
+					"capture_interval_units": aws.StringValue(v.CaptureIntervalUnits),
+				}}
+			}
+
+			if v := v.H264Settings; v != nil {
+				codecSettings["h264_settings"] = []interface{}{map[string]interface{}{
+					"adaptive_quantization": aws.StringValue(v.AdaptiveQuantization),
+					"bitrate":               aws.Int64Value(v.Bitrate),
+					"buf_size":              aws.Int64Value(v.BufSize),
+					"entropy_encoding":      aws.StringValue(v.EntropyEncoding),
+					"framerate_control":     aws.StringValue(v.FramerateControl),
+					"framerate_denominator": aws.Int64Value(v.FramerateDenominator),
+					"framerate_numerator":   aws.Int64Value(v.FramerateNumerator),
+					"gop_size":              aws.Float64Value(v.GopSize),
+					"gop_size_units":        aws.StringValue(v.GopSizeUnits),
+					"level":                 aws.StringValue(v.Level),
+					"max_bitrate":           aws.Int64Value(v.MaxBitrate),
+					"par_control":           aws.StringValue(v.ParControl),
+					"profile":               aws.StringValue(v.Profile),
+					"qvbr_quality_level":    aws.Int64Value(v.QvbrQualityLevel),
+					"rate_control_mode":     aws.StringValue(v.RateControlMode),
+					"scene_change_detect":   aws.StringValue(v.SceneChangeDetect),
+				}}
+			}
+
+			tfMap["codec_settings"] = []interface{}{codecSettings}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,424 @@
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaLiveChannel_basic(t *testing.T) {
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.audio_descriptions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.output_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.output_groups.0.output_group_settings.0.archive_group_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.timecode_config.0.source", "EMBEDDED"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.video_descriptions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.video_descriptions.0.codec_settings.0.h264_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_attachments.0.input_id", "aws_medialive_input.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", "AVC"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_channel"},
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_disappears(t *testing.T) {
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_tags(t *testing.T) {
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_channel"},
+			},
+			{
+				Config: testAccChannelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccChannelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_update(t *testing.T) {
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := fmt.Sprintf("%s-updated", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_update(rName, rName, "AVC", "HD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", "AVC"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.input_resolution", "HD"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccChannelConfig_update(rName, rNameUpdated, "HEVC", "UHD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", "HEVC"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.input_resolution", "UHD"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_start(t *testing.T) {
+	resourceName := "aws_medialive_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_start(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					testAccCheckChannelState(resourceName, medialive.ChannelStateRunning),
+				),
+			},
+			{
+				Config: testAccChannelConfig_start(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName),
+					testAccCheckChannelState(resourceName, medialive.ChannelStateIdle),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		_, err := tfmedialive.FindChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		_, err := tfmedialive.FindChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckChannelState(n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		output, err := tfmedialive.FindChannelByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.State); got != want {
+			return fmt.Errorf("MediaLive Channel (%s) state: got %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccChannelBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "medialive.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:GetObject",
+        "s3:DeleteObject",
+      ]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.8/32"
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+  type                  = "UDP_PUSH"
+}
+`, rName)
+}
+
+func testAccChannelResourceConfig(rName, name, codec, resolution string, start bool, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[2]q
+  channel_class = "SINGLE_PIPELINE"
+  role_arn      = aws_iam_role.test.arn
+  start_channel = %[5]t
+
+  input_specification {
+    codec            = %[3]q
+    input_resolution = %[4]q
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "example-input"
+    input_id              = aws_medialive_input.test.id
+  }
+
+  destinations {
+    id = "destination"
+
+    settings {
+      url = "s3://${aws_s3_bucket.test.id}/%[1]s/archive"
+    }
+  }
+
+  encoder_settings {
+    timecode_config {
+      source = "EMBEDDED"
+    }
+
+    audio_descriptions {
+      audio_selector_name = "example-audio"
+      name                = "audio"
+    }
+
+    video_descriptions {
+      name = "video"
+
+      codec_settings {
+        h264_settings {
+          bitrate = 5000000
+        }
+      }
+    }
+
+    output_groups {
+      output_group_settings {
+        archive_group_settings {
+          destination {
+            destination_ref_id = "destination"
+          }
+        }
+      }
+
+      outputs {
+        output_name             = "output"
+        video_description_name  = "video"
+        audio_description_names = ["audio"]
+
+        output_settings {
+          archive_output_settings {
+            name_modifier = "_1"
+            extension     = "m2ts"
+
+            container_settings {
+              m2ts_settings {
+                rate_mode = "CBR"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+%[6]s
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, name, codec, resolution, start, tags)
+}
+
+func testAccChannelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, rName, "AVC", "HD", false, ""))
+}
+
+func testAccChannelConfig_update(rName, name, codec, resolution string) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, name, codec, resolution, false, ""))
+}
+
+func testAccChannelConfig_start(rName string, start bool) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, rName, "AVC", "HD", start, ""))
+}
+
+func testAccChannelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, rName, "AVC", "HD", false, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1)))
+}
+
+func testAccChannelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelBaseConfig(rName), testAccChannelResourceConfig(rName, rName, "AVC", "HD", false, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2)))
+}
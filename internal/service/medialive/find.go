@@ -0,0 +1,139 @@
+package medialive
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindChannelByID(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	input := &medialive.DescribeChannelInput{
+		ChannelId: aws.String(id),
+	}
+
+	output, err := conn.DescribeChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.ChannelStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindInputByID(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	input := &medialive.DescribeInputInput{
+		InputId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInputWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.InputStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindInputSecurityGroupByID(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	input := &medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInputSecurityGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.InputSecurityGroupStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindMultiplexByID(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeMultiplexOutput, error) {
+	input := &medialive.DescribeMultiplexInput{
+		MultiplexId: aws.String(id),
+	}
+
+	output, err := conn.DescribeMultiplexWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == medialive.MultiplexStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
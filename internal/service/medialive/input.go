@@ -0,0 +1,500 @@
+package medialive
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputCreate,
+		ReadWithoutTimeout:   resourceInputRead,
+		UpdateWithoutTimeout: resourceInputUpdate,
+		DeleteWithoutTimeout: resourceInputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_channels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_devices": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_partner_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_connect_flows": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(medialive.InputType_Values(), false),
+			},
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateInputInput{
+		Name:      aws.String(name),
+		RequestId: aws.String(resource.UniqueId()),
+		Type:      aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("destination"); ok && len(v.([]interface{})) > 0 {
+		input.Destinations = expandInputDestinationRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_devices"); ok && v.(*schema.Set).Len() > 0 {
+		for _, id := range flex.ExpandStringSet(v.(*schema.Set)) {
+			input.InputDevices = append(input.InputDevices, &medialive.InputDeviceSettings{Id: id})
+		}
+	}
+
+	if v, ok := d.GetOk("input_security_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.InputSecurityGroups = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("media_connect_flows"); ok && v.(*schema.Set).Len() > 0 {
+		input.MediaConnectFlows = expandMediaConnectFlowRequests(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source"); ok && len(v.([]interface{})) > 0 {
+		input.Sources = expandInputSourceRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.Vpc = &medialive.InputVpcRequest{
+			SubnetIds: flex.ExpandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+			input.Vpc.SecurityGroupIds = flex.ExpandStringSet(v)
+		}
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input: %s", input)
+	output, err := conn.CreateInputWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MediaLive Input (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Input.Id))
+
+	if _, err := waitInputCreated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for MediaLive Input (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceInputRead(ctx, d, meta)
+}
+
+func resourceInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindInputByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("attached_channels", aws.StringValueSlice(output.AttachedChannels))
+	if err := d.Set("destination", flattenInputDestinations(output.Destinations)); err != nil {
+		return diag.Errorf("error setting destination: %s", err)
+	}
+	d.Set("input_class", output.InputClass)
+	var inputDevices []string
+	for _, v := range output.InputDevices {
+		inputDevices = append(inputDevices, aws.StringValue(v.Id))
+	}
+	d.Set("input_devices", inputDevices)
+	d.Set("input_partner_ids", aws.StringValueSlice(output.InputPartnerIds))
+	d.Set("input_security_groups", aws.StringValueSlice(output.SecurityGroups))
+	d.Set("input_source_type", output.InputSourceType)
+	var mediaConnectFlows []string
+	for _, v := range output.MediaConnectFlows {
+		mediaConnectFlows = append(mediaConnectFlows, aws.StringValue(v.FlowArn))
+	}
+	d.Set("media_connect_flows", mediaConnectFlows)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("source", flattenInputSources(output.Sources)); err != nil {
+		return diag.Errorf("error setting source: %s", err)
+	}
+	d.Set("type", output.Type)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &medialive.UpdateInputInput{
+			InputId: aws.String(d.Id()),
+			Name:    aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("destination") {
+			input.Destinations = expandInputDestinationRequests(d.Get("destination").([]interface{}))
+		}
+
+		if d.HasChange("input_devices") {
+			input.InputDevices = []*medialive.InputDeviceRequest{}
+
+			for _, id := range flex.ExpandStringSet(d.Get("input_devices").(*schema.Set)) {
+				input.InputDevices = append(input.InputDevices, &medialive.InputDeviceRequest{Id: id})
+			}
+		}
+
+		if d.HasChange("input_security_groups") {
+			input.InputSecurityGroups = flex.ExpandStringSet(d.Get("input_security_groups").(*schema.Set))
+		}
+
+		if d.HasChange("media_connect_flows") {
+			input.MediaConnectFlows = expandMediaConnectFlowRequests(d.Get("media_connect_flows").(*schema.Set).List())
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source") {
+			input.Sources = expandInputSourceRequests(d.Get("source").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input: %s", input)
+		_, err := conn.UpdateInputWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating MediaLive Input (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MediaLive Input (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceInputRead(ctx, d, meta)
+}
+
+func resourceInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input: %s", d.Id())
+	_, err := conn.DeleteInputWithContext(ctx, &medialive.DeleteInputInput{
+		InputId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInputDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for MediaLive Input (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputDestinationRequests(tfList []interface{}) []*medialive.InputDestinationRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*medialive.InputDestinationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputDestinationRequest{}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInputSourceRequests(tfList []interface{}) []*medialive.InputSourceRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*medialive.InputSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputSourceRequest{
+			Url: aws.String(tfMap["url"].(string)),
+		}
+
+		if v, ok := tfMap["password_param"].(string); ok && v != "" {
+			apiObject.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			apiObject.Username = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaConnectFlowRequests(tfList []interface{}) []*medialive.MediaConnectFlowRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*medialive.MediaConnectFlowRequest
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, &medialive.MediaConnectFlowRequest{
+			FlowArn: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenInputDestinations(apiObjects []*medialive.InputDestination) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"ip":   aws.StringValue(apiObject.Ip),
+			"port": aws.StringValue(apiObject.Port),
+			"url":  aws.StringValue(apiObject.Url),
+		}
+
+		// The stream name of an RTMP push destination is the path of its URL, e.g. rtmp://198.51.100.1:1935/live/stream.
+		if u, err := url.Parse(aws.StringValue(apiObject.Url)); err == nil {
+			tfMap["stream_name"] = strings.TrimPrefix(u.Path, "/")
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenInputSources(apiObjects []*medialive.InputSource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"password_param": aws.StringValue(apiObject.PasswordParam),
+			"url":            aws.StringValue(apiObject.Url),
+			"username":       aws.StringValue(apiObject.Username),
+		})
+	}
+
+	return tfList
+}
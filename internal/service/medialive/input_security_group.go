@@ -0,0 +1,217 @@
+package medialive
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInputSecurityGroupCreate,
+		ReadWithoutTimeout:   resourceInputSecurityGroupRead,
+		UpdateWithoutTimeout: resourceInputSecurityGroupUpdate,
+		DeleteWithoutTimeout: resourceInputSecurityGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"whitelist_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceInputSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandInputWhitelistRuleCidrs(d.Get("whitelist_rule").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	output, err := conn.CreateInputSecurityGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroup.Id))
+
+	return resourceInputSecurityGroupRead(ctx, d, meta)
+}
+
+func resourceInputSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindInputSecurityGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("inputs", aws.StringValueSlice(output.Inputs))
+	if err := d.Set("whitelist_rule", flattenInputWhitelistRules(output.WhitelistRules)); err != nil {
+		return diag.Errorf("error setting whitelist_rule: %s", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceInputSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChange("whitelist_rule") {
+		input := &medialive.UpdateInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(d.Id()),
+			WhitelistRules:       expandInputWhitelistRuleCidrs(d.Get("whitelist_rule").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+		_, err := conn.UpdateInputSecurityGroupWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating MediaLive Input Security Group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInputSecurityGroupUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for MediaLive Input Security Group (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MediaLive Input Security Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceInputSecurityGroupRead(ctx, d, meta)
+}
+
+func resourceInputSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	_, err := conn.DeleteInputSecurityGroupWithContext(ctx, &medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInputSecurityGroupDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for MediaLive Input Security Group (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputWhitelistRuleCidrs(tfList []interface{}) []*medialive.InputWhitelistRuleCidr {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*medialive.InputWhitelistRuleCidr
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(tfMap["cidr"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenInputWhitelistRules(apiObjects []*medialive.InputWhitelistRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cidr": aws.StringValue(apiObject.Cidr),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,248 @@
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaLiveInputSecurityGroup_basic(t *testing.T) {
+	resourceName := "aws_medialive_input_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputSecurityGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`inputSecurityGroup:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "whitelist_rule.*", map[string]string{
+						"cidr": "10.0.0.8/32",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInputSecurityGroup_disappears(t *testing.T) {
+	resourceName := "aws_medialive_input_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputSecurityGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceInputSecurityGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInputSecurityGroup_tags(t *testing.T) {
+	resourceName := "aws_medialive_input_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputSecurityGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInputSecurityGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccInputSecurityGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInputSecurityGroup_whitelistRules(t *testing.T) {
+	resourceName := "aws_medialive_input_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputSecurityGroupConfig_whitelistRules(rName, "10.0.0.8/32", "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "whitelist_rule.*", map[string]string{
+						"cidr": "10.0.0.8/32",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "whitelist_rule.*", map[string]string{
+						"cidr": "10.2.0.0/16",
+					}),
+				),
+			},
+			{
+				Config: testAccInputSecurityGroupConfig_whitelistRules(rName, "10.0.0.8/32", "10.3.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputSecurityGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "whitelist_rule.*", map[string]string{
+						"cidr": "10.3.0.0/16",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInputSecurityGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input_security_group" {
+			continue
+		}
+
+		_, err := tfmedialive.FindInputSecurityGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Input Security Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckInputSecurityGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input Security Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		_, err := tfmedialive.FindInputSecurityGroupByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccInputSecurityGroupConfig_basic(rName string) string {
+	return `
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.8/32"
+  }
+}
+`
+}
+
+func testAccInputSecurityGroupConfig_whitelistRules(rName, cidr1, cidr2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = %[1]q
+  }
+
+  whitelist_rule {
+    cidr = %[2]q
+  }
+}
+`, cidr1, cidr2)
+}
+
+func testAccInputSecurityGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.8/32"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccInputSecurityGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.8/32"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,308 @@
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaLiveInput_basic(t *testing.T) {
+	resourceName := "aws_medialive_input.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`input:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attached_channels.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_class", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "input_security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "UDP_PUSH"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInput_disappears(t *testing.T) {
+	resourceName := "aws_medialive_input.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceInput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInput_tags(t *testing.T) {
+	resourceName := "aws_medialive_input.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInputConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccInputConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInput_rtmpPush(t *testing.T) {
+	resourceName := "aws_medialive_input.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_rtmpPush(rName, "live"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.stream_name", "live/a"),
+					resource.TestCheckResourceAttr(resourceName, "destination.1.stream_name", "live/b"),
+					resource.TestCheckResourceAttr(resourceName, "type", "RTMP_PUSH"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInputConfig_rtmpPush(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.stream_name", "updated/a"),
+					resource.TestCheckResourceAttr(resourceName, "destination.1.stream_name", "updated/b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveInput_urlPull(t *testing.T) {
+	resourceName := "aws_medialive_input.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_urlPull(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInputExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.url", fmt.Sprintf("https://example.com/%s/a/index.m3u8", rName)),
+					resource.TestCheckResourceAttr(resourceName, "type", "URL_PULL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInputDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input" {
+			continue
+		}
+
+		_, err := tfmedialive.FindInputByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Input %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckInputExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		_, err := tfmedialive.FindInputByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccInputBaseConfig() string {
+	return `
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.8/32"
+  }
+}
+`
+}
+
+func testAccInputConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInputBaseConfig(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+  type                  = "UDP_PUSH"
+}
+`, rName))
+}
+
+func testAccInputConfig_rtmpPush(rName, streamName string) string {
+	return acctest.ConfigCompose(testAccInputBaseConfig(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+  type                  = "RTMP_PUSH"
+
+  destination {
+    stream_name = "%[2]s/a"
+  }
+
+  destination {
+    stream_name = "%[2]s/b"
+  }
+}
+`, rName, streamName))
+}
+
+func testAccInputConfig_urlPull(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  source {
+    url = "https://example.com/%[1]s/a/index.m3u8"
+  }
+
+  source {
+    url = "https://example.com/%[1]s/b/index.m3u8"
+  }
+}
+`, rName)
+}
+
+func testAccInputConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccInputBaseConfig(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+  type                  = "UDP_PUSH"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccInputConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccInputBaseConfig(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+  type                  = "UDP_PUSH"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
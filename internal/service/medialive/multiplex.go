@@ -0,0 +1,328 @@
+package medialive
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMultiplex() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiplexCreate,
+		ReadWithoutTimeout:   resourceMultiplexRead,
+		UpdateWithoutTimeout: resourceMultiplexUpdate,
+		DeleteWithoutTimeout: resourceMultiplexDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"multiplex_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_video_buffer_delay_milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(800, 3000),
+						},
+						"transport_stream_bitrate": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1000000, 100000000),
+						},
+						"transport_stream_id": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"transport_stream_reserved_bitrate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100000000),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_multiplex": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceMultiplexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateMultiplexInput{
+		AvailabilityZones: flex.ExpandStringList(d.Get("availability_zones").([]interface{})),
+		MultiplexSettings: expandMultiplexSettings(d.Get("multiplex_settings").([]interface{})),
+		Name:              aws.String(name),
+		RequestId:         aws.String(resource.UniqueId()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Multiplex: %s", input)
+	output, err := conn.CreateMultiplexWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MediaLive Multiplex (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Multiplex.Id))
+
+	if _, err := waitMultiplexCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for MediaLive Multiplex (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("start_multiplex").(bool) {
+		if err := startMultiplex(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceMultiplexRead(ctx, d, meta)
+}
+
+func resourceMultiplexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindMultiplexByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Multiplex (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaLive Multiplex (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("availability_zones", aws.StringValueSlice(output.AvailabilityZones))
+	if err := d.Set("multiplex_settings", flattenMultiplexSettings(output.MultiplexSettings)); err != nil {
+		return diag.Errorf("error setting multiplex_settings: %s", err)
+	}
+	d.Set("name", output.Name)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMultiplexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChanges("multiplex_settings", "name") {
+		input := &medialive.UpdateMultiplexInput{
+			MultiplexId:       aws.String(d.Id()),
+			MultiplexSettings: expandMultiplexSettings(d.Get("multiplex_settings").([]interface{})),
+			Name:              aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Multiplex: %s", input)
+		_, err := conn.UpdateMultiplexWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating MediaLive Multiplex (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_multiplex") {
+		if d.Get("start_multiplex").(bool) {
+			if err := startMultiplex(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := stopMultiplex(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MediaLive Multiplex (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMultiplexRead(ctx, d, meta)
+}
+
+func resourceMultiplexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	output, err := FindMultiplexByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MediaLive Multiplex (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.State) == medialive.MultiplexStateRunning {
+		if err := stopMultiplex(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Multiplex: %s", d.Id())
+	_, err = conn.DeleteMultiplexWithContext(ctx, &medialive.DeleteMultiplexInput{
+		MultiplexId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MediaLive Multiplex (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMultiplexDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for MediaLive Multiplex (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startMultiplex(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting MediaLive Multiplex: %s", id)
+	_, err := conn.StartMultiplexWithContext(ctx, &medialive.StartMultiplexInput{
+		MultiplexId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Multiplex (%s): %w", id, err)
+	}
+
+	if _, err := waitMultiplexRunning(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Multiplex (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func stopMultiplex(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping MediaLive Multiplex: %s", id)
+	_, err := conn.StopMultiplexWithContext(ctx, &medialive.StopMultiplexInput{
+		MultiplexId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Multiplex (%s): %w", id, err)
+	}
+
+	if _, err := waitMultiplexStopped(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Multiplex (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandMultiplexSettings(tfList []interface{}) *medialive.MultiplexSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &medialive.MultiplexSettings{
+		TransportStreamBitrate: aws.Int64(int64(tfMap["transport_stream_bitrate"].(int))),
+		TransportStreamId:      aws.Int64(int64(tfMap["transport_stream_id"].(int))),
+	}
+
+	if v, ok := tfMap["maximum_video_buffer_delay_milliseconds"].(int); ok && v != 0 {
+		apiObject.MaximumVideoBufferDelayMilliseconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["transport_stream_reserved_bitrate"].(int); ok && v != 0 {
+		apiObject.TransportStreamReservedBitrate = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenMultiplexSettings(apiObject *medialive.MultiplexSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"maximum_video_buffer_delay_milliseconds": aws.Int64Value(apiObject.MaximumVideoBufferDelayMilliseconds),
+		"transport_stream_bitrate":                aws.Int64Value(apiObject.TransportStreamBitrate),
+		"transport_stream_id":                     aws.Int64Value(apiObject.TransportStreamId),
+		"transport_stream_reserved_bitrate":       aws.Int64Value(apiObject.TransportStreamReservedBitrate),
+	}
+
+	return []interface{}{tfMap}
+}
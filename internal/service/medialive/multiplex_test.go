@@ -0,0 +1,273 @@
+package medialive_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaLiveMultiplex_basic(t *testing.T) {
+	resourceName := "aws_medialive_multiplex.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiplexConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`multiplex:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.transport_stream_bitrate", "1000000"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.transport_stream_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.transport_stream_reserved_bitrate", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.maximum_video_buffer_delay_milliseconds", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "start_multiplex", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_multiplex"},
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveMultiplex_disappears(t *testing.T) {
+	resourceName := "aws_medialive_multiplex.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiplexConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceMultiplex(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveMultiplex_tags(t *testing.T) {
+	resourceName := "aws_medialive_multiplex.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiplexConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_multiplex"},
+			},
+			{
+				Config: testAccMultiplexConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMultiplexConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveMultiplex_start(t *testing.T) {
+	resourceName := "aws_medialive_multiplex.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiplexConfig_startMultiplex(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					testAccCheckMultiplexState(resourceName, medialive.MultiplexStateRunning),
+				),
+			},
+			{
+				Config: testAccMultiplexConfig_startMultiplex(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiplexExists(resourceName),
+					testAccCheckMultiplexState(resourceName, medialive.MultiplexStateIdle),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMultiplexDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_multiplex" {
+			continue
+		}
+
+		_, err := tfmedialive.FindMultiplexByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Multiplex %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMultiplexExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Multiplex ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		_, err := tfmedialive.FindMultiplexByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMultiplexState(n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		output, err := tfmedialive.FindMultiplexByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.State); got != want {
+			return fmt.Errorf("MediaLive Multiplex (%s) state: got %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccMultiplexConfig_basic(rName string) string {
+	return testAccMultiplexConfig_startMultiplex(rName, false)
+}
+
+func testAccMultiplexConfig_startMultiplex(rName string, start bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_medialive_multiplex" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+  start_multiplex    = %[2]t
+
+  multiplex_settings {
+    transport_stream_bitrate                = 1000000
+    transport_stream_id                     = 1
+    transport_stream_reserved_bitrate       = 1
+    maximum_video_buffer_delay_milliseconds = 1000
+  }
+}
+`, rName, start))
+}
+
+func testAccMultiplexConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_medialive_multiplex" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  multiplex_settings {
+    transport_stream_bitrate = 1000000
+    transport_stream_id      = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMultiplexConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_medialive_multiplex" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  multiplex_settings {
+    transport_stream_bitrate = 1000000
+    transport_stream_id      = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,74 @@
+package medialive
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusChannel(ctx context.Context, conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindChannelByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusInput(ctx context.Context, conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInputByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusInputSecurityGroup(ctx context.Context, conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInputSecurityGroupByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusMultiplex(ctx context.Context, conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMultiplexByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
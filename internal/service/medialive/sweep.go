@@ -0,0 +1,228 @@
+//go:build sweep
+// +build sweep
+
+package medialive
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
+		Name: "aws_medialive_channel",
+		F:    sweepChannels,
+	})
+
+	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+		Name: "aws_medialive_input",
+		F:    sweepInputs,
+		Dependencies: []string{
+			"aws_medialive_channel",
+		},
+	})
+
+	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+		Name: "aws_medialive_input_security_group",
+		F:    sweepInputSecurityGroups,
+		Dependencies: []string{
+			"aws_medialive_input",
+		},
+	})
+
+	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+		Name: "aws_medialive_multiplex",
+		F:    sweepMultiplexes,
+		Dependencies: []string{
+			"aws_medialive_channel",
+		},
+	})
+}
+
+func sweepChannels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaLiveConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &medialive.ListChannelsInput{}
+
+	err = conn.ListChannelsPagesWithContext(context.Background(), input, func(page *medialive.ListChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Channels {
+			r := ResourceChannel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Channels sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaLive Channels (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Channels (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepInputs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaLiveConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &medialive.ListInputsInput{}
+
+	err = conn.ListInputsPagesWithContext(context.Background(), input, func(page *medialive.ListInputsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Inputs {
+			r := ResourceInput()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaLive Inputs (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Inputs (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepInputSecurityGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaLiveConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &medialive.ListInputSecurityGroupsInput{}
+
+	err = conn.ListInputSecurityGroupsPagesWithContext(context.Background(), input, func(page *medialive.ListInputSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InputSecurityGroups {
+			r := ResourceInputSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaLive Input Security Groups (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Input Security Groups (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepMultiplexes(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MediaLiveConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &medialive.ListMultiplexesInput{}
+
+	err = conn.ListMultiplexesPagesWithContext(context.Background(), input, func(page *medialive.ListMultiplexesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Multiplexes {
+			r := ResourceMultiplex()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaLive Multiplexes (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaLive Multiplexes (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,237 @@
+package medialive
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	inputCreatedTimeout              = 2 * time.Minute
+	inputDeletedTimeout              = 5 * time.Minute
+	inputSecurityGroupUpdatedTimeout = 2 * time.Minute
+	inputSecurityGroupDeletedTimeout = 2 * time.Minute
+)
+
+func waitChannelCreated(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateCreating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: statusChannel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChannelUpdated(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateUpdating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: statusChannel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChannelRunning(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStarting},
+		Target:  []string{medialive.ChannelStateRunning},
+		Refresh: statusChannel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChannelStopped(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStopping},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: statusChannel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChannelDeleted(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateDeleting},
+		Target:  []string{},
+		Refresh: statusChannel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputCreated(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateCreating},
+		Target:  []string{medialive.InputStateDetached, medialive.InputStateAttached},
+		Refresh: statusInput(ctx, conn, id),
+		Timeout: inputCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputDeleted(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateDeleting},
+		Target:  []string{},
+		Refresh: statusInput(ctx, conn, id),
+		Timeout: inputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputSecurityGroupUpdated(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target:  []string{medialive.InputSecurityGroupStateIdle, medialive.InputSecurityGroupStateInUse},
+		Refresh: statusInputSecurityGroup(ctx, conn, id),
+		Timeout: inputSecurityGroupUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputSecurityGroupDeleted(ctx context.Context, conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateIdle, medialive.InputSecurityGroupStateInUse, medialive.InputSecurityGroupStateUpdating},
+		Target:  []string{},
+		Refresh: statusInputSecurityGroup(ctx, conn, id),
+		Timeout: inputSecurityGroupDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMultiplexCreated(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateCreating},
+		Target:  []string{medialive.MultiplexStateIdle},
+		Refresh: statusMultiplex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMultiplexRunning(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateStarting},
+		Target:  []string{medialive.MultiplexStateRunning},
+		Refresh: statusMultiplex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMultiplexStopped(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateStopping},
+		Target:  []string{medialive.MultiplexStateIdle},
+		Refresh: statusMultiplex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMultiplexDeleted(ctx context.Context, conn *medialive.MediaLive, id string, timeout time.Duration) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateDeleting},
+		Target:  []string{},
+		Refresh: statusMultiplex(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
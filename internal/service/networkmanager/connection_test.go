@@ -0,0 +1,186 @@
+package networkmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccNetworkManagerConnection_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`connection/global-network-.+/connection-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "connected_device_id", "aws_networkmanager_device.test2", "id"),
+					resource.TestCheckResourceAttr(resourceName, "connected_link_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "device_id", "aws_networkmanager_device.test1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "link_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFuncByARN(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerConnection_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfnetworkmanager.ResourceConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerConnection_update(t *testing.T) {
+	resourceName := "aws_networkmanager_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_description("description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFuncByARN(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectionConfig_description("description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_connection" {
+			continue
+		}
+
+		_, err := tfnetworkmanager.FindConnectionByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err := tfnetworkmanager.FindConnectionByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccConnectionConfig_base() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test1" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+resource "aws_networkmanager_device" "test2" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+`
+}
+
+func testAccConnectionConfig_basic() string {
+	return acctest.ConfigCompose(testAccConnectionConfig_base(), `
+resource "aws_networkmanager_connection" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  device_id           = aws_networkmanager_device.test1.id
+  connected_device_id = aws_networkmanager_device.test2.id
+}
+`)
+}
+
+func testAccConnectionConfig_description(description string) string {
+	return acctest.ConfigCompose(testAccConnectionConfig_base(), fmt.Sprintf(`
+resource "aws_networkmanager_connection" "test" {
+  global_network_id   = aws_networkmanager_global_network.test.id
+  device_id           = aws_networkmanager_device.test1.id
+  connected_device_id = aws_networkmanager_device.test2.id
+  description         = %[1]q
+}
+`, description))
+}
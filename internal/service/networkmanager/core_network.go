@@ -0,0 +1,411 @@
+package networkmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCoreNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCoreNetworkCreate,
+		ReadWithoutTimeout:   resourceCoreNetworkRead,
+		UpdateWithoutTimeout: resourceCoreNetworkUpdate,
+		DeleteWithoutTimeout: resourceCoreNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"edges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"edge_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inside_cidr_blocks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"segments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shared_segments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceCoreNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &networkmanager.CreateCoreNetworkInput{
+		GlobalNetworkId: aws.String(d.Get("global_network_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_document"); ok {
+		input.PolicyDocument = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Core Network: %s", input)
+	output, err := conn.CreateCoreNetworkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Core Network: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CoreNetwork.CoreNetworkId))
+
+	if _, err := waitCoreNetworkCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Core Network (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceCoreNetworkRead(ctx, d, meta)
+}
+
+func resourceCoreNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	coreNetwork, err := FindCoreNetworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Core Network (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", coreNetwork.CoreNetworkArn)
+	if coreNetwork.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(coreNetwork.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("description", coreNetwork.Description)
+	if err := d.Set("edges", flattenCoreNetworkEdges(coreNetwork.Edges)); err != nil {
+		return diag.Errorf("error setting edges: %s", err)
+	}
+	d.Set("global_network_id", coreNetwork.GlobalNetworkId)
+	if err := d.Set("segments", flattenCoreNetworkSegments(coreNetwork.Segments)); err != nil {
+		return diag.Errorf("error setting segments: %s", err)
+	}
+	d.Set("state", coreNetwork.State)
+
+	policy, err := FindCoreNetworkPolicyByTwoPartKey(ctx, conn, d.Id(), networkmanager.CoreNetworkPolicyAliasLatest)
+
+	if tfresource.NotFound(err) {
+		d.Set("policy_document", nil)
+	} else if err != nil {
+		return diag.Errorf("error reading Network Manager Core Network (%s) policy: %s", d.Id(), err)
+	} else {
+		b, err := json.Marshal(policy.PolicyDocument)
+
+		if err != nil {
+			return diag.Errorf("error marshaling Network Manager Core Network (%s) policy document: %s", d.Id(), err)
+		}
+
+		if old := d.Get("policy_document").(string); old != "" && verify.JSONBytesEqual([]byte(old), b) {
+			d.Set("policy_document", old)
+		} else {
+			d.Set("policy_document", string(b))
+		}
+	}
+
+	tags := KeyValueTags(coreNetwork.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCoreNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChange("description") {
+		input := &networkmanager.UpdateCoreNetworkInput{
+			CoreNetworkId: aws.String(d.Id()),
+			Description:   aws.String(d.Get("description").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Core Network: %s", input)
+		_, err := conn.UpdateCoreNetworkWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Network Manager Core Network (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("policy_document") {
+		if err := putAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), d.Get("policy_document").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager Core Network (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCoreNetworkRead(ctx, d, meta)
+}
+
+func resourceCoreNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	log.Printf("[DEBUG] Deleting Network Manager Core Network: %s", d.Id())
+	_, err := conn.DeleteCoreNetworkWithContext(ctx, &networkmanager.DeleteCoreNetworkInput{
+		CoreNetworkId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Core Network (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCoreNetworkDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Core Network (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// putAndExecuteCoreNetworkPolicy submits a new policy version and, once its change set
+// has been generated, executes the change set so that the policy becomes LIVE.
+func putAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, policyDocument string, timeout time.Duration) error {
+	var document aws.JSONValue
+
+	if err := json.Unmarshal([]byte(policyDocument), &document); err != nil {
+		return fmt.Errorf("error decoding Network Manager Core Network (%s) policy document: %w", coreNetworkID, err)
+	}
+
+	input := &networkmanager.PutCoreNetworkPolicyInput{
+		CoreNetworkId:  aws.String(coreNetworkID),
+		PolicyDocument: document,
+	}
+
+	log.Printf("[DEBUG] Putting Network Manager Core Network policy: %s", input)
+	output, err := conn.PutCoreNetworkPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Network Manager Core Network (%s) policy: %w", coreNetworkID, err)
+	}
+
+	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
+
+	if _, err := waitCoreNetworkPolicyChangeSetReady(ctx, conn, coreNetworkID, policyVersionID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Core Network (%s) policy version (%d) change set: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	_, err = conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Network Manager Core Network (%s) policy version (%d) change set: %w", coreNetworkID, policyVersionID, err)
+	}
+
+	return nil
+}
+
+func flattenCoreNetworkEdge(apiObject *networkmanager.CoreNetworkEdge) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Asn; v != nil {
+		tfMap["asn"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.EdgeLocation; v != nil {
+		tfMap["edge_location"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InsideCidrBlocks; v != nil {
+		tfMap["inside_cidr_blocks"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCoreNetworkEdges(apiObjects []*networkmanager.CoreNetworkEdge) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCoreNetworkEdge(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCoreNetworkSegment(apiObject *networkmanager.CoreNetworkSegment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EdgeLocations; v != nil {
+		tfMap["edge_locations"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SharedSegments; v != nil {
+		tfMap["shared_segments"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
+
+func flattenCoreNetworkSegments(apiObjects []*networkmanager.CoreNetworkSegment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCoreNetworkSegment(apiObject))
+	}
+
+	return tfList
+}
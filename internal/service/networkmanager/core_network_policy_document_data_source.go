@@ -0,0 +1,582 @@
+package networkmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
+	segmentNameValidateFunc := validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]{0,63}$`), "must begin with a letter and contain only alphanumeric characters")
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoreNetworkPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"attachment_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"association_method": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"constant", "tag"}, false),
+									},
+									"require_acceptance": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"segment": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: segmentNameValidateFunc,
+									},
+									"tag_value_of_key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"condition_logic": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+						},
+						"conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"operator": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"equals", "not-equals", "contains", "begins-with"}, false),
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"account-id", "any", "tag-value", "tag-exists", "resource-id", "region", "attachment-type"}, false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"rule_number": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+			},
+			"core_network_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn_ranges": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"edge_locations": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"asn": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntBetween(64512, 65534),
+											validation.IntBetween(4200000000, 4294967294),
+										),
+									},
+									"inside_cidr_blocks": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidCIDRNetworkAddress,
+										},
+									},
+									"location": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
+						"inside_cidr_blocks": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						"vpn_ecmp_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"share", "create-route"}, false),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_cidr_blocks": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						"destinations": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.Any(
+									validation.StringInSlice([]string{"blackhole"}, false),
+									validation.StringMatch(regexp.MustCompile(`^attachment-[0-9a-f]{17}$`), "must be a Cloud WAN attachment ID"),
+								),
+							},
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"attachment-route"}, false),
+						},
+						"segment": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: segmentNameValidateFunc,
+						},
+						"share_with": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"share_with_except": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: segmentNameValidateFunc,
+							},
+						},
+					},
+				},
+			},
+			"segments": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: segmentNameValidateFunc,
+							},
+						},
+						"deny_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: segmentNameValidateFunc,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"edge_locations": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"isolate_attachments": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: segmentNameValidateFunc,
+						},
+						"require_attachment_acceptance": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2021.12",
+				ValidateFunc: validation.StringInSlice([]string{"2021.12"}, false),
+			},
+		},
+	}
+}
+
+func dataSourceCoreNetworkPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	doc := &CoreNetworkPolicyDoc{
+		Version:                  d.Get("version").(string),
+		CoreNetworkConfiguration: expandCoreNetworkPolicyCoreNetworkConfiguration(d.Get("core_network_configuration").([]interface{})[0].(map[string]interface{})),
+	}
+
+	segments, err := expandCoreNetworkPolicySegments(d.Get("segments").([]interface{}))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	doc.Segments = segments
+
+	segmentActions, err := expandCoreNetworkPolicySegmentActions(d.Get("segment_actions").([]interface{}))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	doc.SegmentActions = segmentActions
+
+	attachmentPolicies, err := expandCoreNetworkPolicyAttachmentPolicies(d.Get("attachment_policies").([]interface{}))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	doc.AttachmentPolicies = attachmentPolicies
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		// should never happen if the above code is correct
+		return diag.FromErr(err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return nil
+}
+
+func expandCoreNetworkPolicyCoreNetworkConfiguration(tfMap map[string]interface{}) *CoreNetworkPolicyCoreNetworkConfiguration {
+	apiObject := &CoreNetworkPolicyCoreNetworkConfiguration{
+		AsnRanges:      aws.StringValueSlice(flex.ExpandStringSet(tfMap["asn_ranges"].(*schema.Set))),
+		EdgeLocations:  expandCoreNetworkPolicyEdgeLocations(tfMap["edge_locations"].([]interface{})),
+		VpnEcmpSupport: tfMap["vpn_ecmp_support"].(bool),
+	}
+
+	if v, ok := tfMap["inside_cidr_blocks"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InsideCidrBlocks = aws.StringValueSlice(flex.ExpandStringSet(v))
+	}
+
+	return apiObject
+}
+
+func expandCoreNetworkPolicyEdgeLocations(tfList []interface{}) []*CoreNetworkPolicyEdgeLocation {
+	var apiObjects []*CoreNetworkPolicyEdgeLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &CoreNetworkPolicyEdgeLocation{
+			Location: tfMap["location"].(string),
+		}
+
+		if v, ok := tfMap["asn"].(int); ok && v != 0 {
+			apiObject.Asn = int64(v)
+		}
+
+		if v, ok := tfMap["inside_cidr_blocks"].([]interface{}); ok && len(v) > 0 {
+			apiObject.InsideCidrBlocks = aws.StringValueSlice(flex.ExpandStringList(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCoreNetworkPolicySegments(tfList []interface{}) ([]*CoreNetworkPolicySegment, error) {
+	var apiObjects []*CoreNetworkPolicySegment
+	names := make(map[string]struct{})
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("segments[%d]: duplicate segment name (%s)", i, name)
+		}
+
+		names[name] = struct{}{}
+
+		apiObject := &CoreNetworkPolicySegment{
+			Description:                 tfMap["description"].(string),
+			IsolateAttachments:          tfMap["isolate_attachments"].(bool),
+			Name:                        name,
+			RequireAttachmentAcceptance: tfMap["require_attachment_acceptance"].(bool),
+		}
+
+		if v, ok := tfMap["edge_locations"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.EdgeLocations = aws.StringValueSlice(flex.ExpandStringSet(v))
+		}
+
+		allowFilter, _ := tfMap["allow_filter"].(*schema.Set)
+		denyFilter, _ := tfMap["deny_filter"].(*schema.Set)
+
+		if allowFilter != nil && allowFilter.Len() > 0 && denyFilter != nil && denyFilter.Len() > 0 {
+			return nil, fmt.Errorf("segments[%d]: only one of allow_filter and deny_filter may be set", i)
+		}
+
+		if allowFilter != nil && allowFilter.Len() > 0 {
+			apiObject.AllowFilter = aws.StringValueSlice(flex.ExpandStringSet(allowFilter))
+		}
+
+		if denyFilter != nil && denyFilter.Len() > 0 {
+			apiObject.DenyFilter = aws.StringValueSlice(flex.ExpandStringSet(denyFilter))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandCoreNetworkPolicySegmentActions(tfList []interface{}) ([]*CoreNetworkPolicySegmentAction, error) {
+	var apiObjects []*CoreNetworkPolicySegmentAction
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &CoreNetworkPolicySegmentAction{
+			Action:      tfMap["action"].(string),
+			Description: tfMap["description"].(string),
+			Segment:     tfMap["segment"].(string),
+		}
+
+		shareWith := tfMap["share_with"].(*schema.Set)
+		shareWithExcept := tfMap["share_with_except"].(*schema.Set)
+		destinationCIDRBlocks := tfMap["destination_cidr_blocks"].(*schema.Set)
+		destinations := tfMap["destinations"].(*schema.Set)
+
+		switch apiObject.Action {
+		case "share":
+			if destinationCIDRBlocks.Len() > 0 || destinations.Len() > 0 {
+				return nil, fmt.Errorf("segment_actions[%d]: destination_cidr_blocks and destinations must not be set when action is share", i)
+			}
+
+			if shareWith.Len() > 0 && shareWithExcept.Len() > 0 {
+				return nil, fmt.Errorf("segment_actions[%d]: only one of share_with and share_with_except may be set", i)
+			}
+
+			if shareWith.Len() == 0 && shareWithExcept.Len() == 0 {
+				return nil, fmt.Errorf("segment_actions[%d]: one of share_with or share_with_except must be set when action is share", i)
+			}
+
+			if v := aws.StringValueSlice(flex.ExpandStringSet(shareWith)); len(v) == 1 && v[0] == "*" {
+				apiObject.ShareWith = "*"
+			} else if len(v) > 0 {
+				apiObject.ShareWith = v
+			} else {
+				apiObject.ShareWith = &CoreNetworkPolicyShareWithExcept{
+					Except: aws.StringValueSlice(flex.ExpandStringSet(shareWithExcept)),
+				}
+			}
+
+			apiObject.Mode = tfMap["mode"].(string)
+
+		case "create-route":
+			if shareWith.Len() > 0 || shareWithExcept.Len() > 0 || tfMap["mode"].(string) != "" {
+				return nil, fmt.Errorf("segment_actions[%d]: mode, share_with and share_with_except must not be set when action is create-route", i)
+			}
+
+			if destinationCIDRBlocks.Len() == 0 || destinations.Len() == 0 {
+				return nil, fmt.Errorf("segment_actions[%d]: destination_cidr_blocks and destinations must be set when action is create-route", i)
+			}
+
+			apiObject.DestinationCidrBlocks = aws.StringValueSlice(flex.ExpandStringSet(destinationCIDRBlocks))
+			apiObject.Destinations = aws.StringValueSlice(flex.ExpandStringSet(destinations))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandCoreNetworkPolicyAttachmentPolicies(tfList []interface{}) ([]*CoreNetworkPolicyAttachmentPolicy, error) {
+	var apiObjects []*CoreNetworkPolicyAttachmentPolicy
+	ruleNumbers := make(map[int]struct{})
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		ruleNumber := tfMap["rule_number"].(int)
+
+		if _, ok := ruleNumbers[ruleNumber]; ok {
+			return nil, fmt.Errorf("attachment_policies[%d]: duplicate rule_number (%d)", i, ruleNumber)
+		}
+
+		ruleNumbers[ruleNumber] = struct{}{}
+
+		conditions := expandCoreNetworkPolicyAttachmentPolicyConditions(tfMap["conditions"].([]interface{}))
+		conditionLogic := tfMap["condition_logic"].(string)
+
+		if len(conditions) > 1 && conditionLogic == "" {
+			return nil, fmt.Errorf("attachment_policies[%d]: condition_logic must be set when there is more than one condition", i)
+		}
+
+		action, err := expandCoreNetworkPolicyAttachmentPolicyAction(tfMap["action"].([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, fmt.Errorf("attachment_policies[%d]: %w", i, err)
+		}
+
+		apiObjects = append(apiObjects, &CoreNetworkPolicyAttachmentPolicy{
+			Action:         action,
+			ConditionLogic: conditionLogic,
+			Conditions:     conditions,
+			Description:    tfMap["description"].(string),
+			RuleNumber:     ruleNumber,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func expandCoreNetworkPolicyAttachmentPolicyConditions(tfList []interface{}) []*CoreNetworkPolicyAttachmentPolicyCondition {
+	var apiObjects []*CoreNetworkPolicyAttachmentPolicyCondition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &CoreNetworkPolicyAttachmentPolicyCondition{
+			Key:      tfMap["key"].(string),
+			Operator: tfMap["operator"].(string),
+			Type:     tfMap["type"].(string),
+			Value:    tfMap["value"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandCoreNetworkPolicyAttachmentPolicyAction(tfMap map[string]interface{}) (*CoreNetworkPolicyAttachmentPolicyAction, error) {
+	apiObject := &CoreNetworkPolicyAttachmentPolicyAction{
+		AssociationMethod: tfMap["association_method"].(string),
+		RequireAcceptance: tfMap["require_acceptance"].(bool),
+		Segment:           tfMap["segment"].(string),
+		TagValueOfKey:     tfMap["tag_value_of_key"].(string),
+	}
+
+	switch apiObject.AssociationMethod {
+	case "constant":
+		if apiObject.Segment == "" {
+			return nil, fmt.Errorf("action segment must be set when association_method is constant")
+		}
+
+		if apiObject.TagValueOfKey != "" {
+			return nil, fmt.Errorf("action tag_value_of_key must not be set when association_method is constant")
+		}
+	case "tag":
+		if apiObject.TagValueOfKey == "" {
+			return nil, fmt.Errorf("action tag_value_of_key must be set when association_method is tag")
+		}
+
+		if apiObject.Segment != "" {
+			return nil, fmt.Errorf("action segment must not be set when association_method is tag")
+		}
+	}
+
+	return apiObject, nil
+}
@@ -0,0 +1,273 @@
+package networkmanager_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_core_network_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccCoreNetworkPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_shareWithExcept(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_core_network_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyDocumentDataSourceConfig_shareWithExcept,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccCoreNetworkPolicyDocumentDataSourceExpectedJSON_shareWithExcept),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_invalidAttachmentPolicyAction(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_invalidAttachmentPolicyAction,
+				ExpectError: regexp.MustCompile(`action tag_value_of_key must be set when association_method is tag`),
+			},
+		},
+	})
+}
+
+var testAccCoreNetworkPolicyDocumentDataSourceConfig_basic = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+      asn      = 64512
+    }
+  }
+
+  segments {
+    name                          = "development"
+    require_attachment_acceptance = true
+  }
+
+  segment_actions {
+    action     = "share"
+    mode       = "attachment-route"
+    segment    = "development"
+    share_with = ["*"]
+  }
+
+  attachment_policies {
+    rule_number     = 100
+    condition_logic = "or"
+
+    conditions {
+      type     = "tag-value"
+      operator = "equals"
+      key      = "segment"
+      value    = "development"
+    }
+
+    action {
+      association_method = "constant"
+      segment            = "development"
+    }
+  }
+}
+`
+
+var testAccCoreNetworkPolicyDocumentDataSourceExpectedJSON_basic = `{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": [
+      "64512-64555"
+    ],
+    "vpn-ecmp-support": true,
+    "edge-locations": [
+      {
+        "location": "us-east-1",
+        "asn": 64512
+      }
+    ]
+  },
+  "segments": [
+    {
+      "name": "development",
+      "isolate-attachments": false,
+      "require-attachment-acceptance": true
+    }
+  ],
+  "segment-actions": [
+    {
+      "action": "share",
+      "segment": "development",
+      "mode": "attachment-route",
+      "share-with": "*"
+    }
+  ],
+  "attachment-policies": [
+    {
+      "rule-number": 100,
+      "condition-logic": "or",
+      "conditions": [
+        {
+          "type": "tag-value",
+          "operator": "equals",
+          "key": "segment",
+          "value": "development"
+        }
+      ],
+      "action": {
+        "association-method": "constant",
+        "segment": "development"
+      }
+    }
+  ]
+}`
+
+var testAccCoreNetworkPolicyDocumentDataSourceConfig_shareWithExcept = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+  }
+
+  segments {
+    name = "shared"
+  }
+
+  segments {
+    name                = "isolated"
+    isolate_attachments = true
+  }
+
+  segment_actions {
+    action            = "share"
+    segment           = "shared"
+    share_with_except = ["isolated"]
+  }
+
+  attachment_policies {
+    rule_number = 100
+
+    conditions {
+      type = "tag-exists"
+      key  = "segment"
+    }
+
+    action {
+      association_method = "tag"
+      tag_value_of_key   = "segment"
+    }
+  }
+}
+`
+
+var testAccCoreNetworkPolicyDocumentDataSourceExpectedJSON_shareWithExcept = `{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": [
+      "64512-64555"
+    ],
+    "vpn-ecmp-support": true,
+    "edge-locations": [
+      {
+        "location": "us-east-1"
+      }
+    ]
+  },
+  "segments": [
+    {
+      "name": "shared",
+      "isolate-attachments": false,
+      "require-attachment-acceptance": false
+    },
+    {
+      "name": "isolated",
+      "isolate-attachments": true,
+      "require-attachment-acceptance": false
+    }
+  ],
+  "segment-actions": [
+    {
+      "action": "share",
+      "segment": "shared",
+      "share-with": {
+        "except": [
+          "isolated"
+        ]
+      }
+    }
+  ],
+  "attachment-policies": [
+    {
+      "rule-number": 100,
+      "conditions": [
+        {
+          "type": "tag-exists",
+          "key": "segment"
+        }
+      ],
+      "action": {
+        "association-method": "tag",
+        "tag-value-of-key": "segment"
+      }
+    }
+  ]
+}`
+
+var testAccCoreNetworkPolicyDocumentDataSourceConfig_invalidAttachmentPolicyAction = `
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-64555"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+  }
+
+  segments {
+    name = "development"
+  }
+
+  attachment_policies {
+    rule_number = 100
+
+    conditions {
+      type = "any"
+    }
+
+    action {
+      association_method = "tag"
+    }
+  }
+}
+`
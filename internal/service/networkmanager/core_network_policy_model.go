@@ -0,0 +1,68 @@
+package networkmanager
+
+type CoreNetworkPolicyDoc struct {
+	Version                  string                                     `json:"version,omitempty"`
+	CoreNetworkConfiguration *CoreNetworkPolicyCoreNetworkConfiguration `json:"core-network-configuration"`
+	Segments                 []*CoreNetworkPolicySegment                `json:"segments"`
+	SegmentActions           []*CoreNetworkPolicySegmentAction          `json:"segment-actions,omitempty"`
+	AttachmentPolicies       []*CoreNetworkPolicyAttachmentPolicy       `json:"attachment-policies,omitempty"`
+}
+
+type CoreNetworkPolicyCoreNetworkConfiguration struct {
+	AsnRanges        []string                         `json:"asn-ranges"`
+	InsideCidrBlocks []string                         `json:"inside-cidr-blocks,omitempty"`
+	VpnEcmpSupport   bool                             `json:"vpn-ecmp-support"`
+	EdgeLocations    []*CoreNetworkPolicyEdgeLocation `json:"edge-locations"`
+}
+
+type CoreNetworkPolicyEdgeLocation struct {
+	Location         string   `json:"location"`
+	Asn              int64    `json:"asn,omitempty"`
+	InsideCidrBlocks []string `json:"inside-cidr-blocks,omitempty"`
+}
+
+type CoreNetworkPolicySegment struct {
+	Name                        string   `json:"name"`
+	Description                 string   `json:"description,omitempty"`
+	EdgeLocations               []string `json:"edge-locations,omitempty"`
+	IsolateAttachments          bool     `json:"isolate-attachments"`
+	RequireAttachmentAcceptance bool     `json:"require-attachment-acceptance"`
+	DenyFilter                  []string `json:"deny-filter,omitempty"`
+	AllowFilter                 []string `json:"allow-filter,omitempty"`
+}
+
+type CoreNetworkPolicySegmentAction struct {
+	Action                string      `json:"action"`
+	Segment               string      `json:"segment"`
+	Mode                  string      `json:"mode,omitempty"`
+	ShareWith             interface{} `json:"share-with,omitempty"`
+	DestinationCidrBlocks []string    `json:"destination-cidr-blocks,omitempty"`
+	Destinations          []string    `json:"destinations,omitempty"`
+	Description           string      `json:"description,omitempty"`
+}
+
+type CoreNetworkPolicyShareWithExcept struct {
+	Except []string `json:"except"`
+}
+
+type CoreNetworkPolicyAttachmentPolicy struct {
+	RuleNumber     int                                           `json:"rule-number"`
+	Description    string                                        `json:"description,omitempty"`
+	ConditionLogic string                                        `json:"condition-logic,omitempty"`
+	Conditions     []*CoreNetworkPolicyAttachmentPolicyCondition `json:"conditions"`
+	Action         *CoreNetworkPolicyAttachmentPolicyAction      `json:"action"`
+}
+
+type CoreNetworkPolicyAttachmentPolicyCondition struct {
+	Type     string `json:"type"`
+	Operator string `json:"operator,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+type CoreNetworkPolicyAttachmentPolicyAction struct {
+	AssociationMethod string `json:"association-method"`
+	Segment           string `json:"segment,omitempty"`
+	TagValueOfKey     string `json:"tag-value-of-key,omitempty"`
+	RequireAcceptance bool   `json:"require-acceptance,omitempty"`
+}
@@ -0,0 +1,332 @@
+package networkmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeviceCreate,
+		ReadWithoutTimeout:   resourceDeviceRead,
+		UpdateWithoutTimeout: resourceDeviceUpdate,
+		DeleteWithoutTimeout: resourceDeviceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByARN,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": locationSchema(),
+			"model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"serial_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+		},
+	}
+}
+
+func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.CreateDeviceInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("aws_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AWSLocation = expandAWSLocation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Location = expandLocation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("model"); ok {
+		input.Model = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("serial_number"); ok {
+		input.SerialNumber = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("site_id"); ok {
+		input.SiteId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vendor"); ok {
+		input.Vendor = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Device: %s", input)
+	output, err := conn.CreateDeviceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Device: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Device.DeviceId))
+
+	if _, err := waitDeviceCreated(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Device (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDeviceRead(ctx, d, meta)
+}
+
+func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	device, err := FindDeviceByTwoPartKey(ctx, conn, globalNetworkID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Device (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", device.DeviceArn)
+	if device.AWSLocation != nil {
+		if err := d.Set("aws_location", []interface{}{flattenAWSLocation(device.AWSLocation)}); err != nil {
+			return diag.Errorf("error setting aws_location: %s", err)
+		}
+	} else {
+		d.Set("aws_location", nil)
+	}
+	d.Set("description", device.Description)
+	d.Set("global_network_id", device.GlobalNetworkId)
+	if device.Location != nil {
+		if err := d.Set("location", []interface{}{flattenLocation(device.Location)}); err != nil {
+			return diag.Errorf("error setting location: %s", err)
+		}
+	} else {
+		d.Set("location", nil)
+	}
+	d.Set("model", device.Model)
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("site_id", device.SiteId)
+	d.Set("type", device.Type)
+	d.Set("vendor", device.Vendor)
+
+	tags := KeyValueTags(device.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		globalNetworkID := d.Get("global_network_id").(string)
+		input := &networkmanager.UpdateDeviceInput{
+			Description:     aws.String(d.Get("description").(string)),
+			DeviceId:        aws.String(d.Id()),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			Model:           aws.String(d.Get("model").(string)),
+			SerialNumber:    aws.String(d.Get("serial_number").(string)),
+			SiteId:          aws.String(d.Get("site_id").(string)),
+			Type:            aws.String(d.Get("type").(string)),
+			Vendor:          aws.String(d.Get("vendor").(string)),
+		}
+
+		if v, ok := d.GetOk("aws_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.AWSLocation = expandAWSLocation(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.AWSLocation = &networkmanager.AWSLocation{}
+		}
+
+		if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Location = expandLocation(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Location = &networkmanager.Location{}
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Device: %s", input)
+		_, err := conn.UpdateDeviceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Network Manager Device (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDeviceUpdated(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Device (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager Device (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDeviceRead(ctx, d, meta)
+}
+
+func resourceDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Device: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteDeviceWithContext(ctx, &networkmanager.DeleteDeviceInput{
+			DeviceId:        aws.String(d.Id()),
+			GlobalNetworkId: aws.String(globalNetworkID),
+		})
+	}, networkmanager.ErrCodeConflictException)
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Device (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDeviceDeleted(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Device (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAWSLocation(tfMap map[string]interface{}) *networkmanager.AWSLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.AWSLocation{}
+
+	if v, ok := tfMap["subnet_arn"].(string); ok && v != "" {
+		apiObject.SubnetArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["zone"].(string); ok && v != "" {
+		apiObject.Zone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSLocation(apiObject *networkmanager.AWSLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SubnetArn; v != nil {
+		tfMap["subnet_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Zone; v != nil {
+		tfMap["zone"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
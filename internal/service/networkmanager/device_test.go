@@ -0,0 +1,202 @@
+package networkmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccNetworkManagerDevice_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`device/global-network-.+/device-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "aws_location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", "aws_networkmanager_global_network.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "model", ""),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", ""),
+					resource.TestCheckResourceAttr(resourceName, "site_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+					resource.TestCheckResourceAttr(resourceName, "vendor", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFuncByARN(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerDevice_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfnetworkmanager.ResourceDevice(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerDevice_update(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+	siteResourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceConfig_update("description1", "model1", "sn1", "type1", "vendor1", "18.0029784", "-76.7897987"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.latitude", "18.0029784"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.longitude", "-76.7897987"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model1"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "sn1"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFuncByARN(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeviceConfig_update("description2", "model2", "sn2", "type2", "vendor2", "22", "-22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.latitude", "22"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.longitude", "-22"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model2"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "sn2"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeviceDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_device" {
+			continue
+		}
+
+		_, err := tfnetworkmanager.FindDeviceByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Device %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDeviceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Device ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err := tfnetworkmanager.FindDeviceByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDeviceConfig_basic() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccDeviceConfig_update(description, model, serialNumber, deviceType, vendor, latitude, longitude string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  description   = %[1]q
+  model         = %[2]q
+  serial_number = %[3]q
+  type          = %[4]q
+  vendor        = %[5]q
+
+  location {
+    latitude  = %[6]q
+    longitude = %[7]q
+  }
+}
+`, description, model, serialNumber, deviceType, vendor, latitude, longitude)
+}
@@ -0,0 +1,466 @@
+package networkmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGlobalNetworkByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{id}),
+	}
+	var output []*networkmanager.GlobalNetwork
+
+	err := conn.DescribeGlobalNetworksPagesWithContext(ctx, input, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GlobalNetworks {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindSiteByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteIds:         aws.StringSlice([]string{siteID}),
+	}
+	var output []*networkmanager.Site
+
+	err := conn.GetSitesPagesWithContext(ctx, input, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Sites {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != globalNetworkID || aws.StringValue(output[0].SiteId) != siteID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindDeviceByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	input := &networkmanager.GetDevicesInput{
+		DeviceIds:       aws.StringSlice([]string{deviceID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+	var output []*networkmanager.Device
+
+	err := conn.GetDevicesPagesWithContext(ctx, input, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Devices {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != globalNetworkID || aws.StringValue(output[0].DeviceId) != deviceID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindLinkByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkIds:         aws.StringSlice([]string{linkID}),
+	}
+	var output []*networkmanager.Link
+
+	err := conn.GetLinksPagesWithContext(ctx, input, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Links {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != globalNetworkID || aws.StringValue(output[0].LinkId) != linkID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindLinkAssociationByThreePartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	input := &networkmanager.GetLinkAssociationsInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+	var output []*networkmanager.LinkAssociation
+
+	err := conn.GetLinkAssociationsPagesWithContext(ctx, input, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LinkAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if state := aws.StringValue(output[0].LinkAssociationState); state == networkmanager.LinkAssociationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != globalNetworkID || aws.StringValue(output[0].LinkId) != linkID || aws.StringValue(output[0].DeviceId) != deviceID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindConnectionByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, connectionID string) (*networkmanager.Connection, error) {
+	input := &networkmanager.GetConnectionsInput{
+		ConnectionIds:   aws.StringSlice([]string{connectionID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+	var output []*networkmanager.Connection
+
+	err := conn.GetConnectionsPagesWithContext(ctx, input, func(page *networkmanager.GetConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connections {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != globalNetworkID || aws.StringValue(output[0].ConnectionId) != connectionID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindTransitGatewayRegistrationByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	input := &networkmanager.GetTransitGatewayRegistrationsInput{
+		GlobalNetworkId:    aws.String(globalNetworkID),
+		TransitGatewayArns: aws.StringSlice([]string{transitGatewayARN}),
+	}
+	var output []*networkmanager.TransitGatewayRegistration
+
+	err := conn.GetTransitGatewayRegistrationsPagesWithContext(ctx, input, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayRegistrations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0].State == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if state := aws.StringValue(output[0].State.Code); state == networkmanager.TransitGatewayRegistrationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output[0].GlobalNetworkId) != globalNetworkID || aws.StringValue(output[0].TransitGatewayArn) != transitGatewayARN {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output[0], nil
+}
+
+func FindCoreNetworkByID(ctx context.Context, conn *networkmanager.NetworkManager, id string) (*networkmanager.CoreNetwork, error) {
+	input := &networkmanager.GetCoreNetworkInput{
+		CoreNetworkId: aws.String(id),
+	}
+
+	output, err := conn.GetCoreNetworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetwork == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetwork, nil
+}
+
+func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetworkPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetworkPolicy, nil
+}
+
+func FindCoreNetworkPolicyByVersion(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		CoreNetworkId:   aws.String(coreNetworkID),
+		PolicyVersionId: aws.Int64(policyVersionID),
+	}
+
+	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CoreNetworkPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CoreNetworkPolicy, nil
+}
+
+// globalNetworkIDNotFoundError returns whether the error indicates that the
+// global network in the request URI does not exist.
+func globalNetworkIDNotFoundError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, networkmanager.ErrCodeValidationException, "Global network id not found")
+}
@@ -0,0 +1,70 @@
+package networkmanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const linkAssociationIDSeparator = ","
+
+func LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID string) string {
+	parts := []string{globalNetworkID, linkID, deviceID}
+	id := strings.Join(parts, linkAssociationIDSeparator)
+
+	return id
+}
+
+func LinkAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, linkAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected global-network-id%[2]slink-id%[2]sdevice-id", id, linkAssociationIDSeparator)
+}
+
+const transitGatewayRegistrationIDSeparator = ","
+
+func TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN string) string {
+	parts := []string{globalNetworkID, transitGatewayARN}
+	id := strings.Join(parts, transitGatewayRegistrationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayRegistrationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayRegistrationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected global-network-id%[2]stransit-gateway-arn", id, transitGatewayRegistrationIDSeparator)
+}
+
+// importStateByARN imports a resource that belongs to a global network using its ARN,
+// e.g. arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223.
+// The resource ID is set to the last part of the ARN resource and global_network_id to the middle part.
+func importStateByARN(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	v, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ARN (%s): %w", d.Id(), err)
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format for ARN resource (%s), expected resource-type/global-network-id/resource-id", v.Resource)
+	}
+
+	d.SetId(parts[2])
+	d.Set("global_network_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
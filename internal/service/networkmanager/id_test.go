@@ -0,0 +1,124 @@
+package networkmanager
+
+import (
+	"testing"
+)
+
+func TestLinkAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputID                 string
+		ExpectedGlobalNetworkID string
+		ExpectedLinkID          string
+		ExpectedDeviceID        string
+		ExpectError             bool
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing device ID",
+			InputID:     "global-network-01231231231231231,link-11112222aaaabbbb1",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty parts",
+			InputID:     ",,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "a,b,c,d",
+			ExpectError: true,
+		},
+		{
+			TestName:                "valid",
+			InputID:                 "global-network-01231231231231231,link-11112222aaaabbbb1,device-07f6fd08867abc123",
+			ExpectedGlobalNetworkID: "global-network-01231231231231231",
+			ExpectedLinkID:          "link-11112222aaaabbbb1",
+			ExpectedDeviceID:        "device-07f6fd08867abc123",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGlobalNetworkID, gotLinkID, gotDeviceID, err := LinkAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotGlobalNetworkID != testCase.ExpectedGlobalNetworkID {
+				t.Errorf("got global network ID %s, expected %s", gotGlobalNetworkID, testCase.ExpectedGlobalNetworkID)
+			}
+
+			if gotLinkID != testCase.ExpectedLinkID {
+				t.Errorf("got link ID %s, expected %s", gotLinkID, testCase.ExpectedLinkID)
+			}
+
+			if gotDeviceID != testCase.ExpectedDeviceID {
+				t.Errorf("got device ID %s, expected %s", gotDeviceID, testCase.ExpectedDeviceID)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRegistrationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName                  string
+		InputID                   string
+		ExpectedGlobalNetworkID   string
+		ExpectedTransitGatewayARN string
+		ExpectError               bool
+	}{
+		{
+			TestName:    "empty",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing transit gateway ARN",
+			InputID:     "global-network-01231231231231231",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty parts",
+			InputID:     ",",
+			ExpectError: true,
+		},
+		{
+			TestName:                  "valid",
+			InputID:                   "global-network-01231231231231231,arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-0123456789abcdef0",
+			ExpectedGlobalNetworkID:   "global-network-01231231231231231",
+			ExpectedTransitGatewayARN: "arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-0123456789abcdef0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGlobalNetworkID, gotTransitGatewayARN, err := TransitGatewayRegistrationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotGlobalNetworkID != testCase.ExpectedGlobalNetworkID {
+				t.Errorf("got global network ID %s, expected %s", gotGlobalNetworkID, testCase.ExpectedGlobalNetworkID)
+			}
+
+			if gotTransitGatewayARN != testCase.ExpectedTransitGatewayARN {
+				t.Errorf("got transit gateway ARN %s, expected %s", gotTransitGatewayARN, testCase.ExpectedTransitGatewayARN)
+			}
+		})
+	}
+}
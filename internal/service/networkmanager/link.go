@@ -0,0 +1,287 @@
+package networkmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLink() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLinkCreate,
+		ReadWithoutTimeout:   resourceLinkRead,
+		UpdateWithoutTimeout: resourceLinkUpdate,
+		DeleteWithoutTimeout: resourceLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByARN,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"download_speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"upload_speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// "provider" is a reserved argument name.
+			"provider_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+		},
+	}
+}
+
+func resourceLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.CreateLinkInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteId:          aws.String(d.Get("site_id").(string)),
+	}
+
+	if v, ok := d.GetOk("bandwidth"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Bandwidth = expandBandwidth(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provider_name"); ok {
+		input.Provider = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Link: %s", input)
+	output, err := conn.CreateLinkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Link: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Link.LinkId))
+
+	if _, err := waitLinkCreated(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Link (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceLinkRead(ctx, d, meta)
+}
+
+func resourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	link, err := FindLinkByTwoPartKey(ctx, conn, globalNetworkID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Link (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", link.LinkArn)
+	if link.Bandwidth != nil {
+		if err := d.Set("bandwidth", []interface{}{flattenBandwidth(link.Bandwidth)}); err != nil {
+			return diag.Errorf("error setting bandwidth: %s", err)
+		}
+	} else {
+		d.Set("bandwidth", nil)
+	}
+	d.Set("description", link.Description)
+	d.Set("global_network_id", link.GlobalNetworkId)
+	d.Set("provider_name", link.Provider)
+	d.Set("site_id", link.SiteId)
+	d.Set("type", link.Type)
+
+	tags := KeyValueTags(link.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		globalNetworkID := d.Get("global_network_id").(string)
+		input := &networkmanager.UpdateLinkInput{
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			LinkId:          aws.String(d.Id()),
+			Provider:        aws.String(d.Get("provider_name").(string)),
+			Type:            aws.String(d.Get("type").(string)),
+		}
+
+		if v, ok := d.GetOk("bandwidth"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Bandwidth = expandBandwidth(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Link: %s", input)
+		_, err := conn.UpdateLinkWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Network Manager Link (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitLinkUpdated(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Link (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager Link (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLinkRead(ctx, d, meta)
+}
+
+func resourceLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Link: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteLinkWithContext(ctx, &networkmanager.DeleteLinkInput{
+			GlobalNetworkId: aws.String(globalNetworkID),
+			LinkId:          aws.String(d.Id()),
+		})
+	}, networkmanager.ErrCodeConflictException)
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Link (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitLinkDeleted(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Link (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBandwidth(tfMap map[string]interface{}) *networkmanager.Bandwidth {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.Bandwidth{}
+
+	if v, ok := tfMap["download_speed"].(int); ok && v != 0 {
+		apiObject.DownloadSpeed = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["upload_speed"].(int); ok && v != 0 {
+		apiObject.UploadSpeed = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenBandwidth(apiObject *networkmanager.Bandwidth) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DownloadSpeed; v != nil {
+		tfMap["download_speed"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UploadSpeed; v != nil {
+		tfMap["upload_speed"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
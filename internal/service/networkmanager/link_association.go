@@ -0,0 +1,137 @@
+package networkmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceLinkAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLinkAssociationCreate,
+		ReadWithoutTimeout:   resourceLinkAssociationRead,
+		DeleteWithoutTimeout: resourceLinkAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLinkAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	linkID := d.Get("link_id").(string)
+	deviceID := d.Get("device_id").(string)
+	id := LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID)
+	input := &networkmanager.AssociateLinkInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Link Association: %s", input)
+	_, err := conn.AssociateLinkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Link Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitLinkAssociationCreated(ctx, conn, globalNetworkID, linkID, deviceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Link Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceLinkAssociationRead(ctx, d, meta)
+}
+
+func resourceLinkAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID, linkID, deviceID, err := LinkAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindLinkAssociationByThreePartKey(ctx, conn, globalNetworkID, linkID, deviceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Link Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Link Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("device_id", output.DeviceId)
+	d.Set("global_network_id", output.GlobalNetworkId)
+	d.Set("link_id", output.LinkId)
+
+	return nil
+}
+
+func resourceLinkAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID, linkID, deviceID, err := LinkAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Link Association: %s", d.Id())
+	_, err = conn.DisassociateLinkWithContext(ctx, &networkmanager.DisassociateLinkInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Link Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitLinkAssociationDeleted(ctx, conn, globalNetworkID, linkID, deviceID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Link Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
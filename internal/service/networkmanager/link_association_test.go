@@ -0,0 +1,149 @@
+package networkmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccNetworkManagerLinkAssociation_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkAssociationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "device_id", "aws_networkmanager_device.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", "aws_networkmanager_global_network.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "link_id", "aws_networkmanager_link.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerLinkAssociation_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkAssociationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfnetworkmanager.ResourceLinkAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLinkAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link_association" {
+			continue
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfnetworkmanager.FindLinkAssociationByThreePartKey(context.Background(), conn, globalNetworkID, linkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Link Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLinkAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Link Association ID is set")
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err = tfnetworkmanager.FindLinkAssociationByThreePartKey(context.Background(), conn, globalNetworkID, linkID, deviceID)
+
+		return err
+	}
+}
+
+func testAccLinkAssociationConfig_basic() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+
+resource "aws_networkmanager_link_association" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+  device_id         = aws_networkmanager_device.test.id
+}
+`
+}
@@ -0,0 +1,198 @@
+package networkmanager_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmanager "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccNetworkManagerLink_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`link/global-network-.+/link-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", "aws_networkmanager_global_network.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "aws_networkmanager_site.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFuncByARN(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerLink_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfnetworkmanager.ResourceLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerLink_update(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(networkmanager.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkConfig_update("description1", "provider1", "type1", 10, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "20"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFuncByARN(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLinkConfig_update("description2", "provider2", "type2", 100, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "100"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinkDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link" {
+			continue
+		}
+
+		_, err := tfnetworkmanager.FindLinkByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Network Manager Link %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLinkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Network Manager Link ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn
+
+		_, err := tfnetworkmanager.FindLinkByTwoPartKey(context.Background(), conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLinkConfig_base() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccLinkConfig_basic() string {
+	return acctest.ConfigCompose(testAccLinkConfig_base(), `
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+`)
+}
+
+func testAccLinkConfig_update(description, providerName, linkType string, downloadSpeed, uploadSpeed int) string {
+	return acctest.ConfigCompose(testAccLinkConfig_base(), fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  description   = %[1]q
+  provider_name = %[2]q
+  type          = %[3]q
+
+  bandwidth {
+    download_speed = %[4]d
+    upload_speed   = %[5]d
+  }
+}
+`, description, providerName, linkType, downloadSpeed, uploadSpeed))
+}
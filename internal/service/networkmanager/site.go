@@ -0,0 +1,275 @@
+package networkmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSite() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSiteCreate,
+		ReadWithoutTimeout:   resourceSiteRead,
+		UpdateWithoutTimeout: resourceSiteUpdate,
+		DeleteWithoutTimeout: resourceSiteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateByARN,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": locationSchema(),
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func locationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"latitude": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"longitude": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceSiteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.CreateSiteInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Location = expandLocation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Site: %s", input)
+	output, err := conn.CreateSiteWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Site: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Site.SiteId))
+
+	if _, err := waitSiteCreated(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Site (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceSiteRead(ctx, d, meta)
+}
+
+func resourceSiteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	site, err := FindSiteByTwoPartKey(ctx, conn, globalNetworkID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Site (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Site (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", site.SiteArn)
+	d.Set("description", site.Description)
+	d.Set("global_network_id", site.GlobalNetworkId)
+	if site.Location != nil {
+		if err := d.Set("location", []interface{}{flattenLocation(site.Location)}); err != nil {
+			return diag.Errorf("error setting location: %s", err)
+		}
+	} else {
+		d.Set("location", nil)
+	}
+
+	tags := KeyValueTags(site.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		globalNetworkID := d.Get("global_network_id").(string)
+		input := &networkmanager.UpdateSiteInput{
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			SiteId:          aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Location = expandLocation(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Location = &networkmanager.Location{}
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Site: %s", input)
+		_, err := conn.UpdateSiteWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Network Manager Site (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSiteUpdated(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Network Manager Site (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Network Manager Site (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSiteRead(ctx, d, meta)
+}
+
+func resourceSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Site: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteSiteWithContext(ctx, &networkmanager.DeleteSiteInput{
+			GlobalNetworkId: aws.String(globalNetworkID),
+			SiteId:          aws.String(d.Id()),
+		})
+	}, networkmanager.ErrCodeConflictException)
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Site (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSiteDeleted(ctx, conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Site (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLocation(tfMap map[string]interface{}) *networkmanager.Location {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.Location{}
+
+	if v, ok := tfMap["address"].(string); ok && v != "" {
+		apiObject.Address = aws.String(v)
+	}
+
+	if v, ok := tfMap["latitude"].(string); ok && v != "" {
+		apiObject.Latitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["longitude"].(string); ok && v != "" {
+		apiObject.Longitude = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLocation(apiObject *networkmanager.Location) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Address; v != nil {
+		tfMap["address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Latitude; v != nil {
+		tfMap["latitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Longitude; v != nil {
+		tfMap["longitude"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
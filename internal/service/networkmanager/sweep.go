@@ -0,0 +1,524 @@
+//go:build sweep
+// +build sweep
+
+package networkmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+		Name: "aws_networkmanager_global_network",
+		F:    sweepGlobalNetworks,
+		Dependencies: []string{
+			"aws_networkmanager_core_network",
+			"aws_networkmanager_site",
+			"aws_networkmanager_transit_gateway_registration",
+		},
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+		Name: "aws_networkmanager_core_network",
+		F:    sweepCoreNetworks,
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+		Name: "aws_networkmanager_site",
+		F:    sweepSites,
+		Dependencies: []string{
+			"aws_networkmanager_device",
+			"aws_networkmanager_link",
+		},
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+		Name: "aws_networkmanager_device",
+		F:    sweepDevices,
+		Dependencies: []string{
+			"aws_networkmanager_connection",
+			"aws_networkmanager_link_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+		Name: "aws_networkmanager_link",
+		F:    sweepLinks,
+		Dependencies: []string{
+			"aws_networkmanager_connection",
+			"aws_networkmanager_link_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+		Name: "aws_networkmanager_link_association",
+		F:    sweepLinkAssociations,
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+		Name: "aws_networkmanager_connection",
+		F:    sweepConnections,
+	})
+
+	resource.AddTestSweepers("aws_networkmanager_transit_gateway_registration", &resource.Sweeper{
+		Name: "aws_networkmanager_transit_gateway_registration",
+		F:    sweepTransitGatewayRegistrations,
+	})
+}
+
+func sweepGlobalNetworks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Global Networks sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		r := ResourceGlobalNetwork()
+		d := r.Data(nil)
+		d.SetId(globalNetworkID)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepCoreNetworks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	err = conn.ListCoreNetworksPagesWithContext(ctx, &networkmanager.ListCoreNetworksInput{}, func(page *networkmanager.ListCoreNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CoreNetworks {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceCoreNetwork()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CoreNetworkId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Core Networks sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Core Networks (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Core Networks (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSites(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Sites sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		err := conn.GetSitesPagesWithContext(ctx, &networkmanager.GetSitesInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Sites {
+				if v == nil {
+					continue
+				}
+
+				r := ResourceSite()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.SiteId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Sites (%s): %w", region, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Sites (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDevices(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Devices sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		err := conn.GetDevicesPagesWithContext(ctx, &networkmanager.GetDevicesInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Devices {
+				if v == nil {
+					continue
+				}
+
+				r := ResourceDevice()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.DeviceId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Devices (%s): %w", region, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Devices (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepLinks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Links sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		err := conn.GetLinksPagesWithContext(ctx, &networkmanager.GetLinksInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Links {
+				if v == nil {
+					continue
+				}
+
+				r := ResourceLink()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.LinkId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Links (%s): %w", region, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Links (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepLinkAssociations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Link Associations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		err := conn.GetLinkAssociationsPagesWithContext(ctx, &networkmanager.GetLinkAssociationsInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.LinkAssociations {
+				if v == nil {
+					continue
+				}
+
+				r := ResourceLinkAssociation()
+				d := r.Data(nil)
+				d.SetId(LinkAssociationCreateResourceID(globalNetworkID, aws.StringValue(v.LinkId), aws.StringValue(v.DeviceId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Link Associations (%s): %w", region, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Link Associations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepConnections(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Connections sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		err := conn.GetConnectionsPagesWithContext(ctx, &networkmanager.GetConnectionsInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetConnectionsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Connections {
+				if v == nil {
+					continue
+				}
+
+				r := ResourceConnection()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.ConnectionId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Connections (%s): %w", region, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Connections (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepTransitGatewayRegistrations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	globalNetworkIDs, err := listGlobalNetworkIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Transit Gateway Registrations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	for _, globalNetworkID := range globalNetworkIDs {
+		err := conn.GetTransitGatewayRegistrationsPagesWithContext(ctx, &networkmanager.GetTransitGatewayRegistrationsInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.TransitGatewayRegistrations {
+				if v == nil {
+					continue
+				}
+
+				r := ResourceTransitGatewayRegistration()
+				d := r.Data(nil)
+				d.SetId(TransitGatewayRegistrationCreateResourceID(globalNetworkID, aws.StringValue(v.TransitGatewayArn)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Transit Gateway Registrations (%s): %w", region, err))
+		}
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager Transit Gateway Registrations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listGlobalNetworkIDs(ctx context.Context, conn *networkmanager.NetworkManager) ([]string, error) {
+	var globalNetworkIDs []string
+
+	err := conn.DescribeGlobalNetworksPagesWithContext(ctx, &networkmanager.DescribeGlobalNetworksInput{}, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GlobalNetworks {
+			if v == nil {
+				continue
+			}
+
+			globalNetworkIDs = append(globalNetworkIDs, aws.StringValue(v.GlobalNetworkId))
+		}
+
+		return !lastPage
+	})
+
+	return globalNetworkIDs, err
+}
@@ -0,0 +1,130 @@
+package networkmanager
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTransitGatewayRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayRegistrationCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayRegistrationRead,
+		DeleteWithoutTimeout: resourceTransitGatewayRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	transitGatewayARN := d.Get("transit_gateway_arn").(string)
+	id := TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN)
+	input := &networkmanager.RegisterTransitGatewayInput{
+		GlobalNetworkId:   aws.String(globalNetworkID),
+		TransitGatewayArn: aws.String(transitGatewayARN),
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Transit Gateway Registration: %s", input)
+	_, err := conn.RegisterTransitGatewayWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Network Manager Transit Gateway Registration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitTransitGatewayRegistrationCreated(ctx, conn, globalNetworkID, transitGatewayARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Transit Gateway Registration (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTransitGatewayRegistrationRead(ctx, d, meta)
+}
+
+func resourceTransitGatewayRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID, transitGatewayARN, err := TransitGatewayRegistrationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindTransitGatewayRegistrationByTwoPartKey(ctx, conn, globalNetworkID, transitGatewayARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Network Manager Transit Gateway Registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Network Manager Transit Gateway Registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("global_network_id", output.GlobalNetworkId)
+	d.Set("transit_gateway_arn", output.TransitGatewayArn)
+
+	return nil
+}
+
+func resourceTransitGatewayRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+
+	globalNetworkID, transitGatewayARN, err := TransitGatewayRegistrationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Network Manager Transit Gateway Registration: %s", d.Id())
+	_, err = conn.DeregisterTransitGatewayWithContext(ctx, &networkmanager.DeregisterTransitGatewayInput{
+		GlobalNetworkId:   aws.String(globalNetworkID),
+		TransitGatewayArn: aws.String(transitGatewayARN),
+	})
+
+	if globalNetworkIDNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Network Manager Transit Gateway Registration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTransitGatewayRegistrationDeleted(ctx, conn, globalNetworkID, transitGatewayARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Network Manager Transit Gateway Registration (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,439 @@
+package networkmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Timeout: timeout,
+		Refresh: statusGlobalNetworkState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Timeout: timeout,
+		Refresh: statusGlobalNetworkState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:        []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:         []string{},
+		Timeout:        timeout,
+		Refresh:        statusGlobalNetworkState(ctx, conn, id),
+		NotFoundChecks: 1,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSiteCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStatePending},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Timeout: timeout,
+		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSiteUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateUpdating},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Timeout: timeout,
+		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSiteDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusSiteState(ctx, conn, globalNetworkID, siteID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeviceCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStatePending},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Timeout: timeout,
+		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeviceUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateUpdating},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Timeout: timeout,
+		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeviceDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusDeviceState(ctx, conn, globalNetworkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLinkCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStatePending},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Timeout: timeout,
+		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLinkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateUpdating},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Timeout: timeout,
+		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLinkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusLinkState(ctx, conn, globalNetworkID, linkID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLinkAssociationCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStatePending},
+		Target:  []string{networkmanager.LinkAssociationStateAvailable},
+		Timeout: timeout,
+		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLinkAssociationDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, connectionID string, timeout time.Duration) (*networkmanager.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ConnectionStatePending},
+		Target:  []string{networkmanager.ConnectionStateAvailable},
+		Timeout: timeout,
+		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionUpdated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, connectionID string, timeout time.Duration) (*networkmanager.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ConnectionStateUpdating},
+		Target:  []string{networkmanager.ConnectionStateAvailable},
+		Timeout: timeout,
+		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectionDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, connectionID string, timeout time.Duration) (*networkmanager.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ConnectionStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayRegistrationCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Timeout: timeout,
+		Refresh: statusTransitGatewayRegistrationState(ctx, conn, globalNetworkID, transitGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if state := output.State; state != nil && aws.StringValue(state.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(state.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayRegistrationDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateAvailable, networkmanager.TransitGatewayRegistrationStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusTransitGatewayRegistrationState(ctx, conn, globalNetworkID, transitGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if state := output.State; state != nil && aws.StringValue(state.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(state.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkCreated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateCreating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkState(ctx, conn, id),
+		Delay:   5 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateUpdating},
+		Target:  []string{networkmanager.CoreNetworkStateAvailable},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkState(ctx, conn, id),
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.CoreNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.CoreNetworkStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkState(ctx, conn, id),
+		Delay:   5 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCoreNetworkPolicyChangeSetReady(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID string, policyVersionID int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.ChangeSetStatePendingGeneration},
+		Target:  []string{networkmanager.ChangeSetStateReadyToExecute},
+		Timeout: timeout,
+		Refresh: statusCoreNetworkPolicyChangeSetState(ctx, conn, coreNetworkID, policyVersionID),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		if state := aws.StringValue(output.ChangeSetState); state == networkmanager.ChangeSetStateFailedGeneration {
+			tfresource.SetLastError(err, coreNetworkPolicyErrorsError(output.PolicyErrors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func coreNetworkPolicyErrorsError(apiObjects []*networkmanager.CoreNetworkPolicyError) error {
+	var errs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.Message)))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
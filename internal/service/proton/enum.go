@@ -0,0 +1,13 @@
+package proton
+
+import (
+	"github.com/aws/aws-sdk-go/service/proton"
+)
+
+// templateVersionStatus_Values returns the template version statuses that can be set in configuration.
+func templateVersionStatus_Values() []string {
+	return []string{
+		proton.TemplateVersionStatusDraft,
+		proton.TemplateVersionStatusPublished,
+	}
+}
@@ -0,0 +1,218 @@
+package proton
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironmentAccountConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentAccountConnectionCreate,
+		ReadWithoutTimeout:   resourceEnvironmentAccountConnectionRead,
+		UpdateWithoutTimeout: resourceEnvironmentAccountConnectionUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentAccountConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"codebuild_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"component_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"environment_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"management_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceEnvironmentAccountConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	environmentName := d.Get("environment_name").(string)
+	input := &proton.CreateEnvironmentAccountConnectionInput{
+		EnvironmentName:     aws.String(environmentName),
+		ManagementAccountId: aws.String(d.Get("management_account_id").(string)),
+	}
+
+	if v, ok := d.GetOk("codebuild_role_arn"); ok {
+		input.CodebuildRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("component_role_arn"); ok {
+		input.ComponentRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Environment Account Connection: %s", input)
+	output, err := conn.CreateEnvironmentAccountConnectionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Proton Environment Account Connection (%s): %s", environmentName, err)
+	}
+
+	d.SetId(aws.StringValue(output.EnvironmentAccountConnection.Id))
+
+	return resourceEnvironmentAccountConnectionRead(ctx, d, meta)
+}
+
+func resourceEnvironmentAccountConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	connection, err := FindEnvironmentAccountConnectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment Account Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Proton Environment Account Connection (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(connection.Arn)
+	d.Set("arn", arn)
+	d.Set("codebuild_role_arn", connection.CodebuildRoleArn)
+	d.Set("component_role_arn", connection.ComponentRoleArn)
+	d.Set("environment_account_id", connection.EnvironmentAccountId)
+	d.Set("environment_name", connection.EnvironmentName)
+	d.Set("management_account_id", connection.ManagementAccountId)
+	d.Set("role_arn", connection.RoleArn)
+	d.Set("status", connection.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Proton Environment Account Connection (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentAccountConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("codebuild_role_arn", "component_role_arn", "role_arn") {
+		input := &proton.UpdateEnvironmentAccountConnectionInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("codebuild_role_arn") {
+			input.CodebuildRoleArn = aws.String(d.Get("codebuild_role_arn").(string))
+		}
+
+		if d.HasChange("component_role_arn") {
+			input.ComponentRoleArn = aws.String(d.Get("component_role_arn").(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Proton Environment Account Connection: %s", input)
+		_, err := conn.UpdateEnvironmentAccountConnectionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Proton Environment Account Connection (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Proton Environment Account Connection (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentAccountConnectionRead(ctx, d, meta)
+}
+
+func resourceEnvironmentAccountConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[DEBUG] Deleting Proton Environment Account Connection: %s", d.Id())
+	_, err := conn.DeleteEnvironmentAccountConnectionWithContext(ctx, &proton.DeleteEnvironmentAccountConnectionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Proton Environment Account Connection (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
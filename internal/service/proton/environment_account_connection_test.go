@@ -0,0 +1,217 @@
+package proton_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonEnvironmentAccountConnection_basic(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_account_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, proton.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckEnvironmentAccountConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentAccountConnectionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentAccountConnectionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "codebuild_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "component_role_arn", ""),
+					acctest.CheckResourceAttrAccountID(resourceName, "environment_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "environment_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "management_account_id", "data.aws_caller_identity.management", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PENDING"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentAccountConnection_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_account_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, proton.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckEnvironmentAccountConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentAccountConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentAccountConnectionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceEnvironmentAccountConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentAccountConnection_update(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_account_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, proton.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckEnvironmentAccountConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentAccountConnectionConfig_roles(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentAccountConnectionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "codebuild_role_arn", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "component_role_arn", "aws_iam_role.test.0", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test.0", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEnvironmentAccountConnectionConfig_roles(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentAccountConnectionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "codebuild_role_arn", "aws_iam_role.test.1", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "component_role_arn", "aws_iam_role.test.1", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentAccountConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_environment_account_connection" {
+			continue
+		}
+
+		_, err := tfproton.FindEnvironmentAccountConnectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Environment Account Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEnvironmentAccountConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Environment Account Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err := tfproton.FindEnvironmentAccountConnectionByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccEnvironmentAccountConnectionConfigBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "management" {
+  provider = awsalternate
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "proton.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccEnvironmentAccountConnectionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentAccountConnectionConfigBase(rName), fmt.Sprintf(`
+resource "aws_proton_environment_account_connection" "test" {
+  environment_name      = %[1]q
+  management_account_id = data.aws_caller_identity.management.account_id
+  role_arn              = aws_iam_role.test[0].arn
+}
+`, rName))
+}
+
+func testAccEnvironmentAccountConnectionConfig_roles(rName string, roleIndex int) string {
+	return acctest.ConfigCompose(testAccEnvironmentAccountConnectionConfigBase(rName), fmt.Sprintf(`
+resource "aws_proton_environment_account_connection" "test" {
+  environment_name      = %[1]q
+  management_account_id = data.aws_caller_identity.management.account_id
+  codebuild_role_arn    = aws_iam_role.test[%[2]d].arn
+  component_role_arn    = aws_iam_role.test[%[2]d].arn
+  role_arn              = aws_iam_role.test[%[2]d].arn
+}
+`, rName, roleIndex))
+}
@@ -0,0 +1,340 @@
+package proton
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEnvironmentTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceEnvironmentTemplateVersionRead,
+		UpdateWithoutTimeout: resourceEnvironmentTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentTemplateVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			forceNewIfTemplateVersionUnpublished,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"major_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recommended_minor_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": templateVersionSourceSchema(),
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      proton.TemplateVersionStatusDraft,
+				ValidateFunc: validation.StringInSlice(templateVersionStatus_Values(), false),
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+		},
+	}
+}
+
+func templateVersionSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"s3": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bucket": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"key": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// A published template version can't be returned to draft, so replace it instead.
+var forceNewIfTemplateVersionUnpublished = customdiff.ForceNewIfChange("status", func(_ context.Context, old, new, meta interface{}) bool {
+	return old.(string) == proton.TemplateVersionStatusPublished && new.(string) == proton.TemplateVersionStatusDraft
+})
+
+func resourceEnvironmentTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	templateName := d.Get("template_name").(string)
+	input := &proton.CreateEnvironmentTemplateVersionInput{
+		Source:       expandTemplateVersionSourceInput(d.Get("source").([]interface{})),
+		TemplateName: aws.String(templateName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("major_version"); ok {
+		input.MajorVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Environment Template Version: %s", input)
+	output, err := conn.CreateEnvironmentTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Proton Environment Template Version (%s): %s", templateName, err)
+	}
+
+	majorVersion := aws.StringValue(output.EnvironmentTemplateVersion.MajorVersion)
+	minorVersion := aws.StringValue(output.EnvironmentTemplateVersion.MinorVersion)
+	d.SetId(TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion))
+
+	if _, err := waitEnvironmentTemplateVersionRegistered(ctx, conn, templateName, majorVersion, minorVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Proton Environment Template Version (%s) register: %s", d.Id(), err)
+	}
+
+	if v := d.Get("status").(string); v == proton.TemplateVersionStatusPublished {
+		if err := updateEnvironmentTemplateVersionStatus(ctx, conn, templateName, majorVersion, minorVersion, v); err != nil {
+			return diag.Errorf("error publishing Proton Environment Template Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceEnvironmentTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	version, err := FindEnvironmentTemplateVersionByThreePartKey(ctx, conn, templateName, majorVersion, minorVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Environment Template Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Proton Environment Template Version (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(version.Arn)
+	d.Set("arn", arn)
+	d.Set("description", version.Description)
+	d.Set("major_version", version.MajorVersion)
+	d.Set("minor_version", version.MinorVersion)
+	d.Set("recommended_minor_version", version.RecommendedMinorVersion)
+	d.Set("schema", version.Schema)
+	d.Set("status", version.Status)
+	d.Set("status_message", version.StatusMessage)
+	d.Set("template_name", version.TemplateName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Proton Environment Template Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "status") {
+		input := &proton.UpdateEnvironmentTemplateVersionInput{
+			Description:  aws.String(d.Get("description").(string)),
+			MajorVersion: aws.String(majorVersion),
+			MinorVersion: aws.String(minorVersion),
+			Status:       aws.String(d.Get("status").(string)),
+			TemplateName: aws.String(templateName),
+		}
+
+		log.Printf("[DEBUG] Updating Proton Environment Template Version: %s", input)
+		_, err := conn.UpdateEnvironmentTemplateVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Proton Environment Template Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Proton Environment Template Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEnvironmentTemplateVersionRead(ctx, d, meta)
+}
+
+func resourceEnvironmentTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	templateName, majorVersion, minorVersion, err := TemplateVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Proton Environment Template Version: %s", d.Id())
+	_, err = conn.DeleteEnvironmentTemplateVersionWithContext(ctx, &proton.DeleteEnvironmentTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Proton Environment Template Version (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateEnvironmentTemplateVersionStatus(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion, status string) error {
+	input := &proton.UpdateEnvironmentTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		Status:       aws.String(status),
+		TemplateName: aws.String(templateName),
+	}
+
+	log.Printf("[DEBUG] Updating Proton Environment Template Version status: %s", input)
+	_, err := conn.UpdateEnvironmentTemplateVersionWithContext(ctx, input)
+
+	return err
+}
+
+func expandTemplateVersionSourceInput(tfList []interface{}) *proton.TemplateVersionSourceInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &proton.TemplateVersionSourceInput{}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3 = expandS3ObjectSource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3ObjectSource(tfMap map[string]interface{}) *proton.S3ObjectSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &proton.S3ObjectSource{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
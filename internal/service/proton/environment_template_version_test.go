@@ -0,0 +1,307 @@
+package proton_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonEnvironmentTemplateVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "proton", fmt.Sprintf("environment-template/%s:1.0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "major_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minor_version", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceEnvironmentTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+	templateResourceName := "aws_proton_environment_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_status(rName, "description 1", "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+				),
+			},
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_status(rName, "description 2", "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PUBLISHED"),
+				),
+			},
+			{
+				// Refresh the template to pick up its recommended version.
+				Config: testAccEnvironmentTemplateVersionConfig_status(rName, "description 2", "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(templateResourceName, "recommended_version", "1.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccProtonEnvironmentTemplateVersion_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_environment_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEnvironmentTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEnvironmentTemplateVersionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentTemplateVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_environment_template_version" {
+			continue
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfproton.FindEnvironmentTemplateVersionByThreePartKey(context.Background(), conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Environment Template Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEnvironmentTemplateVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Environment Template Version ID is set")
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err = tfproton.FindEnvironmentTemplateVersionByThreePartKey(context.Background(), conn, templateName, majorVersion, minorVersion)
+
+		return err
+	}
+}
+
+func testAccEnvironmentTemplateVersionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "environment-template.tar.gz"
+  source = "test-fixtures/environment-template.tar.gz"
+}
+
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccEnvironmentTemplateVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentTemplateVersionConfigBase(rName), `
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+
+  source {
+    s3 {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`)
+}
+
+func testAccEnvironmentTemplateVersionConfig_status(rName, description, status string) string {
+	return acctest.ConfigCompose(testAccEnvironmentTemplateVersionConfigBase(rName), fmt.Sprintf(`
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+  description   = %[1]q
+  status        = %[2]q
+
+  source {
+    s3 {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`, description, status))
+}
+
+func testAccEnvironmentTemplateVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccEnvironmentTemplateVersionConfigBase(rName), fmt.Sprintf(`
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+
+  source {
+    s3 {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccEnvironmentTemplateVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccEnvironmentTemplateVersionConfigBase(rName), fmt.Sprintf(`
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+
+  source {
+    s3 {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
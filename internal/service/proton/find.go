@@ -0,0 +1,166 @@
+package proton
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindEnvironmentAccountConnectionByID(ctx context.Context, conn *proton.Proton, id string) (*proton.EnvironmentAccountConnection, error) {
+	input := &proton.GetEnvironmentAccountConnectionInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetEnvironmentAccountConnectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnvironmentAccountConnection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnvironmentAccountConnection, nil
+}
+
+func FindEnvironmentTemplateByName(ctx context.Context, conn *proton.Proton, name string) (*proton.EnvironmentTemplate, error) {
+	input := &proton.GetEnvironmentTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEnvironmentTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnvironmentTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnvironmentTemplate, nil
+}
+
+func FindEnvironmentTemplateVersionByThreePartKey(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string) (*proton.EnvironmentTemplateVersion, error) {
+	input := &proton.GetEnvironmentTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	}
+
+	output, err := conn.GetEnvironmentTemplateVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EnvironmentTemplateVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EnvironmentTemplateVersion, nil
+}
+
+func FindRepositoryByTwoPartKey(ctx context.Context, conn *proton.Proton, provider, name string) (*proton.Repository, error) {
+	input := &proton.GetRepositoryInput{
+		Name:     aws.String(name),
+		Provider: aws.String(provider),
+	}
+
+	output, err := conn.GetRepositoryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Repository == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Repository, nil
+}
+
+func FindServiceTemplateByName(ctx context.Context, conn *proton.Proton, name string) (*proton.ServiceTemplate, error) {
+	input := &proton.GetServiceTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetServiceTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceTemplate, nil
+}
+
+func FindServiceTemplateVersionByThreePartKey(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string) (*proton.ServiceTemplateVersion, error) {
+	input := &proton.GetServiceTemplateVersionInput{
+		MajorVersion: aws.String(majorVersion),
+		MinorVersion: aws.String(minorVersion),
+		TemplateName: aws.String(templateName),
+	}
+
+	output, err := conn.GetServiceTemplateVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceTemplateVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceTemplateVersion, nil
+}
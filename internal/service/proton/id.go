@@ -0,0 +1,45 @@
+package proton
+
+import (
+	"fmt"
+	"strings"
+)
+
+const templateVersionResourceIDSeparator = ":"
+
+func TemplateVersionCreateResourceID(templateName, majorVersion, minorVersion string) string {
+	parts := []string{templateName, majorVersion, minorVersion}
+	id := strings.Join(parts, templateVersionResourceIDSeparator)
+
+	return id
+}
+
+func TemplateVersionParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, templateVersionResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TEMPLATENAME%[2]sMAJORVERSION%[2]sMINORVERSION", id, templateVersionResourceIDSeparator)
+}
+
+const repositoryResourceIDSeparator = ":"
+
+func RepositoryCreateResourceID(provider, name string) string {
+	parts := []string{provider, name}
+	id := strings.Join(parts, repositoryResourceIDSeparator)
+
+	return id
+}
+
+func RepositoryParseResourceID(id string) (string, string, error) {
+	// Repository names are of the form "owner/repository" and do not contain the separator.
+	parts := strings.SplitN(id, repositoryResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PROVIDER%[2]sNAME", id, repositoryResourceIDSeparator)
+}
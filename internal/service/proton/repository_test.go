@@ -0,0 +1,127 @@
+package proton_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonRepository_basic(t *testing.T) {
+	connectionARN := os.Getenv("PROTON_REPOSITORY_CONNECTION_ARN")
+	if connectionARN == "" {
+		t.Skip(
+			"Environment variable PROTON_REPOSITORY_CONNECTION_ARN is not set. " +
+				"This environment variable must be set to the ARN of an available " +
+				"CodeStar connection to GitHub to enable the test.")
+	}
+
+	repositoryName := os.Getenv("PROTON_REPOSITORY_NAME")
+	if repositoryName == "" {
+		t.Skip(
+			"Environment variable PROTON_REPOSITORY_NAME is not set. " +
+				"This environment variable must be set to the name of a GitHub repository " +
+				"(\"owner/repository\") accessible via the connection to enable the test.")
+	}
+
+	resourceName := "aws_proton_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryConfig_basic(connectionARN, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "connection_arn", connectionARN),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", repositoryName),
+					resource.TestCheckResourceAttr(resourceName, "repository_provider", "GITHUB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_repository" {
+			continue
+		}
+
+		provider, name, err := tfproton.RepositoryParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfproton.FindRepositoryByTwoPartKey(context.Background(), conn, provider, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Repository %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRepositoryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Repository ID is set")
+		}
+
+		provider, name, err := tfproton.RepositoryParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err = tfproton.FindRepositoryByTwoPartKey(context.Background(), conn, provider, name)
+
+		return err
+	}
+}
+
+func testAccRepositoryConfig_basic(connectionARN, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "aws_proton_repository" "test" {
+  connection_arn      = %[1]q
+  name                = %[2]q
+  repository_provider = "GITHUB"
+}
+`, connectionARN, repositoryName)
+}
@@ -0,0 +1,212 @@
+package proton
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServiceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceTemplateCreate,
+		ReadWithoutTimeout:   resourceServiceTemplateRead,
+		UpdateWithoutTimeout: resourceServiceTemplateUpdate,
+		DeleteWithoutTimeout: resourceServiceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTemplateName,
+			},
+			"pipeline_provisioning": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(proton.Provisioning_Values(), false),
+			},
+			"recommended_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceServiceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &proton.CreateServiceTemplateInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pipeline_provisioning"); ok {
+		input.PipelineProvisioning = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Proton Service Template: %s", input)
+	_, err := conn.CreateServiceTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Proton Service Template (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceServiceTemplateRead(ctx, d, meta)
+}
+
+func resourceServiceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	template, err := FindServiceTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Proton Service Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Proton Service Template (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(template.Arn)
+	d.Set("arn", arn)
+	d.Set("description", template.Description)
+	d.Set("display_name", template.DisplayName)
+	d.Set("encryption_key", template.EncryptionKey)
+	d.Set("name", template.Name)
+	d.Set("pipeline_provisioning", template.PipelineProvisioning)
+	d.Set("recommended_version", template.RecommendedVersion)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Proton Service Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceServiceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	if d.HasChanges("description", "display_name") {
+		input := &proton.UpdateServiceTemplateInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("display_name"); ok {
+			input.DisplayName = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Proton Service Template: %s", input)
+		_, err := conn.UpdateServiceTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Proton Service Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Proton Service Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceServiceTemplateRead(ctx, d, meta)
+}
+
+func resourceServiceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ProtonConn
+
+	log.Printf("[DEBUG] Deleting Proton Service Template: %s", d.Id())
+	_, err := conn.DeleteServiceTemplateWithContext(ctx, &proton.DeleteServiceTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, proton.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Proton Service Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
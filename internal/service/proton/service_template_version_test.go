@@ -0,0 +1,257 @@
+package proton_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/proton"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfproton "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccProtonServiceTemplateVersion_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceTemplateVersionExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "proton", fmt.Sprintf("service-template/%s:1.0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "compatible_environment_template.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "compatible_environment_template.*", map[string]string{
+						"major_version": "1",
+						"template_name": rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "major_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minor_version", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "supported_component_sources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func TestAccProtonServiceTemplateVersion_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateVersionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfproton.ResourceServiceTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProtonServiceTemplateVersion_status(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_proton_service_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, proton.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServiceTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceTemplateVersionConfig_status(rName, "description 1", "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "supported_component_sources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "supported_component_sources.*", "DIRECTLY_DEFINED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+			{
+				Config: testAccServiceTemplateVersionConfig_status(rName, "description 2", "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceTemplateVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PUBLISHED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceTemplateVersionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_proton_service_template_version" {
+			continue
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfproton.FindServiceTemplateVersionByThreePartKey(context.Background(), conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Proton Service Template Version %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckServiceTemplateVersionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Proton Service Template Version ID is set")
+		}
+
+		templateName, majorVersion, minorVersion, err := tfproton.TemplateVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ProtonConn
+
+		_, err = tfproton.FindServiceTemplateVersionByThreePartKey(context.Background(), conn, templateName, majorVersion, minorVersion)
+
+		return err
+	}
+}
+
+func testAccServiceTemplateVersionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "environment" {
+  bucket = aws_s3_bucket.test.id
+  key    = "environment-template.tar.gz"
+  source = "test-fixtures/environment-template.tar.gz"
+}
+
+resource "aws_s3_object" "service" {
+  bucket = aws_s3_bucket.test.id
+  key    = "service-template.tar.gz"
+  source = "test-fixtures/service-template.tar.gz"
+}
+
+resource "aws_proton_environment_template" "test" {
+  name = %[1]q
+}
+
+resource "aws_proton_environment_template_version" "test" {
+  template_name = aws_proton_environment_template.test.name
+  status        = "PUBLISHED"
+
+  source {
+    s3 {
+      bucket = aws_s3_object.environment.bucket
+      key    = aws_s3_object.environment.key
+    }
+  }
+}
+
+resource "aws_proton_service_template" "test" {
+  name                  = %[1]q
+  pipeline_provisioning = "CUSTOMER_MANAGED"
+}
+`, rName)
+}
+
+func testAccServiceTemplateVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceTemplateVersionConfigBase(rName), `
+resource "aws_proton_service_template_version" "test" {
+  template_name = aws_proton_service_template.test.name
+
+  compatible_environment_template {
+    template_name = aws_proton_environment_template_version.test.template_name
+    major_version = aws_proton_environment_template_version.test.major_version
+  }
+
+  source {
+    s3 {
+      bucket = aws_s3_object.service.bucket
+      key    = aws_s3_object.service.key
+    }
+  }
+}
+`)
+}
+
+func testAccServiceTemplateVersionConfig_status(rName, description, status string) string {
+	return acctest.ConfigCompose(testAccServiceTemplateVersionConfigBase(rName), fmt.Sprintf(`
+resource "aws_proton_service_template_version" "test" {
+  template_name               = aws_proton_service_template.test.name
+  description                 = %[1]q
+  status                      = %[2]q
+  supported_component_sources = ["DIRECTLY_DEFINED"]
+
+  compatible_environment_template {
+    template_name = aws_proton_environment_template_version.test.template_name
+    major_version = aws_proton_environment_template_version.test.major_version
+  }
+
+  source {
+    s3 {
+      bucket = aws_s3_object.service.bucket
+      key    = aws_s3_object.service.key
+    }
+  }
+}
+`, description, status))
+}
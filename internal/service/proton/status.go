@@ -0,0 +1,42 @@
+package proton
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusEnvironmentTemplateVersion(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentTemplateVersionByThreePartKey(ctx, conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusServiceTemplateVersion(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceTemplateVersionByThreePartKey(ctx, conn, templateName, majorVersion, minorVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,403 @@
+//go:build sweep
+// +build sweep
+
+package proton
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_proton_environment_account_connection", &resource.Sweeper{
+		Name: "aws_proton_environment_account_connection",
+		F:    sweepEnvironmentAccountConnections,
+	})
+
+	resource.AddTestSweepers("aws_proton_environment_template", &resource.Sweeper{
+		Name: "aws_proton_environment_template",
+		F:    sweepEnvironmentTemplates,
+		Dependencies: []string{
+			"aws_proton_environment_template_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_proton_environment_template_version", &resource.Sweeper{
+		Name: "aws_proton_environment_template_version",
+		F:    sweepEnvironmentTemplateVersions,
+	})
+
+	resource.AddTestSweepers("aws_proton_repository", &resource.Sweeper{
+		Name: "aws_proton_repository",
+		F:    sweepRepositories,
+	})
+
+	resource.AddTestSweepers("aws_proton_service_template", &resource.Sweeper{
+		Name: "aws_proton_service_template",
+		F:    sweepServiceTemplates,
+		Dependencies: []string{
+			"aws_proton_service_template_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_proton_service_template_version", &resource.Sweeper{
+		Name: "aws_proton_service_template_version",
+		F:    sweepServiceTemplateVersions,
+	})
+}
+
+func sweepEnvironmentAccountConnections(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ProtonConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &proton.ListEnvironmentAccountConnectionsInput{
+		RequestedBy: aws.String(proton.EnvironmentAccountConnectionRequesterAccountTypeEnvironmentAccount),
+	}
+
+	err = conn.ListEnvironmentAccountConnectionsPagesWithContext(ctx, input, func(page *proton.ListEnvironmentAccountConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, connection := range page.EnvironmentAccountConnections {
+			if connection == nil {
+				continue
+			}
+
+			r := ResourceEnvironmentAccountConnection()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(connection.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Environment Account Connections sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Environment Account Connections (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Environment Account Connections (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepEnvironmentTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ProtonConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &proton.ListEnvironmentTemplatesInput{}
+
+	err = conn.ListEnvironmentTemplatesPagesWithContext(ctx, input, func(page *proton.ListEnvironmentTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, template := range page.Templates {
+			if template == nil {
+				continue
+			}
+
+			r := ResourceEnvironmentTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(template.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Environment Templates sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Environment Templates (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Environment Templates (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepEnvironmentTemplateVersions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ProtonConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &proton.ListEnvironmentTemplatesInput{}
+
+	err = conn.ListEnvironmentTemplatesPagesWithContext(ctx, input, func(page *proton.ListEnvironmentTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, template := range page.Templates {
+			if template == nil {
+				continue
+			}
+
+			templateName := aws.StringValue(template.Name)
+			input := &proton.ListEnvironmentTemplateVersionsInput{
+				TemplateName: aws.String(templateName),
+			}
+
+			err := conn.ListEnvironmentTemplateVersionsPagesWithContext(ctx, input, func(page *proton.ListEnvironmentTemplateVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, version := range page.TemplateVersions {
+					if version == nil {
+						continue
+					}
+
+					r := ResourceEnvironmentTemplateVersion()
+					d := r.Data(nil)
+					d.SetId(TemplateVersionCreateResourceID(templateName, aws.StringValue(version.MajorVersion), aws.StringValue(version.MinorVersion)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Proton Environment Template Versions for template (%s): %w", templateName, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Environment Template Versions sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Environment Templates (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Environment Template Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepRepositories(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ProtonConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &proton.ListRepositoriesInput{}
+
+	err = conn.ListRepositoriesPagesWithContext(ctx, input, func(page *proton.ListRepositoriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, repository := range page.Repositories {
+			if repository == nil {
+				continue
+			}
+
+			r := ResourceRepository()
+			d := r.Data(nil)
+			d.SetId(RepositoryCreateResourceID(aws.StringValue(repository.Provider), aws.StringValue(repository.Name)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Repositories sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Repositories (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Repositories (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepServiceTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ProtonConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &proton.ListServiceTemplatesInput{}
+
+	err = conn.ListServiceTemplatesPagesWithContext(ctx, input, func(page *proton.ListServiceTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, template := range page.Templates {
+			if template == nil {
+				continue
+			}
+
+			r := ResourceServiceTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(template.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Service Templates sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Service Templates (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Service Templates (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepServiceTemplateVersions(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ProtonConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &proton.ListServiceTemplatesInput{}
+
+	err = conn.ListServiceTemplatesPagesWithContext(ctx, input, func(page *proton.ListServiceTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, template := range page.Templates {
+			if template == nil {
+				continue
+			}
+
+			templateName := aws.StringValue(template.Name)
+			input := &proton.ListServiceTemplateVersionsInput{
+				TemplateName: aws.String(templateName),
+			}
+
+			err := conn.ListServiceTemplateVersionsPagesWithContext(ctx, input, func(page *proton.ListServiceTemplateVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, version := range page.TemplateVersions {
+					if version == nil {
+						continue
+					}
+
+					r := ResourceServiceTemplateVersion()
+					d := r.Data(nil)
+					d.SetId(TemplateVersionCreateResourceID(templateName, aws.StringValue(version.MajorVersion), aws.StringValue(version.MinorVersion)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Proton Service Template Versions for template (%s): %w", templateName, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Proton Service Template Versions sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Proton Service Templates (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Proton Service Template Versions (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
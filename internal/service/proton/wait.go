@@ -0,0 +1,54 @@
+package proton
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitEnvironmentTemplateVersionRegistered(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string, timeout time.Duration) (*proton.EnvironmentTemplateVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.TemplateVersionStatusRegistrationInProgress},
+		Target:  []string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished},
+		Refresh: statusEnvironmentTemplateVersion(ctx, conn, templateName, majorVersion, minorVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.EnvironmentTemplateVersion); ok {
+		if status := aws.StringValue(output.Status); status == proton.TemplateVersionStatusRegistrationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceTemplateVersionRegistered(ctx context.Context, conn *proton.Proton, templateName, majorVersion, minorVersion string, timeout time.Duration) (*proton.ServiceTemplateVersion, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{proton.TemplateVersionStatusRegistrationInProgress},
+		Target:  []string{proton.TemplateVersionStatusDraft, proton.TemplateVersionStatusPublished},
+		Refresh: statusServiceTemplateVersion(ctx, conn, templateName, majorVersion, minorVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*proton.ServiceTemplateVersion); ok {
+		if status := aws.StringValue(output.Status); status == proton.TemplateVersionStatusRegistrationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,190 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContact() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactCreate,
+		ReadWithoutTimeout:   resourceContactRead,
+		UpdateWithoutTimeout: resourceContactUpdate,
+		DeleteWithoutTimeout: resourceContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9_\-]+$`), "must contain only lowercase alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// On-call schedules are created with their rotations and are not supported.
+				ValidateFunc: validation.StringInSlice([]string{
+					ssmcontacts.ContactTypeEscalation,
+					ssmcontacts.ContactTypePersonal,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	alias := d.Get("alias").(string)
+	input := &ssmcontacts.CreateContactInput{
+		Alias: aws.String(alias),
+		// The engagement plan is managed by the aws_ssmcontacts_plan resource.
+		Plan: &ssmcontacts.Plan{
+			Stages: []*ssmcontacts.Stage{},
+		},
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Contact: %s", input)
+	output, err := conn.CreateContactWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Contacts Contact (%s): %s", alias, err)
+	}
+
+	d.SetId(aws.StringValue(output.ContactArn))
+
+	return resourceContactRead(ctx, d, meta)
+}
+
+func resourceContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	contact, err := FindContactByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Contact (%s): %s", d.Id(), err)
+	}
+
+	d.Set("alias", contact.Alias)
+	d.Set("arn", contact.ContactArn)
+	d.Set("display_name", contact.DisplayName)
+	d.Set("type", contact.Type)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Contacts Contact (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChange("display_name") {
+		input := &ssmcontacts.UpdateContactInput{
+			ContactId:   aws.String(d.Id()),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Contact: %s", input)
+		_, err := conn.UpdateContactWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating SSM Contacts Contact (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating SSM Contacts Contact (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContactRead(ctx, d, meta)
+}
+
+func resourceContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Contact: %s", d.Id())
+	_, err := conn.DeleteContactWithContext(ctx, &ssmcontacts.DeleteContactInput{
+		ContactId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Contacts Contact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
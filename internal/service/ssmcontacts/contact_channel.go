@@ -0,0 +1,196 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceContactChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactChannelCreate,
+		ReadWithoutTimeout:   resourceContactChannelRead,
+		UpdateWithoutTimeout: resourceContactChannelUpdate,
+		DeleteWithoutTimeout: resourceContactChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"delivery_address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 320),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmcontacts.ChannelType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceContactChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	name := d.Get("name").(string)
+	input := &ssmcontacts.CreateContactChannelInput{
+		ContactId: aws.String(d.Get("contact_id").(string)),
+		// An activation code is sent to the delivery address straight away.
+		// The channel is activated by the aws_ssmcontacts_contact_channel_activation resource.
+		DeferActivation: aws.Bool(false),
+		DeliveryAddress: expandContactChannelAddress(d.Get("delivery_address").([]interface{})),
+		Name:            aws.String(name),
+		Type:            aws.String(d.Get("type").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Contact Channel: %s", input)
+	output, err := conn.CreateContactChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Contacts Contact Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ContactChannelArn))
+
+	return resourceContactChannelRead(ctx, d, meta)
+}
+
+func resourceContactChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	channel, err := FindContactChannelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("activation_status", channel.ActivationStatus)
+	d.Set("arn", channel.ContactChannelArn)
+	d.Set("contact_id", channel.ContactArn)
+	if err := d.Set("delivery_address", flattenContactChannelAddress(channel.DeliveryAddress)); err != nil {
+		return diag.Errorf("error setting delivery_address: %s", err)
+	}
+	d.Set("name", channel.Name)
+	d.Set("type", channel.Type)
+
+	return nil
+}
+
+func resourceContactChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	input := &ssmcontacts.UpdateContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	}
+
+	// Changing the delivery address deactivates the channel.
+	if d.HasChange("delivery_address") {
+		input.DeliveryAddress = expandContactChannelAddress(d.Get("delivery_address").([]interface{}))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating SSM Contacts Contact Channel: %s", input)
+	_, err := conn.UpdateContactChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	return resourceContactChannelRead(ctx, d, meta)
+}
+
+func resourceContactChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Contact Channel: %s", d.Id())
+	_, err := conn.DeleteContactChannelWithContext(ctx, &ssmcontacts.DeleteContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandContactChannelAddress(tfList []interface{}) *ssmcontacts.ContactChannelAddress {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ssmcontacts.ContactChannelAddress{}
+
+	if v, ok := tfMap["simple_address"].(string); ok && v != "" {
+		apiObject.SimpleAddress = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenContactChannelAddress(apiObject *ssmcontacts.ContactChannelAddress) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"simple_address": aws.StringValue(apiObject.SimpleAddress),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,115 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceContactChannelActivation activates a contact channel with the code sent to its delivery address.
+// Keeping activation out of aws_ssmcontacts_contact_channel means creating a channel never waits on a human;
+// the code is supplied in a later apply once it has been received.
+func ResourceContactChannelActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactChannelActivationCreate,
+		ReadWithoutTimeout:   resourceContactChannelActivationRead,
+		DeleteWithoutTimeout: resourceContactChannelActivationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"activation_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(6, 10),
+			},
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_channel_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceContactChannelActivationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	channelID := d.Get("contact_channel_id").(string)
+	input := &ssmcontacts.ActivateContactChannelInput{
+		ActivationCode:   aws.String(d.Get("activation_code").(string)),
+		ContactChannelId: aws.String(channelID),
+	}
+
+	log.Printf("[DEBUG] Activating SSM Contacts Contact Channel: %s", channelID)
+	_, err := conn.ActivateContactChannelWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error activating SSM Contacts Contact Channel (%s): %s", channelID, err)
+	}
+
+	d.SetId(channelID)
+
+	return resourceContactChannelActivationRead(ctx, d, meta)
+}
+
+func resourceContactChannelActivationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	channel, err := FindContactChannelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) not found, removing activation from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	// Changing a channel's delivery address deactivates it, and the old code can't be reused.
+	if status := aws.StringValue(channel.ActivationStatus); !d.IsNewResource() && status != ssmcontacts.ActivationStatusActivated {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) is %s, removing activation from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("activation_status", channel.ActivationStatus)
+	d.Set("contact_channel_id", channel.ContactChannelArn)
+
+	return nil
+}
+
+func resourceContactChannelActivationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deactivating SSM Contacts Contact Channel: %s", d.Id())
+	_, err := conn.DeactivateContactChannelWithContext(ctx, &ssmcontacts.DeactivateContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deactivating SSM Contacts Contact Channel (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
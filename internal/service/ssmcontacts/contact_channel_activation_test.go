@@ -0,0 +1,39 @@
+package ssmcontacts_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// A valid activation code is only delivered to a real address, so only the failure path can be tested.
+func testAccContactChannelActivation_invalidCode(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContactChannelActivationConfig_basic(rName, "000000"),
+				ExpectError: regexp.MustCompile(`error activating SSM Contacts Contact Channel`),
+			},
+		},
+	})
+}
+
+func testAccContactChannelActivationConfig_basic(rName, code string) string {
+	return acctest.ConfigCompose(testAccContactChannelConfig_basic(rName, rName, acctest.DefaultEmailAddress), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact_channel_activation" "test" {
+  contact_channel_id = aws_ssmcontacts_contact_channel.test.arn
+  activation_code    = %[1]q
+}
+`, code))
+}
@@ -0,0 +1,76 @@
+package ssmcontacts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceContactChannel() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContactChannelRead,
+
+		Schema: map[string]*schema.Schema{
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"contact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_address": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceContactChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	arn := d.Get("arn").(string)
+	channel, err := FindContactChannelByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Contact Channel (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.StringValue(channel.ContactChannelArn))
+	d.Set("activation_status", channel.ActivationStatus)
+	d.Set("arn", channel.ContactChannelArn)
+	d.Set("contact_id", channel.ContactArn)
+	if err := d.Set("delivery_address", flattenContactChannelAddress(channel.DeliveryAddress)); err != nil {
+		return diag.Errorf("error setting delivery_address: %s", err)
+	}
+	d.Set("name", channel.Name)
+	d.Set("type", channel.Type)
+
+	return nil
+}
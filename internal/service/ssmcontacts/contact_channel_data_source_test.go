@@ -0,0 +1,45 @@
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccContactChannelDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmcontacts_contact_channel.test"
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "activation_status", resourceName, "activation_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "contact_id", resourceName, "contact_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "delivery_address.#", resourceName, "delivery_address.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "delivery_address.0.simple_address", resourceName, "delivery_address.0.simple_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactChannelDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccContactChannelConfig_basic(rName, rName, acctest.DefaultEmailAddress), `
+data "aws_ssmcontacts_contact_channel" "test" {
+  arn = aws_ssmcontacts_contact_channel.test.arn
+}
+`)
+}
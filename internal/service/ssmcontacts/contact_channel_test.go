@@ -0,0 +1,168 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccContactChannel_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, acctest.DefaultEmailAddress),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", "NOT_ACTIVATED"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`contact-channel/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", acctest.DefaultEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "EMAIL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactChannelConfig_basic(rName, "updated", acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactChannel_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceContactChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccContactChannel_deliveryAddress(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address1 := acctest.RandomEmailAddress(domain)
+	address2 := acctest.RandomEmailAddress(domain)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, address1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", address1),
+				),
+			},
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, address2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", "NOT_ACTIVATED"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", address2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContactChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact_channel" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindContactChannelByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckContactChannelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindContactChannelByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContactChannelConfig_basic(rName, channelName, address string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact_channel" "test" {
+  contact_id = aws_ssmcontacts_contact.test.arn
+  name       = %[1]q
+  type       = "EMAIL"
+
+  delivery_address {
+    simple_address = %[2]q
+  }
+}
+`, channelName, address))
+}
@@ -0,0 +1,43 @@
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccContactDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmcontacts_contact.test"
+	resourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "display_name", resourceName, "display_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccContactConfig_tags1(rName, "key1", "value1"), `
+data "aws_ssmcontacts_contact" "test" {
+  arn = aws_ssmcontacts_contact.test.arn
+}
+`)
+}
@@ -0,0 +1,244 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccContact_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias", rName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`contact/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "PERSONAL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContact_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceContact(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccContact_displayName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_displayName(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "type", "ESCALATION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactConfig_displayName(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContact_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccContactConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContactDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_contact" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindContactByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Contact %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckContactExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Contact ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindContactByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContactConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfig_base(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccContactConfig_displayName(rName, displayName string) string {
+	return acctest.ConfigCompose(testAccConfig_base(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias        = %[1]q
+  display_name = %[2]q
+  type         = "ESCALATION"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, displayName))
+}
+
+func testAccContactConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConfig_base(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccContactConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConfig_base(), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test" {
+  alias = %[1]q
+  type  = "PERSONAL"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,104 @@
+package ssmcontacts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindContactByARN(ctx context.Context, conn *ssmcontacts.SSMContacts, arn string) (*ssmcontacts.GetContactOutput, error) {
+	input := &ssmcontacts.GetContactInput{
+		ContactId: aws.String(arn),
+	}
+
+	output, err := conn.GetContactWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindContactChannelByARN(ctx context.Context, conn *ssmcontacts.SSMContacts, arn string) (*ssmcontacts.GetContactChannelOutput, error) {
+	input := &ssmcontacts.GetContactChannelInput{
+		ContactChannelId: aws.String(arn),
+	}
+
+	output, err := conn.GetContactChannelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindPlanByContactARN returns the engagement plan of the specified contact.
+// A contact always has a plan, so one without any stages is treated as not found.
+func FindPlanByContactARN(ctx context.Context, conn *ssmcontacts.SSMContacts, arn string) (*ssmcontacts.Plan, error) {
+	output, err := FindContactByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Plan == nil || len(output.Plan.Stages) == 0 {
+		return nil, &resource.NotFoundError{
+			Message: "contact has no engagement plan stages",
+		}
+	}
+
+	return output.Plan, nil
+}
+
+func FindRotationByARN(ctx context.Context, conn *ssmcontacts.SSMContacts, arn string) (*ssmcontacts.GetRotationOutput, error) {
+	input := &ssmcontacts.GetRotationInput{
+		RotationId: aws.String(arn),
+	}
+
+	output, err := conn.GetRotationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Recurrence == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
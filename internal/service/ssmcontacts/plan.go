@@ -0,0 +1,291 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePlanPut,
+		ReadWithoutTimeout:   resourcePlanRead,
+		UpdateWithoutTimeout: resourcePlanPut,
+		DeleteWithoutTimeout: resourcePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 30),
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_channel_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"retry_interval_in_minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 60),
+												},
+											},
+										},
+									},
+									"contact_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"is_essential": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourcePlanPut sets the engagement plan of an existing contact.
+// A contact always has a plan, so creating and updating the resource are the same operation.
+func resourcePlanPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	contactID := d.Get("contact_id").(string)
+	input := &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(contactID),
+		Plan: &ssmcontacts.Plan{
+			Stages: expandStages(d.Get("stage").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting SSM Contacts Plan: %s", input)
+	_, err := conn.UpdateContactWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting SSM Contacts Plan (%s): %s", contactID, err)
+	}
+
+	d.SetId(contactID)
+
+	return resourcePlanRead(ctx, d, meta)
+}
+
+func resourcePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	plan, err := FindPlanByContactARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Plan (%s): %s", d.Id(), err)
+	}
+
+	d.Set("contact_id", d.Id())
+	if err := d.Set("stage", flattenStages(plan.Stages)); err != nil {
+		return diag.Errorf("error setting stage: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Plan: %s", d.Id())
+	_, err := conn.UpdateContactWithContext(ctx, &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(d.Id()),
+		Plan: &ssmcontacts.Plan{
+			Stages: []*ssmcontacts.Stage{},
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Contacts Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandStages(tfList []interface{}) []*ssmcontacts.Stage {
+	apiObjects := []*ssmcontacts.Stage{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Stage{
+			DurationInMinutes: aws.Int64(int64(tfMap["duration_in_minutes"].(int))),
+			Targets:           expandTargets(tfMap["target"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTargets(tfList []interface{}) []*ssmcontacts.Target {
+	apiObjects := []*ssmcontacts.Target{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Target{}
+
+		if v, ok := tfMap["channel_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ChannelTargetInfo = expandChannelTargetInfo(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["contact_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContactTargetInfo = expandContactTargetInfo(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandChannelTargetInfo(tfMap map[string]interface{}) *ssmcontacts.ChannelTargetInfo {
+	apiObject := &ssmcontacts.ChannelTargetInfo{}
+
+	if v, ok := tfMap["contact_channel_id"].(string); ok && v != "" {
+		apiObject.ContactChannelId = aws.String(v)
+	}
+
+	if v, ok := tfMap["retry_interval_in_minutes"].(int); ok && v != 0 {
+		apiObject.RetryIntervalInMinutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContactTargetInfo(tfMap map[string]interface{}) *ssmcontacts.ContactTargetInfo {
+	apiObject := &ssmcontacts.ContactTargetInfo{}
+
+	if v, ok := tfMap["contact_id"].(string); ok && v != "" {
+		apiObject.ContactId = aws.String(v)
+	}
+
+	if v, ok := tfMap["is_essential"].(bool); ok {
+		apiObject.IsEssential = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenStages(apiObjects []*ssmcontacts.Stage) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"duration_in_minutes": aws.Int64Value(apiObject.DurationInMinutes),
+			"target":              flattenTargets(apiObject.Targets),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTargets(apiObjects []*ssmcontacts.Target) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ChannelTargetInfo; v != nil {
+			tfMap["channel_target_info"] = []interface{}{map[string]interface{}{
+				"contact_channel_id":        aws.StringValue(v.ContactChannelId),
+				"retry_interval_in_minutes": aws.Int64Value(v.RetryIntervalInMinutes),
+			}}
+		}
+
+		if v := apiObject.ContactTargetInfo; v != nil {
+			tfMap["contact_target_info"] = []interface{}{map[string]interface{}{
+				"contact_id":   aws.StringValue(v.ContactId),
+				"is_essential": aws.BoolValue(v.IsEssential),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,94 @@
+package ssmcontacts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePlan() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_target_info": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_channel_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"retry_interval_in_minutes": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"contact_target_info": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"is_essential": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	contactID := d.Get("contact_id").(string)
+	plan, err := FindPlanByContactARN(ctx, conn, contactID)
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Plan (%s): %s", contactID, err)
+	}
+
+	d.SetId(contactID)
+	if err := d.Set("stage", flattenStages(plan.Stages)); err != nil {
+		return diag.Errorf("error setting stage: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPlanDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmcontacts_plan.test"
+	resourceName := "aws_ssmcontacts_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "contact_id", resourceName, "contact_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.#", resourceName, "stage.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.0.duration_in_minutes", resourceName, "stage.0.duration_in_minutes"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.0.target.#", resourceName, "stage.0.target.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.0.target.0.channel_target_info.0.contact_channel_id", resourceName, "stage.0.target.0.channel_target_info.0.contact_channel_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.0.target.0.channel_target_info.0.retry_interval_in_minutes", resourceName, "stage.0.target.0.channel_target_info.0.retry_interval_in_minutes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPlanDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_basic(rName, 5), `
+data "aws_ssmcontacts_plan" "test" {
+  contact_id = aws_ssmcontacts_plan.test.contact_id
+}
+`)
+}
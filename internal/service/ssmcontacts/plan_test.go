@@ -0,0 +1,224 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccPlan_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_plan.test"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+	channelResourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.channel_target_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.target.0.channel_target_info.0.contact_channel_id", channelResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.channel_target_info.0.retry_interval_in_minutes", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlanConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPlan_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourcePlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPlan_contactTarget(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_plan.escalation"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_contactTarget(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.target.0.contact_target_info.0.contact_id", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.contact_target_info.0.is_essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.duration_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.target.0.contact_target_info.0.is_essential", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPlanDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_plan" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindPlanByContactARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindPlanByContactARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPlanConfig_basic(rName string, duration int) string {
+	return acctest.ConfigCompose(testAccContactChannelConfig_basic(rName, rName, acctest.DefaultEmailAddress), fmt.Sprintf(`
+resource "aws_ssmcontacts_plan" "test" {
+  contact_id = aws_ssmcontacts_contact.test.arn
+
+  stage {
+    duration_in_minutes = %[1]d
+
+    target {
+      channel_target_info {
+        contact_channel_id        = aws_ssmcontacts_contact_channel.test.arn
+        retry_interval_in_minutes = 1
+      }
+    }
+  }
+}
+`, duration))
+}
+
+func testAccPlanConfig_contactTarget(rName string) string {
+	return acctest.ConfigCompose(testAccPlanConfig_basic(rName, 5), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "escalation" {
+  alias = "%[1]s-escalation"
+  type  = "ESCALATION"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+
+resource "aws_ssmcontacts_contact" "backup" {
+  alias = "%[1]s-backup"
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+
+resource "aws_ssmcontacts_plan" "escalation" {
+  contact_id = aws_ssmcontacts_contact.escalation.arn
+
+  stage {
+    duration_in_minutes = 0
+
+    target {
+      contact_target_info {
+        contact_id   = aws_ssmcontacts_contact.test.arn
+        is_essential = true
+      }
+    }
+  }
+
+  stage {
+    duration_in_minutes = 5
+
+    target {
+      contact_target_info {
+        contact_id   = aws_ssmcontacts_contact.backup.arn
+        is_essential = false
+      }
+    }
+  }
+
+  depends_on = [aws_ssmcontacts_plan.test]
+}
+`, rName))
+}
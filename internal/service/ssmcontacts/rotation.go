@@ -0,0 +1,572 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRotationCreate,
+		ReadWithoutTimeout:   resourceRotationRead,
+		UpdateWithoutTimeout: resourceRotationUpdate,
+		DeleteWithoutTimeout: resourceRotationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 30,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-\s\.]+$`), "must contain only alphanumeric characters, underscores, hyphens, periods and spaces"),
+				),
+			},
+			"recurrence": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     handOffTimeResource(),
+						},
+						"monthly_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_month": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 31),
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     handOffTimeResource(),
+									},
+								},
+							},
+						},
+						"number_of_on_calls": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"recurrence_multiplier": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"shift_coverages": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"coverage_times": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem:     handOffTimeResource(),
+												},
+												"start": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem:     handOffTimeResource(),
+												},
+											},
+										},
+									},
+									"map_block_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssmcontacts.DayOfWeek_Values(), false),
+									},
+								},
+							},
+						},
+						"weekly_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssmcontacts.DayOfWeek_Values(), false),
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     handOffTimeResource(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"time_zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func handOffTimeResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hour_of_day": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"minute_of_hour": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+		},
+	}
+}
+
+func resourceRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssmcontacts.CreateRotationInput{
+		ContactIds: flex.ExpandStringList(d.Get("contact_ids").([]interface{})),
+		Name:       aws.String(name),
+		Recurrence: expandRecurrenceSettings(d.Get("recurrence").([]interface{})),
+		TimeZoneId: aws.String(d.Get("time_zone_id").(string)),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+
+		input.StartTime = aws.Time(t)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM Contacts Rotation: %s", input)
+	output, err := conn.CreateRotationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Contacts Rotation (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.RotationArn))
+
+	return resourceRotationRead(ctx, d, meta)
+}
+
+func resourceRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	rotation, err := FindRotationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Rotation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Rotation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", rotation.RotationArn)
+	d.Set("contact_ids", aws.StringValueSlice(rotation.ContactIds))
+	d.Set("name", rotation.Name)
+	if err := d.Set("recurrence", flattenRecurrenceSettings(rotation.Recurrence)); err != nil {
+		return diag.Errorf("error setting recurrence: %s", err)
+	}
+	if rotation.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(rotation.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("time_zone_id", rotation.TimeZoneId)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Contacts Rotation (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// The recurrence is always required.
+		input := &ssmcontacts.UpdateRotationInput{
+			Recurrence: expandRecurrenceSettings(d.Get("recurrence").([]interface{})),
+			RotationId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("contact_ids") {
+			input.ContactIds = flex.ExpandStringList(d.Get("contact_ids").([]interface{}))
+		}
+
+		if hasChange, v := d.HasChange("start_time"), d.Get("start_time").(string); hasChange && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+
+			input.StartTime = aws.Time(t)
+		}
+
+		if d.HasChange("time_zone_id") {
+			input.TimeZoneId = aws.String(d.Get("time_zone_id").(string))
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Rotation: %s", input)
+		_, err := conn.UpdateRotationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating SSM Contacts Rotation (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating SSM Contacts Rotation (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRotationRead(ctx, d, meta)
+}
+
+func resourceRotationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+
+	log.Printf("[DEBUG] Deleting SSM Contacts Rotation: %s", d.Id())
+	_, err := conn.DeleteRotationWithContext(ctx, &ssmcontacts.DeleteRotationInput{
+		RotationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Contacts Rotation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRecurrenceSettings(tfList []interface{}) *ssmcontacts.RecurrenceSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ssmcontacts.RecurrenceSettings{}
+
+	if v, ok := tfMap["daily_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DailySettings = expandHandOffTimes(v)
+	}
+
+	if v, ok := tfMap["monthly_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MonthlySettings = expandMonthlySettings(v)
+	}
+
+	if v, ok := tfMap["number_of_on_calls"].(int); ok {
+		apiObject.NumberOfOnCalls = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["recurrence_multiplier"].(int); ok {
+		apiObject.RecurrenceMultiplier = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["shift_coverages"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ShiftCoverages = expandShiftCoverages(v.List())
+	}
+
+	if v, ok := tfMap["weekly_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.WeeklySettings = expandWeeklySettings(v)
+	}
+
+	return apiObject
+}
+
+func expandHandOffTime(tfList []interface{}) *ssmcontacts.HandOffTime {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &ssmcontacts.HandOffTime{
+		HourOfDay:    aws.Int64(int64(tfMap["hour_of_day"].(int))),
+		MinuteOfHour: aws.Int64(int64(tfMap["minute_of_hour"].(int))),
+	}
+}
+
+func expandHandOffTimes(tfList []interface{}) []*ssmcontacts.HandOffTime {
+	var apiObjects []*ssmcontacts.HandOffTime
+
+	for _, tfMapRaw := range tfList {
+		if tfMapRaw == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandHandOffTime([]interface{}{tfMapRaw}))
+	}
+
+	return apiObjects
+}
+
+func expandMonthlySettings(tfList []interface{}) []*ssmcontacts.MonthlySetting {
+	var apiObjects []*ssmcontacts.MonthlySetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.MonthlySetting{
+			DayOfMonth:  aws.Int64(int64(tfMap["day_of_month"].(int))),
+			HandOffTime: expandHandOffTime(tfMap["hand_off_time"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandWeeklySettings(tfList []interface{}) []*ssmcontacts.WeeklySetting {
+	var apiObjects []*ssmcontacts.WeeklySetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.WeeklySetting{
+			DayOfWeek:   aws.String(tfMap["day_of_week"].(string)),
+			HandOffTime: expandHandOffTime(tfMap["hand_off_time"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandShiftCoverages(tfList []interface{}) map[string][]*ssmcontacts.CoverageTime {
+	apiObject := make(map[string][]*ssmcontacts.CoverageTime)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var coverageTimes []*ssmcontacts.CoverageTime
+
+		for _, v := range tfMap["coverage_times"].([]interface{}) {
+			m, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			coverageTimes = append(coverageTimes, &ssmcontacts.CoverageTime{
+				End:   expandHandOffTime(m["end"].([]interface{})),
+				Start: expandHandOffTime(m["start"].([]interface{})),
+			})
+		}
+
+		apiObject[tfMap["map_block_key"].(string)] = coverageTimes
+	}
+
+	return apiObject
+}
+
+func flattenRecurrenceSettings(apiObject *ssmcontacts.RecurrenceSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"daily_settings":        flattenHandOffTimes(apiObject.DailySettings),
+		"monthly_settings":      flattenMonthlySettings(apiObject.MonthlySettings),
+		"number_of_on_calls":    aws.Int64Value(apiObject.NumberOfOnCalls),
+		"recurrence_multiplier": aws.Int64Value(apiObject.RecurrenceMultiplier),
+		"shift_coverages":       flattenShiftCoverages(apiObject.ShiftCoverages),
+		"weekly_settings":       flattenWeeklySettings(apiObject.WeeklySettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHandOffTime(apiObject *ssmcontacts.HandOffTime) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"hour_of_day":    aws.Int64Value(apiObject.HourOfDay),
+		"minute_of_hour": aws.Int64Value(apiObject.MinuteOfHour),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHandOffTimes(apiObjects []*ssmcontacts.HandOffTime) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenHandOffTime(apiObject)[0])
+	}
+
+	return tfList
+}
+
+func flattenMonthlySettings(apiObjects []*ssmcontacts.MonthlySetting) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"day_of_month":  aws.Int64Value(apiObject.DayOfMonth),
+			"hand_off_time": flattenHandOffTime(apiObject.HandOffTime),
+		})
+	}
+
+	return tfList
+}
+
+func flattenWeeklySettings(apiObjects []*ssmcontacts.WeeklySetting) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"day_of_week":   aws.StringValue(apiObject.DayOfWeek),
+			"hand_off_time": flattenHandOffTime(apiObject.HandOffTime),
+		})
+	}
+
+	return tfList
+}
+
+func flattenShiftCoverages(apiObject map[string][]*ssmcontacts.CoverageTime) []interface{} {
+	var tfList []interface{}
+
+	for day, coverageTimes := range apiObject {
+		var tfCoverageTimes []interface{}
+
+		for _, v := range coverageTimes {
+			if v == nil {
+				continue
+			}
+
+			tfCoverageTimes = append(tfCoverageTimes, map[string]interface{}{
+				"end":   flattenHandOffTime(v.End),
+				"start": flattenHandOffTime(v.Start),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"coverage_times": tfCoverageTimes,
+			"map_block_key":  day,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,183 @@
+package ssmcontacts
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceRotation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRotationRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"contact_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurrence": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     handOffTimeDataSourceResource(),
+						},
+						"monthly_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_month": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     handOffTimeDataSourceResource(),
+									},
+								},
+							},
+						},
+						"number_of_on_calls": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"recurrence_multiplier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"shift_coverages": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"coverage_times": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     handOffTimeDataSourceResource(),
+												},
+												"start": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     handOffTimeDataSourceResource(),
+												},
+											},
+										},
+									},
+									"map_block_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"weekly_settings": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     handOffTimeDataSourceResource(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"time_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func handOffTimeDataSourceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hour_of_day": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"minute_of_hour": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+	rotation, err := FindRotationByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Contacts Rotation (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.StringValue(rotation.RotationArn))
+	d.Set("arn", rotation.RotationArn)
+	d.Set("contact_ids", aws.StringValueSlice(rotation.ContactIds))
+	d.Set("name", rotation.Name)
+	if err := d.Set("recurrence", flattenRecurrenceSettings(rotation.Recurrence)); err != nil {
+		return diag.Errorf("error setting recurrence: %s", err)
+	}
+	if rotation.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(rotation.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("time_zone_id", rotation.TimeZoneId)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Contacts Rotation (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
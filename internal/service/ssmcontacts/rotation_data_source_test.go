@@ -0,0 +1,49 @@
+package ssmcontacts_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccRotationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmcontacts_rotation.test"
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "contact_ids.#", resourceName, "contact_ids.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.#", resourceName, "recurrence.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.0.number_of_on_calls", resourceName, "recurrence.0.number_of_on_calls"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.0.recurrence_multiplier", resourceName, "recurrence.0.recurrence_multiplier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.0.shift_coverages.#", resourceName, "recurrence.0.shift_coverages.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "recurrence.0.weekly_settings.#", resourceName, "recurrence.0.weekly_settings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "start_time", resourceName, "start_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "time_zone_id", resourceName, "time_zone_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRotationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_weekly(rName), `
+data "aws_ssmcontacts_rotation" "test" {
+  arn = aws_ssmcontacts_rotation.test.arn
+}
+`)
+}
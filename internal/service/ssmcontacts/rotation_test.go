@@ -0,0 +1,384 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccRotation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`rotation/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.minute_of_hour", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.number_of_on_calls", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.recurrence_multiplier", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_id", "Europe/London"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRotation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmcontacts.ResourceRotation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRotation_recurrence(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+	startTime := time.Now().UTC().AddDate(0, 0, 7).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_weekly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.number_of_on_calls", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.recurrence_multiplier", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "recurrence.0.shift_coverages.*", map[string]string{
+						"map_block_key":                        "MON",
+						"coverage_times.#":                     "1",
+						"coverage_times.0.start.0.hour_of_day": "8",
+						"coverage_times.0.end.0.hour_of_day":   "17",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.day_of_week", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.hour_of_day", "8"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.minute_of_hour", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRotationConfig_monthly(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.0.day_of_month", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.monthly_settings.0.hand_off_time.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", startTime),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_id", "America/Los_Angeles"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRotation_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRotationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRotationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRotationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmcontacts_rotation" {
+			continue
+		}
+
+		_, err := tfssmcontacts.FindRotationByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Contacts Rotation %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRotationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Contacts Rotation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn
+
+		_, err := tfssmcontacts.FindRotationByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRotationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  name         = %[1]q
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  time_zone_id = "Europe/London"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRotationConfig_twoContacts(rName string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact" "test2" {
+  alias = "%[1]s-2"
+  type  = "PERSONAL"
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccRotationConfig_weekly(rName string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_twoContacts(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  name         = %[1]q
+  contact_ids  = [aws_ssmcontacts_contact.test.arn, aws_ssmcontacts_contact.test2.arn]
+  time_zone_id = "Europe/London"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 2
+
+    weekly_settings {
+      day_of_week = "MON"
+
+      hand_off_time {
+        hour_of_day    = 8
+        minute_of_hour = 30
+      }
+    }
+
+    shift_coverages {
+      map_block_key = "MON"
+
+      coverage_times {
+        start {
+          hour_of_day    = 8
+          minute_of_hour = 0
+        }
+
+        end {
+          hour_of_day    = 17
+          minute_of_hour = 0
+        }
+      }
+    }
+
+    shift_coverages {
+      map_block_key = "TUE"
+
+      coverage_times {
+        start {
+          hour_of_day    = 8
+          minute_of_hour = 0
+        }
+
+        end {
+          hour_of_day    = 17
+          minute_of_hour = 0
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRotationConfig_monthly(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccRotationConfig_twoContacts(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  name         = %[1]q
+  contact_ids  = [aws_ssmcontacts_contact.test2.arn, aws_ssmcontacts_contact.test.arn]
+  start_time   = %[2]q
+  time_zone_id = "America/Los_Angeles"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    monthly_settings {
+      day_of_month = 1
+
+      hand_off_time {
+        hour_of_day    = 9
+        minute_of_hour = 0
+      }
+    }
+  }
+}
+`, rName, startTime))
+}
+
+func testAccRotationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  name         = %[1]q
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  time_zone_id = "Europe/London"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccRotationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  name         = %[1]q
+  contact_ids  = [aws_ssmcontacts_contact.test.arn]
+  time_zone_id = "Europe/London"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
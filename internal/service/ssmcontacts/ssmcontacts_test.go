@@ -0,0 +1,74 @@
+package ssmcontacts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// SSM Contacts requires the account's SSM Incidents replication set, which is a singleton,
+// so these tests cannot run in parallel.
+func TestAccSSMContacts_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Contact": {
+			"basic":       testAccContact_basic,
+			"disappears":  testAccContact_disappears,
+			"displayName": testAccContact_displayName,
+			"tags":        testAccContact_tags,
+		},
+		"ContactDataSource": {
+			"basic": testAccContactDataSource_basic,
+		},
+		"ContactChannel": {
+			"basic":           testAccContactChannel_basic,
+			"disappears":      testAccContactChannel_disappears,
+			"deliveryAddress": testAccContactChannel_deliveryAddress,
+		},
+		"ContactChannelActivation": {
+			"invalidCode": testAccContactChannelActivation_invalidCode,
+		},
+		"ContactChannelDataSource": {
+			"basic": testAccContactChannelDataSource_basic,
+		},
+		"Plan": {
+			"basic":         testAccPlan_basic,
+			"disappears":    testAccPlan_disappears,
+			"contactTarget": testAccPlan_contactTarget,
+		},
+		"PlanDataSource": {
+			"basic": testAccPlanDataSource_basic,
+		},
+		"Rotation": {
+			"basic":      testAccRotation_basic,
+			"disappears": testAccRotation_disappears,
+			"recurrence": testAccRotation_recurrence,
+			"tags":       testAccRotation_tags,
+		},
+		"RotationDataSource": {
+			"basic": testAccRotationDataSource_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccConfig_base() string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+}
+`, acctest.Region())
+}
@@ -0,0 +1,131 @@
+//go:build sweep
+// +build sweep
+
+package ssmcontacts
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	// Deleting a contact also deletes its contact channels and engagement plan.
+	resource.AddTestSweepers("aws_ssmcontacts_contact", &resource.Sweeper{
+		Name:         "aws_ssmcontacts_contact",
+		F:            sweepContacts,
+		Dependencies: []string{"aws_ssmcontacts_rotation"},
+	})
+
+	resource.AddTestSweepers("aws_ssmcontacts_rotation", &resource.Sweeper{
+		Name: "aws_ssmcontacts_rotation",
+		F:    sweepRotations,
+	})
+}
+
+func sweepContacts(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SSMContactsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &ssmcontacts.ListContactsInput{}
+
+	err = conn.ListContactsPagesWithContext(ctx, input, func(page *ssmcontacts.ListContactsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, contact := range page.Contacts {
+			if contact == nil {
+				continue
+			}
+
+			r := ResourceContact()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(contact.ContactArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SSM Contacts Contacts sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SSM Contacts Contacts (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SSM Contacts Contacts (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepRotations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SSMContactsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &ssmcontacts.ListRotationsInput{}
+
+	err = conn.ListRotationsPagesWithContext(ctx, input, func(page *ssmcontacts.ListRotationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, rotation := range page.Rotations {
+			if rotation == nil {
+				continue
+			}
+
+			r := ResourceRotation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(rotation.RotationArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SSM Contacts Rotations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SSM Contacts Rotations (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SSM Contacts Rotations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
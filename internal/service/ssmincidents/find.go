@@ -0,0 +1,92 @@
+package ssmincidents
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindReplicationSetByARN(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string) (*ssmincidents.ReplicationSet, error) {
+	input := &ssmincidents.GetReplicationSetInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetReplicationSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReplicationSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReplicationSet, nil
+}
+
+// FindReplicationSetARN returns the ARN of the account's replication set.
+// An account has at most one replication set, shared across all Regions.
+func FindReplicationSetARN(ctx context.Context, conn *ssmincidents.SSMIncidents) (string, error) {
+	input := &ssmincidents.ListReplicationSetsInput{}
+	var arns []string
+
+	err := conn.ListReplicationSetsPagesWithContext(ctx, input, func(page *ssmincidents.ListReplicationSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		arns = append(arns, aws.StringValueSlice(page.ReplicationSetArns)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(arns) == 0 {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(arns); count > 1 {
+		return "", tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return arns[0], nil
+}
+
+func FindResponsePlanByARN(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string) (*ssmincidents.GetResponsePlanOutput, error) {
+	input := &ssmincidents.GetResponsePlanInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetResponsePlanWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IncidentTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
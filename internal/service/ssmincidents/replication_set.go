@@ -0,0 +1,371 @@
+package ssmincidents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// replicationSetDefaultKMSKey is the value reported for Regions encrypted with an AWS owned key.
+	replicationSetDefaultKMSKey = "DefaultKey"
+)
+
+func ResourceReplicationSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationSetCreate,
+		ReadWithoutTimeout:   resourceReplicationSetRead,
+		UpdateWithoutTimeout: resourceReplicationSetUpdate,
+		DeleteWithoutTimeout: resourceReplicationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			forceNewIfRegionKMSKeyChange,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protected": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      replicationSetRegionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  replicationSetDefaultKMSKey,
+							ValidateFunc: validation.Any(
+								verify.ValidARN,
+								validation.StringInSlice([]string{replicationSetDefaultKMSKey}, false),
+							),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceReplicationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ssmincidents.CreateReplicationSetInput{
+		Regions: expandRegionMapInputValues(d.Get("region").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM Incidents Replication Set: %s", input)
+	output, err := conn.CreateReplicationSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Incidents Replication Set: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitReplicationSetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for SSM Incidents Replication Set (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceReplicationSetRead(ctx, d, meta)
+}
+
+func resourceReplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	replicationSet, err := FindReplicationSetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incidents Replication Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incidents Replication Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", replicationSet.Arn)
+	d.Set("created_by", replicationSet.CreatedBy)
+	d.Set("deletion_protected", replicationSet.DeletionProtected)
+	d.Set("last_modified_by", replicationSet.LastModifiedBy)
+	if err := d.Set("region", flattenRegionInfos(replicationSet.RegionMap)); err != nil {
+		return diag.Errorf("error setting region: %s", err)
+	}
+	d.Set("status", replicationSet.Status)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Incidents Replication Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceReplicationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	if d.HasChange("region") {
+		o, n := d.GetChange("region")
+		oldRegions := expandRegionMapInputValues(o.(*schema.Set).List())
+		newRegions := expandRegionMapInputValues(n.(*schema.Set).List())
+
+		// Only a single Region can be added or removed per request, and a replication set must always
+		// contain at least one Region, so new Regions are added before any are removed.
+		// Changes to a Region's KMS key force a new resource, see forceNewIfRegionKMSKeyChange.
+		var actions []*ssmincidents.UpdateReplicationSetAction
+
+		for name, newRegion := range newRegions {
+			if _, ok := oldRegions[name]; !ok {
+				actions = append(actions, replicationSetAddRegionAction(name, newRegion))
+			}
+		}
+
+		for name := range oldRegions {
+			if _, ok := newRegions[name]; !ok {
+				actions = append(actions, replicationSetDeleteRegionAction(name))
+			}
+		}
+
+		for _, action := range actions {
+			input := &ssmincidents.UpdateReplicationSetInput{
+				Actions: []*ssmincidents.UpdateReplicationSetAction{action},
+				Arn:     aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Updating SSM Incidents Replication Set: %s", input)
+			_, err := conn.UpdateReplicationSetWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("error updating SSM Incidents Replication Set (%s): %s", d.Id(), err)
+			}
+
+			if _, err := waitReplicationSetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("error waiting for SSM Incidents Replication Set (%s) update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating SSM Incidents Replication Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceReplicationSetRead(ctx, d, meta)
+}
+
+func resourceReplicationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	log.Printf("[DEBUG] Deleting SSM Incidents Replication Set: %s", d.Id())
+	_, err := conn.DeleteReplicationSetWithContext(ctx, &ssmincidents.DeleteReplicationSetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Incidents Replication Set (%s): %s", d.Id(), err)
+	}
+
+	if err := waitReplicationSetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for SSM Incidents Replication Set (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// forceNewIfRegionKMSKeyChange forces a new resource when the KMS key of an existing Region changes.
+// The key can only be changed by removing the Region and adding it again, which isn't possible
+// for the last Region as a replication set must always contain at least one Region.
+func forceNewIfRegionKMSKeyChange(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("region") {
+		return nil
+	}
+
+	o, n := d.GetChange("region")
+	oldRegions := expandRegionMapInputValues(o.(*schema.Set).List())
+	newRegions := expandRegionMapInputValues(n.(*schema.Set).List())
+
+	for name, oldRegion := range oldRegions {
+		if newRegion, ok := newRegions[name]; ok && aws.StringValue(oldRegion.SseKmsKeyId) != aws.StringValue(newRegion.SseKmsKeyId) {
+			return d.ForceNew("region")
+		}
+	}
+
+	return nil
+}
+
+func replicationSetAddRegionAction(name string, apiObject *ssmincidents.RegionMapInputValue) *ssmincidents.UpdateReplicationSetAction {
+	return &ssmincidents.UpdateReplicationSetAction{
+		AddRegionAction: &ssmincidents.AddRegionAction{
+			RegionName:  aws.String(name),
+			SseKmsKeyId: apiObject.SseKmsKeyId,
+		},
+	}
+}
+
+func replicationSetDeleteRegionAction(name string) *ssmincidents.UpdateReplicationSetAction {
+	return &ssmincidents.UpdateReplicationSetAction{
+		DeleteRegionAction: &ssmincidents.DeleteRegionAction{
+			RegionName: aws.String(name),
+		},
+	}
+}
+
+func replicationSetRegionHash(v interface{}) int {
+	var buf bytes.Buffer
+	tfMap := v.(map[string]interface{})
+
+	if v, ok := tfMap["name"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	} else {
+		buf.WriteString(fmt.Sprintf("%s-", replicationSetDefaultKMSKey))
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+func expandRegionMapInputValues(tfList []interface{}) map[string]*ssmincidents.RegionMapInputValue {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*ssmincidents.RegionMapInputValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmincidents.RegionMapInputValue{}
+
+		if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" && v != replicationSetDefaultKMSKey {
+			apiObject.SseKmsKeyId = aws.String(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenRegionInfos(apiObjects map[string]*ssmincidents.RegionInfo) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"kms_key_arn":    replicationSetDefaultKMSKey,
+			"name":           name,
+			"status":         aws.StringValue(apiObject.Status),
+			"status_message": aws.StringValue(apiObject.StatusMessage),
+		}
+
+		if v := aws.StringValue(apiObject.SseKmsKeyId); v != "" {
+			tfMap["kms_key_arn"] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
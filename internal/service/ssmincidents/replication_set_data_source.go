@@ -0,0 +1,103 @@
+package ssmincidents
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceReplicationSet() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReplicationSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protected": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceReplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn, err := FindReplicationSetARN(ctx, conn)
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incidents Replication Set: %s", err)
+	}
+
+	replicationSet, err := FindReplicationSetByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incidents Replication Set (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("arn", replicationSet.Arn)
+	d.Set("created_by", replicationSet.CreatedBy)
+	d.Set("deletion_protected", replicationSet.DeletionProtected)
+	d.Set("last_modified_by", replicationSet.LastModifiedBy)
+	if err := d.Set("region", flattenRegionInfos(replicationSet.RegionMap)); err != nil {
+		return diag.Errorf("error setting region: %s", err)
+	}
+	d.Set("status", replicationSet.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Incidents Replication Set (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package ssmincidents_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccReplicationSetDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ssmincidents_replication_set.test"
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSetDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_by", resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deletion_protected", resourceName, "deletion_protected"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "last_modified_by", resourceName, "last_modified_by"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "region.#", resourceName, "region.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationSetDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccReplicationSetConfig_tags1("key1", "value1"), `
+data "aws_ssmincidents_replication_set" "test" {
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`)
+}
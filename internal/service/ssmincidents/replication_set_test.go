@@ -0,0 +1,317 @@
+package ssmincidents_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmincidents "github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccReplicationSet_basic(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSetConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ssm-incidents", regexp.MustCompile(`replication-set/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_by"),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"name":        acctest.Region(),
+						"kms_key_arn": "DefaultKey",
+						"status":      ssmincidents.RegionStatusActive,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", ssmincidents.ReplicationSetStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationSet_disappears(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSetConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmincidents.ResourceReplicationSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationSet_updateRegions(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSetConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+				),
+			},
+			{
+				Config: testAccReplicationSetConfig_twoRegions(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"name":   acctest.Region(),
+						"status": ssmincidents.RegionStatusActive,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"name":   acctest.AlternateRegion(),
+						"status": ssmincidents.RegionStatusActive,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationSetConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReplicationSet_updateKMSKey(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSetConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "region.*", map[string]string{
+						"kms_key_arn": "DefaultKey",
+						"name":        acctest.Region(),
+					}),
+				),
+			},
+			{
+				// Changing the key of the only Region replaces the replication set.
+				Config: testAccReplicationSetConfig_kmsKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "region.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "region.*.kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccReplicationSet_tags(t *testing.T) {
+	resourceName := "aws_ssmincidents_replication_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationSetConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationSetConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccReplicationSetConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicationSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmincidents_replication_set" {
+			continue
+		}
+
+		_, err := tfssmincidents.FindReplicationSetByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Incidents Replication Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckReplicationSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Incidents Replication Set ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+		_, err := tfssmincidents.FindReplicationSetByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccReplicationSetConfig_basic() string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+}
+`, acctest.Region())
+}
+
+func testAccReplicationSetConfig_twoRegions() string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+
+  region {
+    name = %[2]q
+  }
+}
+`, acctest.Region(), acctest.AlternateRegion())
+}
+
+func testAccReplicationSetConfig_kmsKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name        = %[2]q
+    kms_key_arn = aws_kms_key.test.arn
+  }
+}
+`, rName, acctest.Region())
+}
+
+func testAccReplicationSetConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, acctest.Region(), tagKey1, tagValue1)
+}
+
+func testAccReplicationSetConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, acctest.Region(), tagKey1, tagValue1, tagKey2, tagValue2)
+}
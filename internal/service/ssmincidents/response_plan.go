@@ -0,0 +1,714 @@
+package ssmincidents
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResponsePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResponsePlanCreate,
+		ReadWithoutTimeout:   resourceResponsePlanRead,
+		UpdateWithoutTimeout: resourceResponsePlanUpdate,
+		DeleteWithoutTimeout: resourceResponsePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_automation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"document_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dynamic_parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"target_account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(ssmincidents.SsmTargetAccount_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chat_channel": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"engagements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"incident_template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dedupe_string": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"impact": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"incident_tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"notification_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sns_topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"summary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 4000),
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+			"integration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pagerduty": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+									"secret_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"service_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceResponsePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssmincidents.CreateResponsePlanInput{
+		IncidentTemplate: expandIncidentTemplate(d.Get("incident_template").([]interface{})),
+		Name:             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("action"); ok {
+		input.Actions = expandActions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("chat_channel"); ok && v.(*schema.Set).Len() > 0 {
+		input.ChatChannel = expandChatChannel(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engagements"); ok && v.(*schema.Set).Len() > 0 {
+		input.Engagements = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("integration"); ok {
+		input.Integrations = expandIntegrations(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM Incidents Response Plan: %s", input)
+	output, err := conn.CreateResponsePlanWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating SSM Incidents Response Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceResponsePlanRead(ctx, d, meta)
+}
+
+func resourceResponsePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	responsePlan, err := FindResponsePlanByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incidents Response Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenActions(responsePlan.Actions)); err != nil {
+		return diag.Errorf("error setting action: %s", err)
+	}
+	d.Set("arn", responsePlan.Arn)
+	d.Set("chat_channel", flattenChatChannel(responsePlan.ChatChannel))
+	d.Set("display_name", responsePlan.DisplayName)
+	d.Set("engagements", aws.StringValueSlice(responsePlan.Engagements))
+	if err := d.Set("incident_template", flattenIncidentTemplate(responsePlan.IncidentTemplate)); err != nil {
+		return diag.Errorf("error setting incident_template: %s", err)
+	}
+	if err := d.Set("integration", flattenIntegrations(responsePlan.Integrations)); err != nil {
+		return diag.Errorf("error setting integration: %s", err)
+	}
+	d.Set("name", responsePlan.Name)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssmincidents.UpdateResponsePlanInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Actions = expandActions(d.Get("action").([]interface{}))
+
+			if input.Actions == nil {
+				input.Actions = []*ssmincidents.Action{}
+			}
+		}
+
+		if d.HasChange("chat_channel") {
+			input.ChatChannel = expandChatChannel(d.Get("chat_channel").(*schema.Set))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("engagements") {
+			input.Engagements = flex.ExpandStringSet(d.Get("engagements").(*schema.Set))
+		}
+
+		if d.HasChange("incident_template") {
+			incidentTemplate := expandIncidentTemplate(d.Get("incident_template").([]interface{}))
+
+			input.IncidentTemplateDedupeString = incidentTemplate.DedupeString
+			input.IncidentTemplateImpact = incidentTemplate.Impact
+			input.IncidentTemplateNotificationTargets = incidentTemplate.NotificationTargets
+			input.IncidentTemplateSummary = incidentTemplate.Summary
+			input.IncidentTemplateTags = incidentTemplate.IncidentTags
+			input.IncidentTemplateTitle = incidentTemplate.Title
+
+			// Empty values clear the corresponding settings.
+			if input.IncidentTemplateDedupeString == nil {
+				input.IncidentTemplateDedupeString = aws.String("")
+			}
+
+			if input.IncidentTemplateNotificationTargets == nil {
+				input.IncidentTemplateNotificationTargets = []*ssmincidents.NotificationTargetItem{}
+			}
+
+			if input.IncidentTemplateSummary == nil {
+				input.IncidentTemplateSummary = aws.String("")
+			}
+
+			if input.IncidentTemplateTags == nil {
+				input.IncidentTemplateTags = map[string]*string{}
+			}
+		}
+
+		if d.HasChange("integration") {
+			input.Integrations = expandIntegrations(d.Get("integration").([]interface{}))
+
+			if input.Integrations == nil {
+				input.Integrations = []*ssmincidents.Integration{}
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SSM Incidents Response Plan: %s", input)
+		_, err := conn.UpdateResponsePlanWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating SSM Incidents Response Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating SSM Incidents Response Plan (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceResponsePlanRead(ctx, d, meta)
+}
+
+func resourceResponsePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+
+	log.Printf("[DEBUG] Deleting SSM Incidents Response Plan: %s", d.Id())
+	_, err := conn.DeleteResponsePlanWithContext(ctx, &ssmincidents.DeleteResponsePlanInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmincidents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIncidentTemplate(tfList []interface{}) *ssmincidents.IncidentTemplate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ssmincidents.IncidentTemplate{}
+
+	if v, ok := tfMap["dedupe_string"].(string); ok && v != "" {
+		apiObject.DedupeString = aws.String(v)
+	}
+
+	if v, ok := tfMap["impact"].(int); ok {
+		apiObject.Impact = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["incident_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.IncidentTags = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["notification_target"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NotificationTargets = expandNotificationTargetItems(v.List())
+	}
+
+	if v, ok := tfMap["summary"].(string); ok && v != "" {
+		apiObject.Summary = aws.String(v)
+	}
+
+	if v, ok := tfMap["title"].(string); ok {
+		apiObject.Title = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNotificationTargetItems(tfList []interface{}) []*ssmincidents.NotificationTargetItem {
+	var apiObjects []*ssmincidents.NotificationTargetItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmincidents.NotificationTargetItem{}
+
+		if v, ok := tfMap["sns_topic_arn"].(string); ok && v != "" {
+			apiObject.SnsTopicArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandChatChannel(tfSet *schema.Set) *ssmincidents.ChatChannel {
+	if tfSet.Len() == 0 {
+		return &ssmincidents.ChatChannel{
+			Empty: &ssmincidents.EmptyChatChannel{},
+		}
+	}
+
+	return &ssmincidents.ChatChannel{
+		ChatbotSns: flex.ExpandStringSet(tfSet),
+	}
+}
+
+func expandActions(tfList []interface{}) []*ssmincidents.Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	var apiObjects []*ssmincidents.Action
+
+	if v, ok := tfMap["ssm_automation"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObjects = append(apiObjects, &ssmincidents.Action{
+				SsmAutomation: expandSSMAutomation(tfMap),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandSSMAutomation(tfMap map[string]interface{}) *ssmincidents.SsmAutomation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssmincidents.SsmAutomation{}
+
+	if v, ok := tfMap["document_name"].(string); ok && v != "" {
+		apiObject.DocumentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["document_version"].(string); ok && v != "" {
+		apiObject.DocumentVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["dynamic_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.DynamicParameters = make(map[string]*ssmincidents.DynamicSsmParameterValue)
+
+		for k, v := range v {
+			apiObject.DynamicParameters[k] = &ssmincidents.DynamicSsmParameterValue{
+				Variable: aws.String(v.(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["parameter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Parameters = make(map[string][]*string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Parameters[tfMap["name"].(string)] = flex.ExpandStringSet(tfMap["values"].(*schema.Set))
+		}
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_account"].(string); ok && v != "" {
+		apiObject.TargetAccount = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIntegrations(tfList []interface{}) []*ssmincidents.Integration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	var apiObjects []*ssmincidents.Integration
+
+	if v, ok := tfMap["pagerduty"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObjects = append(apiObjects, &ssmincidents.Integration{
+				PagerDutyConfiguration: &ssmincidents.PagerDutyConfiguration{
+					Name: aws.String(tfMap["name"].(string)),
+					PagerDutyIncidentConfiguration: &ssmincidents.PagerDutyIncidentConfiguration{
+						ServiceId: aws.String(tfMap["service_id"].(string)),
+					},
+					SecretId: aws.String(tfMap["secret_id"].(string)),
+				},
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenIncidentTemplate(apiObject *ssmincidents.IncidentTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dedupe_string":       aws.StringValue(apiObject.DedupeString),
+		"impact":              aws.Int64Value(apiObject.Impact),
+		"incident_tags":       aws.StringValueMap(apiObject.IncidentTags),
+		"notification_target": flattenNotificationTargetItems(apiObject.NotificationTargets),
+		"summary":             aws.StringValue(apiObject.Summary),
+		"title":               aws.StringValue(apiObject.Title),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNotificationTargetItems(apiObjects []*ssmincidents.NotificationTargetItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"sns_topic_arn": aws.StringValue(apiObject.SnsTopicArn),
+		})
+	}
+
+	return tfList
+}
+
+func flattenChatChannel(apiObject *ssmincidents.ChatChannel) []string {
+	if apiObject == nil {
+		return nil
+	}
+
+	return aws.StringValueSlice(apiObject.ChatbotSns)
+}
+
+func flattenActions(apiObjects []*ssmincidents.Action) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.SsmAutomation == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenSSMAutomation(apiObject.SsmAutomation))
+	}
+
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ssm_automation": tfList,
+		},
+	}
+}
+
+func flattenSSMAutomation(apiObject *ssmincidents.SsmAutomation) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"document_name":    aws.StringValue(apiObject.DocumentName),
+		"document_version": aws.StringValue(apiObject.DocumentVersion),
+		"role_arn":         aws.StringValue(apiObject.RoleArn),
+		"target_account":   aws.StringValue(apiObject.TargetAccount),
+	}
+
+	if v := apiObject.DynamicParameters; len(v) > 0 {
+		dynamicParameters := make(map[string]interface{})
+
+		for k, v := range v {
+			if v == nil {
+				continue
+			}
+
+			dynamicParameters[k] = aws.StringValue(v.Variable)
+		}
+
+		tfMap["dynamic_parameters"] = dynamicParameters
+	}
+
+	if v := apiObject.Parameters; len(v) > 0 {
+		var parameters []interface{}
+
+		for k, v := range v {
+			parameters = append(parameters, map[string]interface{}{
+				"name":   k,
+				"values": aws.StringValueSlice(v),
+			})
+		}
+
+		tfMap["parameter"] = parameters
+	}
+
+	return tfMap
+}
+
+func flattenIntegrations(apiObjects []*ssmincidents.Integration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.PagerDutyConfiguration == nil {
+			continue
+		}
+
+		pagerDutyConfiguration := apiObject.PagerDutyConfiguration
+		tfMap := map[string]interface{}{
+			"name":      aws.StringValue(pagerDutyConfiguration.Name),
+			"secret_id": aws.StringValue(pagerDutyConfiguration.SecretId),
+		}
+
+		if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration; v != nil {
+			tfMap["service_id"] = aws.StringValue(v.ServiceId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"pagerduty": tfList,
+		},
+	}
+}
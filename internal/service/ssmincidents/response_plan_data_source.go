@@ -0,0 +1,208 @@
+package ssmincidents
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceResponsePlan() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResponsePlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_automation": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"document_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dynamic_parameters": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"parameter": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"role_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_account": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"chat_channel": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engagements": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"incident_template": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dedupe_string": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"impact": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"incident_tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"notification_target": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sns_topic_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"integration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pagerduty": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"secret_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"service_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceResponsePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+	responsePlan, err := FindResponsePlanByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error reading SSM Incidents Response Plan (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.StringValue(responsePlan.Arn))
+	if err := d.Set("action", flattenActions(responsePlan.Actions)); err != nil {
+		return diag.Errorf("error setting action: %s", err)
+	}
+	d.Set("arn", responsePlan.Arn)
+	d.Set("chat_channel", flattenChatChannel(responsePlan.ChatChannel))
+	d.Set("display_name", responsePlan.DisplayName)
+	d.Set("engagements", aws.StringValueSlice(responsePlan.Engagements))
+	if err := d.Set("incident_template", flattenIncidentTemplate(responsePlan.IncidentTemplate)); err != nil {
+		return diag.Errorf("error setting incident_template: %s", err)
+	}
+	if err := d.Set("integration", flattenIntegrations(responsePlan.Integrations)); err != nil {
+		return diag.Errorf("error setting integration: %s", err)
+	}
+	d.Set("name", responsePlan.Name)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for SSM Incidents Response Plan (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package ssmincidents_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccResponsePlanDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssmincidents_response_plan.test"
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "action.#", resourceName, "action.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "chat_channel.#", resourceName, "chat_channel.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "display_name", resourceName, "display_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engagements.#", resourceName, "engagements.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.#", resourceName, "incident_template.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.0.dedupe_string", resourceName, "incident_template.0.dedupe_string"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.0.impact", resourceName, "incident_template.0.impact"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.0.incident_tags.%", resourceName, "incident_template.0.incident_tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.0.notification_target.#", resourceName, "incident_template.0.notification_target.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.0.summary", resourceName, "incident_template.0.summary"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "incident_template.0.title", resourceName, "incident_template.0.title"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "integration.#", resourceName, "integration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlanDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_incidentTemplate(rName), `
+data "aws_ssmincidents_response_plan" "test" {
+  arn = aws_ssmincidents_response_plan.test.arn
+}
+`)
+}
@@ -0,0 +1,399 @@
+package ssmincidents_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmincidents "github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccResponsePlan_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ssm-incidents", regexp.MustCompile(`response-plan/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "engagements.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "3"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", rName),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssmincidents.ResourceResponsePlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_incidentTemplate(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+	snsTopicResourceName := "aws_sns_topic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_incidentTemplate(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "chat_channel.*", snsTopicResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.dedupe_string", "dedupe"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "incident_template.0.notification_target.*.sns_topic_arn", snsTopicResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.summary", "summary"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", "title"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.dedupe_string", ""),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "3"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.summary", ""),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_actions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_actions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.document_name", "AWS-RestartEC2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.document_version", "$DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.dynamic_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.dynamic_parameters.incidentARN", ssmincidents.VariableTypeIncidentRecordArn),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "action.0.ssm_automation.0.parameter.*", map[string]string{
+						"name":     "InstanceId",
+						"values.#": "1",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.ssm_automation.0.role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.target_account", ssmincidents.SsmTargetAccountResponsePlanOwnerAccount),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssmincidents.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResponsePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResponsePlanConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccResponsePlanConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResponsePlanDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssmincidents_response_plan" {
+			continue
+		}
+
+		_, err := tfssmincidents.FindResponsePlanByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Incidents Response Plan %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckResponsePlanExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Incidents Response Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsConn
+
+		_, err := tfssmincidents.FindResponsePlanByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccResponsePlanConfig_base() string {
+	return testAccReplicationSetConfig_basic()
+}
+
+func testAccResponsePlanConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccResponsePlanConfig_incidentTemplate(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name         = %[1]q
+  display_name = "display"
+  chat_channel = [aws_sns_topic.test.arn]
+
+  incident_template {
+    title         = "title"
+    impact        = 1
+    dedupe_string = "dedupe"
+    summary       = "summary"
+
+    incident_tags = {
+      key1 = "value1"
+    }
+
+    notification_target {
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccResponsePlanConfig_actions(rName string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm-incidents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  action {
+    ssm_automation {
+      document_name    = "AWS-RestartEC2Instance"
+      document_version = "$DEFAULT"
+      role_arn         = aws_iam_role.test.arn
+      target_account   = "RESPONSE_PLAN_OWNER_ACCOUNT"
+
+      parameter {
+        name   = "InstanceId"
+        values = ["i-0123456789abcdef0"]
+      }
+
+      dynamic_parameters = {
+        incidentARN = "INCIDENT_RECORD_ARN"
+      }
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
+
+func testAccResponsePlanConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccResponsePlanConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccResponsePlanConfig_base(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = 3
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
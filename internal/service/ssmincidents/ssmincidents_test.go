@@ -0,0 +1,44 @@
+package ssmincidents_test
+
+import (
+	"testing"
+)
+
+// Replication sets are account-wide singletons, so the tests for them and for the
+// response plans that depend on them cannot run in parallel.
+func TestAccSSMIncidents_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"ReplicationSet": {
+			"basic":         testAccReplicationSet_basic,
+			"disappears":    testAccReplicationSet_disappears,
+			"updateRegions": testAccReplicationSet_updateRegions,
+			"updateKMSKey":  testAccReplicationSet_updateKMSKey,
+			"tags":          testAccReplicationSet_tags,
+		},
+		"ReplicationSetDataSource": {
+			"basic": testAccReplicationSetDataSource_basic,
+		},
+		"ResponsePlan": {
+			"basic":            testAccResponsePlan_basic,
+			"disappears":       testAccResponsePlan_disappears,
+			"actions":          testAccResponsePlan_actions,
+			"incidentTemplate": testAccResponsePlan_incidentTemplate,
+			"tags":             testAccResponsePlan_tags,
+		},
+		"ResponsePlanDataSource": {
+			"basic": testAccResponsePlanDataSource_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
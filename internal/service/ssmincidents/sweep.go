@@ -0,0 +1,126 @@
+//go:build sweep
+// +build sweep
+
+package ssmincidents
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
+		Name:         "aws_ssmincidents_replication_set",
+		F:            sweepReplicationSets,
+		Dependencies: []string{"aws_ssmcontacts_contact", "aws_ssmincidents_response_plan"},
+	})
+
+	resource.AddTestSweepers("aws_ssmincidents_response_plan", &resource.Sweeper{
+		Name: "aws_ssmincidents_response_plan",
+		F:    sweepResponsePlans,
+	})
+}
+
+func sweepReplicationSets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SSMIncidentsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &ssmincidents.ListReplicationSetsInput{}
+
+	err = conn.ListReplicationSetsPagesWithContext(ctx, input, func(page *ssmincidents.ListReplicationSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, arn := range page.ReplicationSetArns {
+			r := ResourceReplicationSet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SSM Incidents Replication Sets sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SSM Incidents Replication Sets (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SSM Incidents Replication Sets (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepResponsePlans(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SSMIncidentsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &ssmincidents.ListResponsePlansInput{}
+
+	err = conn.ListResponsePlansPagesWithContext(ctx, input, func(page *ssmincidents.ListResponsePlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, responsePlan := range page.ResponsePlanSummaries {
+			if responsePlan == nil {
+				continue
+			}
+
+			r := ResourceResponsePlan()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(responsePlan.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SSM Incidents Response Plans sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SSM Incidents Response Plans (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SSM Incidents Response Plans (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
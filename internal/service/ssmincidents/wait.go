@@ -0,0 +1,107 @@
+package ssmincidents
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	replicationSetPollInterval = 10 * time.Second
+)
+
+// waitReplicationSetActive waits until the replication set and every one of its Regions are active.
+// Replicating into a new Region can take several minutes, so this polls on a fixed interval
+// rather than backing off.
+func waitReplicationSetActive(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) (*ssmincidents.ReplicationSet, error) {
+	var output *ssmincidents.ReplicationSet
+
+	checkFunc := func() (bool, error) {
+		replicationSet, err := FindReplicationSetByARN(ctx, conn, arn)
+
+		if err != nil {
+			return false, err
+		}
+
+		output = replicationSet
+
+		switch status := aws.StringValue(replicationSet.Status); status {
+		case ssmincidents.ReplicationSetStatusActive:
+		case ssmincidents.ReplicationSetStatusFailed:
+			return false, fmt.Errorf("replication set status: %s%s", status, replicationSetRegionStatusMessages(replicationSet))
+		default:
+			return false, nil
+		}
+
+		for _, region := range replicationSet.RegionMap {
+			if region == nil {
+				continue
+			}
+
+			switch status := aws.StringValue(region.Status); status {
+			case ssmincidents.RegionStatusActive:
+			case ssmincidents.RegionStatusFailed:
+				return false, fmt.Errorf("replication set status: %s%s", status, replicationSetRegionStatusMessages(replicationSet))
+			default:
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+	opts := tfresource.WaitOpts{
+		Delay:        replicationSetPollInterval,
+		PollInterval: replicationSetPollInterval,
+	}
+
+	err := tfresource.WaitUntilContext(ctx, timeout, checkFunc, opts)
+
+	return output, err
+}
+
+// waitReplicationSetDeleted waits until the replication set can no longer be found.
+func waitReplicationSetDeleted(ctx context.Context, conn *ssmincidents.SSMIncidents, arn string, timeout time.Duration) error {
+	checkFunc := func() (bool, error) {
+		replicationSet, err := FindReplicationSetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return true, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		if status := aws.StringValue(replicationSet.Status); status == ssmincidents.ReplicationSetStatusFailed {
+			return false, fmt.Errorf("replication set status: %s%s", status, replicationSetRegionStatusMessages(replicationSet))
+		}
+
+		return false, nil
+	}
+	opts := tfresource.WaitOpts{
+		Delay:        replicationSetPollInterval,
+		PollInterval: replicationSetPollInterval,
+	}
+
+	return tfresource.WaitUntilContext(ctx, timeout, checkFunc, opts)
+}
+
+func replicationSetRegionStatusMessages(replicationSet *ssmincidents.ReplicationSet) string {
+	var messages string
+
+	for name, region := range replicationSet.RegionMap {
+		if region == nil {
+			continue
+		}
+
+		if v := aws.StringValue(region.StatusMessage); v != "" {
+			messages += fmt.Sprintf("; %s: %s", name, v)
+		}
+	}
+
+	return messages
+}
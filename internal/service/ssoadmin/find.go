@@ -69,3 +69,54 @@ func FindManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetA
 
 	return attachedPolicy, err
 }
+
+// FindCustomerManagedPolicy returns the customer managed policy reference attached to a permission set within a specified SSO instance.
+// Returns nil if no matching customer managed policy reference is found.
+func FindCustomerManagedPolicy(conn *ssoadmin.SSOAdmin, policyName, policyPath, permissionSetArn, instanceArn string) (*ssoadmin.CustomerManagedPolicyReference, error) {
+	input := &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput{
+		PermissionSetArn: aws.String(permissionSetArn),
+		InstanceArn:      aws.String(instanceArn),
+	}
+
+	var policyReference *ssoadmin.CustomerManagedPolicyReference
+	err := conn.ListCustomerManagedPolicyReferencesInPermissionSetPages(input, func(page *ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, reference := range page.CustomerManagedPolicyReferences {
+			if reference == nil {
+				continue
+			}
+
+			if aws.StringValue(reference.Name) == policyName && aws.StringValue(reference.Path) == policyPath {
+				policyReference = reference
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return policyReference, err
+}
+
+// FindPermissionsBoundary returns the permissions boundary attached to a permission set within a specified SSO instance.
+// Returns an error if no permissions boundary is found.
+func FindPermissionsBoundary(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) (*ssoadmin.PermissionsBoundary, error) {
+	input := &ssoadmin.GetPermissionsBoundaryForPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	output, err := conn.GetPermissionsBoundaryForPermissionSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.PermissionsBoundary, nil
+}
@@ -0,0 +1,195 @@
+package ssoadmin
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePermissionSets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePermissionSetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permission_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_assignments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"principal_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"principal_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePermissionSetsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+
+	var permissionSetArns []string
+
+	err := conn.ListPermissionSetsPages(&ssoadmin.ListPermissionSetsInput{
+		InstanceArn: aws.String(instanceArn),
+	}, func(page *ssoadmin.ListPermissionSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, permissionSetArn := range page.PermissionSets {
+			if permissionSetArn != nil {
+				permissionSetArns = append(permissionSetArns, aws.StringValue(permissionSetArn))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing SSO Permission Sets: %w", err)
+	}
+
+	permissionSets := make([]interface{}, 0, len(permissionSetArns))
+
+	for _, permissionSetArn := range permissionSetArns {
+		output, err := conn.DescribePermissionSet(&ssoadmin.DescribePermissionSetInput{
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading SSO Permission Set (%s): %w", permissionSetArn, err)
+		}
+
+		if output == nil || output.PermissionSet == nil {
+			continue
+		}
+
+		accountAssignments, err := findPermissionSetAccountAssignments(conn, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return fmt.Errorf("error listing SSO Account Assignments for Permission Set (%s): %w", permissionSetArn, err)
+		}
+
+		permissionSets = append(permissionSets, map[string]interface{}{
+			"account_assignments": flattenAccountAssignments(accountAssignments),
+			"arn":                 permissionSetArn,
+			"name":                aws.StringValue(output.PermissionSet.Name),
+		})
+	}
+
+	d.SetId(instanceArn)
+	if err := d.Set("permission_sets", permissionSets); err != nil {
+		return fmt.Errorf("error setting permission_sets: %w", err)
+	}
+
+	return nil
+}
+
+// findPermissionSetAccountAssignments returns the account assignments of a permission set
+// in every account the permission set is provisioned to.
+func findPermissionSetAccountAssignments(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) ([]*ssoadmin.AccountAssignment, error) {
+	var accountIDs []string
+
+	err := conn.ListAccountsForProvisionedPermissionSetPages(&ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}, func(page *ssoadmin.ListAccountsForProvisionedPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, accountID := range page.AccountIds {
+			if accountID != nil {
+				accountIDs = append(accountIDs, aws.StringValue(accountID))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var accountAssignments []*ssoadmin.AccountAssignment
+
+	for _, accountID := range accountIDs {
+		err := conn.ListAccountAssignmentsPages(&ssoadmin.ListAccountAssignmentsInput{
+			AccountId:        aws.String(accountID),
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+		}, func(page *ssoadmin.ListAccountAssignmentsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, a := range page.AccountAssignments {
+				if a != nil {
+					accountAssignments = append(accountAssignments, a)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accountAssignments, nil
+}
+
+func flattenAccountAssignments(apiObjects []*ssoadmin.AccountAssignment) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":     aws.StringValue(apiObject.AccountId),
+			"principal_id":   aws.StringValue(apiObject.PrincipalId),
+			"principal_type": aws.StringValue(apiObject.PrincipalType),
+		})
+	}
+
+	return tfList
+}
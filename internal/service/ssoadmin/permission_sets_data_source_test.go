@@ -0,0 +1,51 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSOAdminPermissionSetsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ssoadmin_permission_sets.test"
+	resourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOPermissionSetsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_arn", resourceName, "instance_arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "permission_sets.*", map[string]string{
+						"account_assignments.#": "0",
+						"name":                  rName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "permission_sets.*.arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSOPermissionSetsDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+data "aws_ssoadmin_permission_sets" "test" {
+  instance_arn = aws_ssoadmin_permission_set.test.instance_arn
+}
+`, rName)
+}
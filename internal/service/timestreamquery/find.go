@@ -0,0 +1,36 @@
+package timestreamquery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindScheduledQueryByARN(ctx context.Context, conn *timestreamquery.TimestreamQuery, arn string) (*timestreamquery.ScheduledQueryDescription, error) {
+	input := &timestreamquery.DescribeScheduledQueryInput{
+		ScheduledQueryArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeScheduledQueryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, timestreamquery.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledQuery == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledQuery, nil
+}
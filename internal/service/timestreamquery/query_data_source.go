@@ -0,0 +1,295 @@
+package timestreamquery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// queryPageMaxRows is the largest page the Query API returns.
+	queryPageMaxRows = 1000
+
+	columnTypeArray      = "ARRAY"
+	columnTypeRow        = "ROW"
+	columnTypeTimeSeries = "TIMESERIES"
+)
+
+func DataSourceQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQueryRead,
+
+		Schema: map[string]*schema.Schema{
+			"column": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_rows": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"query_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"row": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"json_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"null_value": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"scalar_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+
+	maxRows := d.Get("max_rows").(int)
+	input := &timestreamquery.QueryInput{
+		QueryString: aws.String(d.Get("query_string").(string)),
+	}
+
+	if maxRows > 0 && maxRows < queryPageMaxRows {
+		input.MaxRows = aws.Int64(int64(maxRows))
+	}
+
+	var columns []*timestreamquery.ColumnInfo
+	var queryID string
+	var rows []*timestreamquery.Row
+	var truncated bool
+
+	// Pages may be empty while the query is still running; paging continues until there's no next token.
+	err := conn.QueryPagesWithContext(ctx, input, func(page *timestreamquery.QueryOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		queryID = aws.StringValue(page.QueryId)
+
+		if len(page.ColumnInfo) > 0 {
+			columns = page.ColumnInfo
+		}
+
+		rows = append(rows, page.Rows...)
+
+		if maxRows > 0 && len(rows) >= maxRows {
+			rows = rows[:maxRows]
+			truncated = !lastPage
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("error running Timestream Query: %s", err)
+	}
+
+	// Stop the query from running on in the background once it has returned enough rows.
+	if truncated {
+		_, err := conn.CancelQueryWithContext(ctx, &timestreamquery.CancelQueryInput{
+			QueryId: aws.String(queryID),
+		})
+
+		// A query that has already finished can't be canceled.
+		if err != nil && !tfawserr.ErrCodeEquals(err, timestreamquery.ErrCodeValidationException) {
+			return diag.Errorf("error canceling Timestream Query (%s): %s", queryID, err)
+		}
+	}
+
+	d.SetId(queryID)
+	if err := d.Set("column", flattenColumnInfos(columns)); err != nil {
+		return diag.Errorf("error setting column: %s", err)
+	}
+	d.Set("query_id", queryID)
+
+	tfRows, err := flattenRows(rows)
+
+	if err != nil {
+		return diag.Errorf("error reading Timestream Query (%s) results: %s", queryID, err)
+	}
+
+	if err := d.Set("row", tfRows); err != nil {
+		return diag.Errorf("error setting row: %s", err)
+	}
+
+	return nil
+}
+
+func flattenColumnInfos(apiObjects []*timestreamquery.ColumnInfo) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": columnType(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+// columnType returns the scalar type of a column, or ARRAY, ROW or TIMESERIES for complex columns.
+func columnType(apiObject *timestreamquery.Type) string {
+	switch {
+	case apiObject == nil:
+		return ""
+	case apiObject.ArrayColumnInfo != nil:
+		return columnTypeArray
+	case apiObject.RowColumnInfo != nil:
+		return columnTypeRow
+	case apiObject.TimeSeriesMeasureValueColumnInfo != nil:
+		return columnTypeTimeSeries
+	default:
+		return aws.StringValue(apiObject.ScalarType)
+	}
+}
+
+func flattenRows(apiObjects []*timestreamquery.Row) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var tfData []interface{}
+
+		for _, datum := range apiObject.Data {
+			tfMap, err := flattenDatum(datum)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfData = append(tfData, tfMap)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"data": tfData,
+		})
+	}
+
+	return tfList, nil
+}
+
+// flattenDatum returns a scalar value as a string and JSON-encodes array, row and time series values.
+func flattenDatum(apiObject *timestreamquery.Datum) (map[string]interface{}, error) {
+	tfMap := map[string]interface{}{
+		"json_value":   "",
+		"null_value":   false,
+		"scalar_value": "",
+	}
+
+	switch {
+	case apiObject == nil || aws.BoolValue(apiObject.NullValue):
+		tfMap["null_value"] = true
+	case apiObject.ScalarValue != nil:
+		tfMap["scalar_value"] = aws.StringValue(apiObject.ScalarValue)
+	default:
+		b, err := json.Marshal(datumValue(apiObject))
+
+		if err != nil {
+			return nil, fmt.Errorf("encoding value: %w", err)
+		}
+
+		tfMap["json_value"] = string(b)
+	}
+
+	return tfMap, nil
+}
+
+func datumValue(apiObject *timestreamquery.Datum) interface{} {
+	switch {
+	case apiObject == nil || aws.BoolValue(apiObject.NullValue):
+		return nil
+	case apiObject.ScalarValue != nil:
+		return aws.StringValue(apiObject.ScalarValue)
+	case apiObject.ArrayValue != nil:
+		values := make([]interface{}, 0, len(apiObject.ArrayValue))
+
+		for _, v := range apiObject.ArrayValue {
+			values = append(values, datumValue(v))
+		}
+
+		return values
+	case apiObject.RowValue != nil:
+		values := make([]interface{}, 0, len(apiObject.RowValue.Data))
+
+		for _, v := range apiObject.RowValue.Data {
+			values = append(values, datumValue(v))
+		}
+
+		return values
+	case apiObject.TimeSeriesValue != nil:
+		values := make([]interface{}, 0, len(apiObject.TimeSeriesValue))
+
+		for _, v := range apiObject.TimeSeriesValue {
+			if v == nil {
+				continue
+			}
+
+			values = append(values, map[string]interface{}{
+				"time":  aws.StringValue(v.Time),
+				"value": datumValue(v.Value),
+			})
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package timestreamquery_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccTimestreamQueryQueryDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_timestreamquery_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "column.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.0.name", "one"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.0.type", "INTEGER"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.1.name", "letter"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.1.type", "VARCHAR"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.2.name", "numbers"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.2.type", "ARRAY"),
+					resource.TestCheckResourceAttr(dataSourceName, "column.3.name", "nothing"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.0.data.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.0.data.0.scalar_value", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.0.data.1.scalar_value", "a"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.0.data.2.json_value", `["1","2"]`),
+					resource.TestCheckResourceAttr(dataSourceName, "row.0.data.3.null_value", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryQueryDataSource_maxRows(t *testing.T) {
+	dataSourceName := "data.aws_timestreamquery_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_maxRows,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "row.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.0.data.0.scalar_value", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "row.2.data.0.scalar_value", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccQueryDataSourceConfig_basic = `
+data "aws_timestreamquery_query" "test" {
+  query_string = "SELECT 1 AS one, 'a' AS letter, ARRAY[1, 2] AS numbers, CAST(NULL AS VARCHAR) AS nothing"
+}
+`
+
+const testAccQueryDataSourceConfig_maxRows = `
+data "aws_timestreamquery_query" "test" {
+  query_string = "SELECT n FROM UNNEST(sequence(1, 10)) AS t(n) ORDER BY n"
+  max_rows     = 3
+}
+`
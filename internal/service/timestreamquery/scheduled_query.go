@@ -0,0 +1,771 @@
+package timestreamquery
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceScheduledQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScheduledQueryCreate,
+		ReadWithoutTimeout:   resourceScheduledQueryRead,
+		UpdateWithoutTimeout: resourceScheduledQueryUpdate,
+		DeleteWithoutTimeout: resourceScheduledQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_report_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"encryption_option": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(timestreamquery.S3EncryptionOption_Values(), false),
+									},
+									"object_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 896),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9|!\-_*'()][a-zA-Z0-9!\-_*'()/.]*$`), "must only include alphanumeric and !-_*'()/. characters"),
+				),
+			},
+			"notification_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sns_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"schedule_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"scheduled_query_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      timestreamquery.ScheduledQueryStateEnabled,
+				ValidateFunc: validation.StringInSlice(timestreamquery.ScheduledQueryState_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestream_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dimension_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimension_value_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(timestreamquery.DimensionValueType_Values(), false),
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"measure_name_column": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"mixed_measure_mapping": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"measure_value_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(timestreamquery.MeasureValueType_Values(), false),
+												},
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingSchema(false),
+												"source_column": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"target_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"multi_measure_mappings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingSchema(true),
+												"target_multi_measure_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"table_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"time_column": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func multiMeasureAttributeMappingSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"measure_value_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(timestreamquery.ScalarMeasureValueType_Values(), false),
+				},
+				"source_column": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"target_multi_measure_attribute_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduledQueryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &timestreamquery.CreateScheduledQueryInput{
+		ErrorReportConfiguration:       expandErrorReportConfiguration(d.Get("error_report_configuration").([]interface{})),
+		Name:                           aws.String(name),
+		NotificationConfiguration:      expandNotificationConfiguration(d.Get("notification_configuration").([]interface{})),
+		QueryString:                    aws.String(d.Get("query_string").(string)),
+		ScheduleConfiguration:          expandScheduleConfiguration(d.Get("schedule_configuration").([]interface{})),
+		ScheduledQueryExecutionRoleArn: aws.String(d.Get("scheduled_query_execution_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_configuration"); ok {
+		input.TargetConfiguration = expandTargetConfiguration(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Query Scheduled Query: %s", input)
+	output, err := conn.CreateScheduledQueryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Timestream Query Scheduled Query (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	// Scheduled queries are always created enabled.
+	if v := d.Get("state").(string); v != timestreamquery.ScheduledQueryStateEnabled {
+		if err := updateScheduledQueryState(ctx, conn, d.Id(), v); err != nil {
+			return diag.Errorf("error updating Timestream Query Scheduled Query (%s) state: %s", d.Id(), err)
+		}
+	}
+
+	return resourceScheduledQueryRead(ctx, d, meta)
+}
+
+func resourceScheduledQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	scheduledQuery, err := FindScheduledQueryByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream Query Scheduled Query (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Timestream Query Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", scheduledQuery.Arn)
+	if err := d.Set("error_report_configuration", flattenErrorReportConfiguration(scheduledQuery.ErrorReportConfiguration)); err != nil {
+		return diag.Errorf("error setting error_report_configuration: %s", err)
+	}
+	d.Set("kms_key_id", scheduledQuery.KmsKeyId)
+	d.Set("name", scheduledQuery.Name)
+	if err := d.Set("notification_configuration", flattenNotificationConfiguration(scheduledQuery.NotificationConfiguration)); err != nil {
+		return diag.Errorf("error setting notification_configuration: %s", err)
+	}
+	d.Set("query_string", scheduledQuery.QueryString)
+	if err := d.Set("schedule_configuration", flattenScheduleConfiguration(scheduledQuery.ScheduleConfiguration)); err != nil {
+		return diag.Errorf("error setting schedule_configuration: %s", err)
+	}
+	d.Set("scheduled_query_execution_role_arn", scheduledQuery.ScheduledQueryExecutionRoleArn)
+	d.Set("state", scheduledQuery.State)
+	if err := d.Set("target_configuration", flattenTargetConfiguration(scheduledQuery.TargetConfiguration)); err != nil {
+		return diag.Errorf("error setting target_configuration: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Timestream Query Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceScheduledQueryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+
+	if d.HasChange("state") {
+		if err := updateScheduledQueryState(ctx, conn, d.Id(), d.Get("state").(string)); err != nil {
+			return diag.Errorf("error updating Timestream Query Scheduled Query (%s) state: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Timestream Query Scheduled Query (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceScheduledQueryRead(ctx, d, meta)
+}
+
+func resourceScheduledQueryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamQueryConn
+
+	log.Printf("[DEBUG] Deleting Timestream Query Scheduled Query: %s", d.Id())
+	_, err := conn.DeleteScheduledQueryWithContext(ctx, &timestreamquery.DeleteScheduledQueryInput{
+		ScheduledQueryArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreamquery.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Timestream Query Scheduled Query (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateScheduledQueryState enables or disables a scheduled query, the only change the API allows.
+func updateScheduledQueryState(ctx context.Context, conn *timestreamquery.TimestreamQuery, arn, state string) error {
+	input := &timestreamquery.UpdateScheduledQueryInput{
+		ScheduledQueryArn: aws.String(arn),
+		State:             aws.String(state),
+	}
+
+	log.Printf("[DEBUG] Updating Timestream Query Scheduled Query: %s", input)
+	_, err := conn.UpdateScheduledQueryWithContext(ctx, input)
+
+	return err
+}
+
+func expandErrorReportConfiguration(tfList []interface{}) *timestreamquery.ErrorReportConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &timestreamquery.ErrorReportConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandS3Configuration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3Configuration(tfMap map[string]interface{}) *timestreamquery.S3Configuration {
+	apiObject := &timestreamquery.S3Configuration{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_option"].(string); ok && v != "" {
+		apiObject.EncryptionOption = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_key_prefix"].(string); ok && v != "" {
+		apiObject.ObjectKeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNotificationConfiguration(tfList []interface{}) *timestreamquery.NotificationConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &timestreamquery.NotificationConfiguration{}
+
+	if v, ok := tfMap["sns_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnsConfiguration = &timestreamquery.SnsConfiguration{
+			TopicArn: aws.String(v[0].(map[string]interface{})["topic_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduleConfiguration(tfList []interface{}) *timestreamquery.ScheduleConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &timestreamquery.ScheduleConfiguration{
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+	}
+}
+
+func expandTargetConfiguration(tfList []interface{}) *timestreamquery.TargetConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &timestreamquery.TargetConfiguration{}
+
+	if v, ok := tfMap["timestream_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TimestreamConfiguration = expandTimestreamConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTimestreamConfiguration(tfMap map[string]interface{}) *timestreamquery.TimestreamConfiguration {
+	apiObject := &timestreamquery.TimestreamConfiguration{
+		DatabaseName:      aws.String(tfMap["database_name"].(string)),
+		DimensionMappings: expandDimensionMappings(tfMap["dimension_mapping"].([]interface{})),
+		TableName:         aws.String(tfMap["table_name"].(string)),
+		TimeColumn:        aws.String(tfMap["time_column"].(string)),
+	}
+
+	if v, ok := tfMap["measure_name_column"].(string); ok && v != "" {
+		apiObject.MeasureNameColumn = aws.String(v)
+	}
+
+	if v, ok := tfMap["mixed_measure_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MixedMeasureMappings = expandMixedMeasureMappings(v)
+	}
+
+	if v, ok := tfMap["multi_measure_mappings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MultiMeasureMappings = expandMultiMeasureMappings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDimensionMappings(tfList []interface{}) []*timestreamquery.DimensionMapping {
+	apiObjects := []*timestreamquery.DimensionMapping{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &timestreamquery.DimensionMapping{
+			DimensionValueType: aws.String(tfMap["dimension_value_type"].(string)),
+			Name:               aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMixedMeasureMappings(tfList []interface{}) []*timestreamquery.MixedMeasureMapping {
+	var apiObjects []*timestreamquery.MixedMeasureMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &timestreamquery.MixedMeasureMapping{
+			MeasureValueType: aws.String(tfMap["measure_value_type"].(string)),
+		}
+
+		if v, ok := tfMap["measure_name"].(string); ok && v != "" {
+			apiObject.MeasureName = aws.String(v)
+		}
+
+		if v, ok := tfMap["multi_measure_attribute_mapping"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MultiMeasureAttributeMappings = expandMultiMeasureAttributeMappings(v)
+		}
+
+		if v, ok := tfMap["source_column"].(string); ok && v != "" {
+			apiObject.SourceColumn = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_measure_name"].(string); ok && v != "" {
+			apiObject.TargetMeasureName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMultiMeasureMappings(tfMap map[string]interface{}) *timestreamquery.MultiMeasureMappings {
+	apiObject := &timestreamquery.MultiMeasureMappings{
+		MultiMeasureAttributeMappings: expandMultiMeasureAttributeMappings(tfMap["multi_measure_attribute_mapping"].([]interface{})),
+	}
+
+	if v, ok := tfMap["target_multi_measure_name"].(string); ok && v != "" {
+		apiObject.TargetMultiMeasureName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiMeasureAttributeMappings(tfList []interface{}) []*timestreamquery.MultiMeasureAttributeMapping {
+	var apiObjects []*timestreamquery.MultiMeasureAttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &timestreamquery.MultiMeasureAttributeMapping{
+			MeasureValueType: aws.String(tfMap["measure_value_type"].(string)),
+			SourceColumn:     aws.String(tfMap["source_column"].(string)),
+		}
+
+		if v, ok := tfMap["target_multi_measure_attribute_name"].(string); ok && v != "" {
+			apiObject.TargetMultiMeasureAttributeName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenErrorReportConfiguration(apiObject *timestreamquery.ErrorReportConfiguration) []interface{} {
+	if apiObject == nil || apiObject.S3Configuration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_configuration": []interface{}{map[string]interface{}{
+			"bucket_name":       aws.StringValue(apiObject.S3Configuration.BucketName),
+			"encryption_option": aws.StringValue(apiObject.S3Configuration.EncryptionOption),
+			"object_key_prefix": aws.StringValue(apiObject.S3Configuration.ObjectKeyPrefix),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNotificationConfiguration(apiObject *timestreamquery.NotificationConfiguration) []interface{} {
+	if apiObject == nil || apiObject.SnsConfiguration == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sns_configuration": []interface{}{map[string]interface{}{
+			"topic_arn": aws.StringValue(apiObject.SnsConfiguration.TopicArn),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenScheduleConfiguration(apiObject *timestreamquery.ScheduleConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"schedule_expression": aws.StringValue(apiObject.ScheduleExpression),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTargetConfiguration(apiObject *timestreamquery.TargetConfiguration) []interface{} {
+	if apiObject == nil || apiObject.TimestreamConfiguration == nil {
+		return nil
+	}
+
+	config := apiObject.TimestreamConfiguration
+	tfConfig := map[string]interface{}{
+		"database_name":          aws.StringValue(config.DatabaseName),
+		"dimension_mapping":      flattenDimensionMappings(config.DimensionMappings),
+		"measure_name_column":    aws.StringValue(config.MeasureNameColumn),
+		"mixed_measure_mapping":  flattenMixedMeasureMappings(config.MixedMeasureMappings),
+		"multi_measure_mappings": flattenMultiMeasureMappings(config.MultiMeasureMappings),
+		"table_name":             aws.StringValue(config.TableName),
+		"time_column":            aws.StringValue(config.TimeColumn),
+	}
+
+	tfMap := map[string]interface{}{
+		"timestream_configuration": []interface{}{tfConfig},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDimensionMappings(apiObjects []*timestreamquery.DimensionMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"dimension_value_type": aws.StringValue(apiObject.DimensionValueType),
+			"name":                 aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMixedMeasureMappings(apiObjects []*timestreamquery.MixedMeasureMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"measure_name":                    aws.StringValue(apiObject.MeasureName),
+			"measure_value_type":              aws.StringValue(apiObject.MeasureValueType),
+			"multi_measure_attribute_mapping": flattenMultiMeasureAttributeMappings(apiObject.MultiMeasureAttributeMappings),
+			"source_column":                   aws.StringValue(apiObject.SourceColumn),
+			"target_measure_name":             aws.StringValue(apiObject.TargetMeasureName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMultiMeasureMappings(apiObject *timestreamquery.MultiMeasureMappings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"multi_measure_attribute_mapping": flattenMultiMeasureAttributeMappings(apiObject.MultiMeasureAttributeMappings),
+		"target_multi_measure_name":       aws.StringValue(apiObject.TargetMultiMeasureName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMultiMeasureAttributeMappings(apiObjects []*timestreamquery.MultiMeasureAttributeMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"measure_value_type":                  aws.StringValue(apiObject.MeasureValueType),
+			"source_column":                       aws.StringValue(apiObject.SourceColumn),
+			"target_multi_measure_attribute_name": aws.StringValue(apiObject.TargetMultiMeasureAttributeName),
+		})
+	}
+
+	return tfList
+}
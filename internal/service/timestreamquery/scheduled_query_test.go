@@ -0,0 +1,364 @@
+package timestreamquery_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreamquery "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTimestreamQueryScheduledQuery_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	roleResourceName := "aws_iam_role.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "timestream", regexp.MustCompile(`scheduled-query/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "error_report_configuration.0.s3_configuration.0.bucket_name", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.0.s3_configuration.0.object_key_prefix", "errors"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration.0.sns_configuration.0.topic_arn", topicResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "query_string"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttrPair(resourceName, "scheduled_query_execution_role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.0.dimension_value_type", "VARCHAR"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.0.name", "region"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.0.measure_value_type", "DOUBLE"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.0.source_column", "value"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.target_multi_measure_name", "metrics"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.table_name", "target"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.time_column", "binned_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tftimestreamquery.ResourceScheduledQuery(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_state(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledQueryConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, timestreamquery.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckScheduledQueryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledQueryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_timestreamquery_scheduled_query" {
+			continue
+		}
+
+		_, err := tftimestreamquery.FindScheduledQueryByARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Timestream Query Scheduled Query %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckScheduledQueryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Timestream Query Scheduled Query ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn
+
+		_, err := tftimestreamquery.FindScheduledQueryByARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryConn
+
+	input := &timestreamquery.ListScheduledQueriesInput{}
+
+	_, err := conn.ListScheduledQueries(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccScheduledQueryConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = "target"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "timestream.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["timestream:*"]
+        Effect   = "Allow"
+        Resource = "*"
+      },
+      {
+        Action   = ["s3:GetBucketAcl", "s3:PutObject"]
+        Effect   = "Allow"
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      },
+      {
+        Action   = ["sns:Publish"]
+        Effect   = "Allow"
+        Resource = aws_sns_topic.test.arn
+      },
+    ]
+  })
+}
+`, rName)
+}
+
+func testAccScheduledQueryConfig_resource(rName, state, tags string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamquery_scheduled_query" "test" {
+  name                               = %[1]q
+  query_string                       = "SELECT 'us-east-1' AS region, bin(@scheduled_runtime, 1h) AS binned_time, 1.0 AS value"
+  scheduled_query_execution_role_arn = aws_iam_role.test.arn
+  state                              = %[2]q
+
+  error_report_configuration {
+    s3_configuration {
+      bucket_name       = aws_s3_bucket.test.bucket
+      object_key_prefix = "errors"
+    }
+  }
+
+  notification_configuration {
+    sns_configuration {
+      topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  schedule_configuration {
+    schedule_expression = "rate(1 hour)"
+  }
+
+  target_configuration {
+    timestream_configuration {
+      database_name = aws_timestreamwrite_table.test.database_name
+      table_name    = aws_timestreamwrite_table.test.table_name
+      time_column   = "binned_time"
+
+      dimension_mapping {
+        name                 = "region"
+        dimension_value_type = "VARCHAR"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "metrics"
+
+        multi_measure_attribute_mapping {
+          source_column      = "value"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+%[3]s
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, state, tags)
+}
+
+func testAccScheduledQueryConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(testAccScheduledQueryConfig_base(rName), testAccScheduledQueryConfig_resource(rName, state, ""))
+}
+
+func testAccScheduledQueryConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccScheduledQueryConfig_base(rName), testAccScheduledQueryConfig_resource(rName, "ENABLED", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1)))
+}
+
+func testAccScheduledQueryConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccScheduledQueryConfig_base(rName), testAccScheduledQueryConfig_resource(rName, "ENABLED", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2)))
+}
@@ -0,0 +1,74 @@
+//go:build sweep
+// +build sweep
+
+package timestreamquery
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamquery"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_timestreamquery_scheduled_query", &resource.Sweeper{
+		Name: "aws_timestreamquery_scheduled_query",
+		F:    sweepScheduledQueries,
+	})
+}
+
+func sweepScheduledQueries(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).TimestreamQueryConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &timestreamquery.ListScheduledQueriesInput{}
+
+	err = conn.ListScheduledQueriesPagesWithContext(ctx, input, func(page *timestreamquery.ListScheduledQueriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, scheduledQuery := range page.ScheduledQueries {
+			if scheduledQuery == nil {
+				continue
+			}
+
+			r := ResourceScheduledQuery()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(scheduledQuery.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream Query Scheduled Queries sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Timestream Query Scheduled Queries (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Timestream Query Scheduled Queries (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
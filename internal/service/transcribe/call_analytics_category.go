@@ -0,0 +1,507 @@
+package transcribe
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCallAnalyticsCategory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCallAnalyticsCategoryCreate,
+		ReadWithoutTimeout:   resourceCallAnalyticsCategoryRead,
+		UpdateWithoutTimeout: resourceCallAnalyticsCategoryUpdate,
+		DeleteWithoutTimeout: resourceCallAnalyticsCategoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"category_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"input_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.InputType_Values(), false),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interruption_filter": ruleFilterSchema(map[string]*schema.Schema{
+							"participant_role": participantRoleSchema(),
+							"threshold": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+						}),
+						"non_talk_time_filter": ruleFilterSchema(map[string]*schema.Schema{
+							"threshold": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+						}),
+						"sentiment_filter": ruleFilterSchema(map[string]*schema.Schema{
+							"participant_role": participantRoleSchema(),
+							"sentiments": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringInSlice(transcribeservice.SentimentValue_Values(), false),
+								},
+							},
+						}),
+						"transcript_filter": ruleFilterSchema(map[string]*schema.Schema{
+							"participant_role": participantRoleSchema(),
+							"targets": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"transcript_filter_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      transcribeservice.TranscriptFilterTypeExact,
+								ValidateFunc: validation.StringInSlice(transcribeservice.TranscriptFilterType_Values(), false),
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+// ruleFilterSchema returns the schema of a rule filter block, adding the time
+// ranges and negation common to every filter type to the given attributes.
+func ruleFilterSchema(s map[string]*schema.Schema) *schema.Schema {
+	s["absolute_time_range"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"start_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+	s["negate"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	s["relative_time_range"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"first": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"last": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"start_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func participantRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(transcribeservice.ParticipantRole_Values(), false),
+	}
+}
+
+func resourceCallAnalyticsCategoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	name := d.Get("category_name").(string)
+	input := &transcribeservice.CreateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+		Rules:        expandRules(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("input_type"); ok {
+		input.InputType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Call Analytics Category: %s", input)
+	_, err := conn.CreateCallAnalyticsCategoryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Transcribe Call Analytics Category (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceCallAnalyticsCategoryRead(ctx, d, meta)
+}
+
+func resourceCallAnalyticsCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	category, err := FindCallAnalyticsCategoryByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Call Analytics Category (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Transcribe Call Analytics Category (%s): %s", d.Id(), err)
+	}
+
+	d.Set("category_name", category.CategoryName)
+	d.Set("input_type", category.InputType)
+	if err := d.Set("rule", flattenRules(category.Rules)); err != nil {
+		return diag.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCallAnalyticsCategoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	input := &transcribeservice.UpdateCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+		InputType:    aws.String(d.Get("input_type").(string)),
+		Rules:        expandRules(d.Get("rule").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Transcribe Call Analytics Category: %s", input)
+	_, err := conn.UpdateCallAnalyticsCategoryWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Transcribe Call Analytics Category (%s): %s", d.Id(), err)
+	}
+
+	return resourceCallAnalyticsCategoryRead(ctx, d, meta)
+}
+
+func resourceCallAnalyticsCategoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Call Analytics Category: %s", d.Id())
+	_, err := conn.DeleteCallAnalyticsCategoryWithContext(ctx, &transcribeservice.DeleteCallAnalyticsCategoryInput{
+		CategoryName: aws.String(d.Id()),
+	})
+
+	if isNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Transcribe Call Analytics Category (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRules(tfList []interface{}) []*transcribeservice.Rule {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*transcribeservice.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &transcribeservice.Rule{}
+
+		if v, ok := tfMap["interruption_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.InterruptionFilter = expandInterruptionFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["non_talk_time_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.NonTalkTimeFilter = expandNonTalkTimeFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["sentiment_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SentimentFilter = expandSentimentFilter(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["transcript_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TranscriptFilter = expandTranscriptFilter(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInterruptionFilter(tfMap map[string]interface{}) *transcribeservice.InterruptionFilter {
+	apiObject := &transcribeservice.InterruptionFilter{
+		AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+		Negate:            aws.Bool(tfMap["negate"].(bool)),
+		RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+	}
+
+	if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+		apiObject.ParticipantRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["threshold"].(int); ok && v > 0 {
+		apiObject.Threshold = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandNonTalkTimeFilter(tfMap map[string]interface{}) *transcribeservice.NonTalkTimeFilter {
+	apiObject := &transcribeservice.NonTalkTimeFilter{
+		AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+		Negate:            aws.Bool(tfMap["negate"].(bool)),
+		RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+	}
+
+	if v, ok := tfMap["threshold"].(int); ok && v > 0 {
+		apiObject.Threshold = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandSentimentFilter(tfMap map[string]interface{}) *transcribeservice.SentimentFilter {
+	apiObject := &transcribeservice.SentimentFilter{
+		AbsoluteTimeRange: expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+		Negate:            aws.Bool(tfMap["negate"].(bool)),
+		RelativeTimeRange: expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+		Sentiments:        flex.ExpandStringSet(tfMap["sentiments"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+		apiObject.ParticipantRole = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTranscriptFilter(tfMap map[string]interface{}) *transcribeservice.TranscriptFilter {
+	apiObject := &transcribeservice.TranscriptFilter{
+		AbsoluteTimeRange:    expandAbsoluteTimeRange(tfMap["absolute_time_range"].([]interface{})),
+		Negate:               aws.Bool(tfMap["negate"].(bool)),
+		RelativeTimeRange:    expandRelativeTimeRange(tfMap["relative_time_range"].([]interface{})),
+		Targets:              flex.ExpandStringList(tfMap["targets"].([]interface{})),
+		TranscriptFilterType: aws.String(tfMap["transcript_filter_type"].(string)),
+	}
+
+	if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+		apiObject.ParticipantRole = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAbsoluteTimeRange(tfList []interface{}) *transcribeservice.AbsoluteTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &transcribeservice.AbsoluteTimeRange{}
+
+	// A start time of zero is meaningful, so the start and end of a range are
+	// sent together whenever an end is set.
+	if v, ok := tfMap["end_time"].(int); ok && v > 0 {
+		apiObject.EndTime = aws.Int64(int64(v))
+		apiObject.StartTime = aws.Int64(int64(tfMap["start_time"].(int)))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v > 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v > 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandRelativeTimeRange(tfList []interface{}) *transcribeservice.RelativeTimeRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &transcribeservice.RelativeTimeRange{}
+
+	if v, ok := tfMap["end_percentage"].(int); ok && v > 0 {
+		apiObject.EndPercentage = aws.Int64(int64(v))
+		apiObject.StartPercentage = aws.Int64(int64(tfMap["start_percentage"].(int)))
+	}
+
+	if v, ok := tfMap["first"].(int); ok && v > 0 {
+		apiObject.First = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["last"].(int); ok && v > 0 {
+		apiObject.Last = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRules(apiObjects []*transcribeservice.Rule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.InterruptionFilter; v != nil {
+			tfMap["interruption_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":              aws.BoolValue(v.Negate),
+				"participant_role":    aws.StringValue(v.ParticipantRole),
+				"relative_time_range": flattenRelativeTimeRange(v.RelativeTimeRange),
+				"threshold":           aws.Int64Value(v.Threshold),
+			}}
+		}
+
+		if v := apiObject.NonTalkTimeFilter; v != nil {
+			tfMap["non_talk_time_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":              aws.BoolValue(v.Negate),
+				"relative_time_range": flattenRelativeTimeRange(v.RelativeTimeRange),
+				"threshold":           aws.Int64Value(v.Threshold),
+			}}
+		}
+
+		if v := apiObject.SentimentFilter; v != nil {
+			tfMap["sentiment_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range": flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":              aws.BoolValue(v.Negate),
+				"participant_role":    aws.StringValue(v.ParticipantRole),
+				"relative_time_range": flattenRelativeTimeRange(v.RelativeTimeRange),
+				"sentiments":          flex.FlattenStringSet(v.Sentiments),
+			}}
+		}
+
+		if v := apiObject.TranscriptFilter; v != nil {
+			tfMap["transcript_filter"] = []interface{}{map[string]interface{}{
+				"absolute_time_range":    flattenAbsoluteTimeRange(v.AbsoluteTimeRange),
+				"negate":                 aws.BoolValue(v.Negate),
+				"participant_role":       aws.StringValue(v.ParticipantRole),
+				"relative_time_range":    flattenRelativeTimeRange(v.RelativeTimeRange),
+				"targets":                aws.StringValueSlice(v.Targets),
+				"transcript_filter_type": aws.StringValue(v.TranscriptFilterType),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAbsoluteTimeRange(apiObject *transcribeservice.AbsoluteTimeRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"end_time":   aws.Int64Value(apiObject.EndTime),
+		"first":      aws.Int64Value(apiObject.First),
+		"last":       aws.Int64Value(apiObject.Last),
+		"start_time": aws.Int64Value(apiObject.StartTime),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRelativeTimeRange(apiObject *transcribeservice.RelativeTimeRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"end_percentage":   aws.Int64Value(apiObject.EndPercentage),
+		"first":            aws.Int64Value(apiObject.First),
+		"last":             aws.Int64Value(apiObject.Last),
+		"start_percentage": aws.Int64Value(apiObject.StartPercentage),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,224 @@
+package transcribe_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeCallAnalyticsCategory_basic(t *testing.T) {
+	var v transcribeservice.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCallAnalyticsCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "category_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "input_type", transcribeservice.InputTypePostCall),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.negate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.participant_role", transcribeservice.ParticipantRoleCustomer),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.targets.0", "cancel my subscription"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.0.transcript_filter_type", transcribeservice.TranscriptFilterTypeExact),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_disappears(t *testing.T) {
+	var v transcribeservice.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCallAnalyticsCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceCallAnalyticsCategory(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeCallAnalyticsCategory_rules(t *testing.T) {
+	var v transcribeservice.CategoryProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_call_analytics_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCallAnalyticsCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallAnalyticsCategoryRulesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.interruption_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.interruption_filter.0.participant_role", "AGENT"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.interruption_filter.0.threshold", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.0.absolute_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.0.absolute_time_range.0.start_time", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.0.absolute_time_range.0.end_time", "60000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.non_talk_time_filter.0.threshold", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.sentiment_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.sentiment_filter.0.negate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.sentiment_filter.0.relative_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.sentiment_filter.0.relative_time_range.0.last", "20"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.sentiment_filter.0.sentiments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule.2.sentiment_filter.0.sentiments.*", "POSITIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCallAnalyticsCategoryConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCallAnalyticsCategoryExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transcript_filter.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCallAnalyticsCategoryExists(n string, v *transcribeservice.CategoryProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Call Analytics Category ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindCallAnalyticsCategoryByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCallAnalyticsCategoryDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_call_analytics_category" {
+			continue
+		}
+
+		_, err := tftranscribe.FindCallAnalyticsCategoryByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Call Analytics Category %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCallAnalyticsCategoryConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+
+  rule {
+    transcript_filter {
+      participant_role = "CUSTOMER"
+      targets          = ["cancel my subscription"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCallAnalyticsCategoryRulesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_call_analytics_category" "test" {
+  category_name = %[1]q
+
+  rule {
+    interruption_filter {
+      participant_role = "AGENT"
+      threshold        = 10000
+    }
+  }
+
+  rule {
+    non_talk_time_filter {
+      threshold = 5000
+
+      absolute_time_range {
+        start_time = 0
+        end_time   = 60000
+      }
+    }
+  }
+
+  rule {
+    sentiment_filter {
+      negate     = true
+      sentiments = ["POSITIVE"]
+
+      relative_time_range {
+        last = 20
+      }
+    }
+  }
+}
+`, rName)
+}
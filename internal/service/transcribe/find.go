@@ -0,0 +1,143 @@
+package transcribe
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// isNotFoundError returns whether the error indicates a missing resource. Some
+// Get operations report a missing resource as a bad request.
+func isNotFoundError(err error) bool {
+	return tfawserr.ErrCodeEquals(err, transcribeservice.ErrCodeNotFoundException) ||
+		tfawserr.ErrMessageContains(err, transcribeservice.ErrCodeBadRequestException, "couldn't be found")
+}
+
+func FindVocabularyByName(ctx context.Context, conn *transcribeservice.TranscribeService, name string) (*transcribeservice.GetVocabularyOutput, error) {
+	input := &transcribeservice.GetVocabularyInput{
+		VocabularyName: aws.String(name),
+	}
+
+	output, err := conn.GetVocabularyWithContext(ctx, input)
+
+	if isNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMedicalVocabularyByName(ctx context.Context, conn *transcribeservice.TranscribeService, name string) (*transcribeservice.GetMedicalVocabularyOutput, error) {
+	input := &transcribeservice.GetMedicalVocabularyInput{
+		VocabularyName: aws.String(name),
+	}
+
+	output, err := conn.GetMedicalVocabularyWithContext(ctx, input)
+
+	if isNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVocabularyFilterByName(ctx context.Context, conn *transcribeservice.TranscribeService, name string) (*transcribeservice.GetVocabularyFilterOutput, error) {
+	input := &transcribeservice.GetVocabularyFilterInput{
+		VocabularyFilterName: aws.String(name),
+	}
+
+	output, err := conn.GetVocabularyFilterWithContext(ctx, input)
+
+	if isNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindLanguageModelByName(ctx context.Context, conn *transcribeservice.TranscribeService, name string) (*transcribeservice.LanguageModel, error) {
+	input := &transcribeservice.DescribeLanguageModelInput{
+		ModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeLanguageModelWithContext(ctx, input)
+
+	if isNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LanguageModel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LanguageModel, nil
+}
+
+func FindCallAnalyticsCategoryByName(ctx context.Context, conn *transcribeservice.TranscribeService, name string) (*transcribeservice.CategoryProperties, error) {
+	input := &transcribeservice.GetCallAnalyticsCategoryInput{
+		CategoryName: aws.String(name),
+	}
+
+	output, err := conn.GetCallAnalyticsCategoryWithContext(ctx, input)
+
+	if isNotFoundError(err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CategoryProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CategoryProperties, nil
+}
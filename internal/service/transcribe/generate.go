@@ -0,0 +1,5 @@
+//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package transcribe
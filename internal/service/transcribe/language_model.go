@@ -0,0 +1,254 @@
+package transcribe
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLanguageModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLanguageModelCreate,
+		ReadWithoutTimeout:   resourceLanguageModelRead,
+		UpdateWithoutTimeout: resourceLanguageModelUpdate,
+		DeleteWithoutTimeout: resourceLanguageModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(600 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.BaseModelName_Values(), false),
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+						"tuning_data_s3_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+					},
+				},
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.CLMLanguageCode_Values(), false),
+			},
+			"model_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceLanguageModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("model_name").(string)
+	input := &transcribeservice.CreateLanguageModelInput{
+		BaseModelName:   aws.String(d.Get("base_model_name").(string)),
+		InputDataConfig: expandInputDataConfig(d.Get("input_data_config").([]interface{})),
+		LanguageCode:    aws.String(d.Get("language_code").(string)),
+		ModelName:       aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Language Model: %s", input)
+	_, err := tfresource.RetryWhenContext(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateLanguageModelWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, transcribeservice.ErrCodeBadRequestException, "Make sure that you have read permission") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return diag.Errorf("error creating Transcribe Language Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitLanguageModelTrained(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Transcribe Language Model (%s) training: %s", d.Id(), err)
+	}
+
+	return resourceLanguageModelRead(ctx, d, meta)
+}
+
+func resourceLanguageModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	model, err := FindLanguageModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Language Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Transcribe Language Model (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "transcribe",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "language-model/" + d.Id(),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("base_model_name", model.BaseModelName)
+	if err := d.Set("input_data_config", flattenInputDataConfig(model.InputDataConfig)); err != nil {
+		return diag.Errorf("error setting input_data_config: %s", err)
+	}
+	d.Set("language_code", model.LanguageCode)
+	d.Set("model_name", model.ModelName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Transcribe Language Model (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLanguageModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Transcribe Language Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLanguageModelRead(ctx, d, meta)
+}
+
+func resourceLanguageModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Language Model: %s", d.Id())
+	_, err := conn.DeleteLanguageModelWithContext(ctx, &transcribeservice.DeleteLanguageModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+
+	if isNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Transcribe Language Model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandInputDataConfig(tfList []interface{}) *transcribeservice.InputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &transcribeservice.InputDataConfig{
+		DataAccessRoleArn: aws.String(tfMap["data_access_role_arn"].(string)),
+		S3Uri:             aws.String(tfMap["s3_uri"].(string)),
+	}
+
+	if v, ok := tfMap["tuning_data_s3_uri"].(string); ok && v != "" {
+		apiObject.TuningDataS3Uri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenInputDataConfig(apiObject *transcribeservice.InputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"data_access_role_arn": aws.StringValue(apiObject.DataAccessRoleArn),
+		"s3_uri":               aws.StringValue(apiObject.S3Uri),
+		"tuning_data_s3_uri":   aws.StringValue(apiObject.TuningDataS3Uri),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,276 @@
+package transcribe_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeLanguageModel_basic(t *testing.T) {
+	var v transcribeservice.LanguageModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_language_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLanguageModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLanguageModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "transcribe", fmt.Sprintf("language-model/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "base_model_name", transcribeservice.BaseModelNameNarrowBand),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_data_config.0.data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.0.s3_uri", fmt.Sprintf("s3://%s/training/", rName)),
+					resource.TestCheckResourceAttr(resourceName, "language_code", transcribeservice.CLMLanguageCodeEnUs),
+					resource.TestCheckResourceAttr(resourceName, "model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeLanguageModel_disappears(t *testing.T) {
+	var v transcribeservice.LanguageModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_language_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLanguageModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLanguageModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceLanguageModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeLanguageModel_tags(t *testing.T) {
+	var v transcribeservice.LanguageModel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_language_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLanguageModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLanguageModelTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLanguageModelTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLanguageModelTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLanguageModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLanguageModelExists(n string, v *transcribeservice.LanguageModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Language Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindLanguageModelByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLanguageModelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_language_model" {
+			continue
+		}
+
+		_, err := tftranscribe.FindLanguageModelByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Language Model %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccLanguageModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "training/language_model.txt"
+  source = "test-fixtures/language_model.txt"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "transcribe.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      }, {
+      Action   = "s3:ListBucket"
+      Effect   = "Allow"
+      Resource = aws_s3_bucket.test.arn
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccLanguageModelConfig(rName string) string {
+	return acctest.ConfigCompose(testAccLanguageModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_transcribe_language_model" "test" {
+  model_name      = %[1]q
+  base_model_name = "NarrowBand"
+  language_code   = "en-US"
+
+  input_data_config {
+    data_access_role_arn = aws_iam_role.test.arn
+    s3_uri               = "s3://${aws_s3_bucket.test.bucket}/training/"
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName))
+}
+
+func testAccLanguageModelTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccLanguageModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_transcribe_language_model" "test" {
+  model_name      = %[1]q
+  base_model_name = "NarrowBand"
+  language_code   = "en-US"
+
+  input_data_config {
+    data_access_role_arn = aws_iam_role.test.arn
+    s3_uri               = "s3://${aws_s3_bucket.test.bucket}/training/"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccLanguageModelTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccLanguageModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_transcribe_language_model" "test" {
+  model_name      = %[1]q
+  base_model_name = "NarrowBand"
+  language_code   = "en-US"
+
+  input_data_config {
+    data_access_role_arn = aws_iam_role.test.arn
+    s3_uri               = "s3://${aws_s3_bucket.test.bucket}/training/"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,205 @@
+package transcribe
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMedicalVocabulary() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMedicalVocabularyCreate,
+		ReadWithoutTimeout:   resourceMedicalVocabularyRead,
+		UpdateWithoutTimeout: resourceMedicalVocabularyUpdate,
+		DeleteWithoutTimeout: resourceMedicalVocabularyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"download_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.LanguageCode_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vocabulary_file_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+			"vocabulary_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+	}
+}
+
+func resourceMedicalVocabularyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("vocabulary_name").(string)
+	input := &transcribeservice.CreateMedicalVocabularyInput{
+		LanguageCode:      aws.String(d.Get("language_code").(string)),
+		VocabularyFileUri: aws.String(d.Get("vocabulary_file_uri").(string)),
+		VocabularyName:    aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Medical Vocabulary: %s", input)
+	_, err := conn.CreateMedicalVocabularyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Transcribe Medical Vocabulary (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitMedicalVocabularyReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Transcribe Medical Vocabulary (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceMedicalVocabularyRead(ctx, d, meta)
+}
+
+func resourceMedicalVocabularyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vocabulary, err := FindMedicalVocabularyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Medical Vocabulary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Transcribe Medical Vocabulary (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "transcribe",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "medical-vocabulary/" + d.Id(),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("download_uri", vocabulary.DownloadUri)
+	d.Set("language_code", vocabulary.LanguageCode)
+	d.Set("vocabulary_name", vocabulary.VocabularyName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Transcribe Medical Vocabulary (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMedicalVocabularyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &transcribeservice.UpdateMedicalVocabularyInput{
+			LanguageCode:      aws.String(d.Get("language_code").(string)),
+			VocabularyFileUri: aws.String(d.Get("vocabulary_file_uri").(string)),
+			VocabularyName:    aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Transcribe Medical Vocabulary: %s", input)
+		_, err := conn.UpdateMedicalVocabularyWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Transcribe Medical Vocabulary (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitMedicalVocabularyReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for Transcribe Medical Vocabulary (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Transcribe Medical Vocabulary (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMedicalVocabularyRead(ctx, d, meta)
+}
+
+func resourceMedicalVocabularyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Medical Vocabulary: %s", d.Id())
+	_, err := conn.DeleteMedicalVocabularyWithContext(ctx, &transcribeservice.DeleteMedicalVocabularyInput{
+		VocabularyName: aws.String(d.Id()),
+	})
+
+	if isNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Transcribe Medical Vocabulary (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMedicalVocabularyDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Transcribe Medical Vocabulary (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package transcribe_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeMedicalVocabulary_basic(t *testing.T) {
+	var v transcribeservice.GetMedicalVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_medical_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMedicalVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMedicalVocabularyConfig(rName, "first.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMedicalVocabularyExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "transcribe", fmt.Sprintf("medical-vocabulary/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_file_uri", fmt.Sprintf("s3://%s/first.txt", rName)),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vocabulary_file_uri"},
+			},
+			{
+				Config: testAccMedicalVocabularyConfig(rName, "second.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMedicalVocabularyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_file_uri", fmt.Sprintf("s3://%s/second.txt", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeMedicalVocabulary_disappears(t *testing.T) {
+	var v transcribeservice.GetMedicalVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_medical_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMedicalVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMedicalVocabularyConfig(rName, "first.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMedicalVocabularyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceMedicalVocabulary(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeMedicalVocabulary_tags(t *testing.T) {
+	var v transcribeservice.GetMedicalVocabularyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_medical_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMedicalVocabularyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMedicalVocabularyTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMedicalVocabularyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vocabulary_file_uri"},
+			},
+			{
+				Config: testAccMedicalVocabularyTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMedicalVocabularyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMedicalVocabularyTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMedicalVocabularyExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMedicalVocabularyExists(n string, v *transcribeservice.GetMedicalVocabularyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Medical Vocabulary ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindMedicalVocabularyByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckMedicalVocabularyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_medical_vocabulary" {
+			continue
+		}
+
+		_, err := tftranscribe.FindMedicalVocabularyByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Medical Vocabulary %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccMedicalVocabularyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "first" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "first.txt"
+  content = "Phrase\tIPA\tSoundsLike\tDisplayAs\nAcetaminophen\t\t\tacetaminophen\n"
+}
+
+resource "aws_s3_object" "second" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "second.txt"
+  content = "Phrase\tIPA\tSoundsLike\tDisplayAs\nIbuprofen\t\t\tibuprofen\n"
+}
+`, rName)
+}
+
+func testAccMedicalVocabularyConfig(rName, key string) string {
+	return acctest.ConfigCompose(testAccMedicalVocabularyConfigBase(rName), fmt.Sprintf(`
+resource "aws_transcribe_medical_vocabulary" "test" {
+  vocabulary_name     = %[1]q
+  language_code       = "en-US"
+  vocabulary_file_uri = "s3://${aws_s3_bucket.test.bucket}/%[2]s"
+
+  depends_on = [aws_s3_object.first, aws_s3_object.second]
+}
+`, rName, key))
+}
+
+func testAccMedicalVocabularyTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMedicalVocabularyConfigBase(rName), fmt.Sprintf(`
+resource "aws_transcribe_medical_vocabulary" "test" {
+  vocabulary_name     = %[1]q
+  language_code       = "en-US"
+  vocabulary_file_uri = "s3://${aws_s3_object.first.bucket}/${aws_s3_object.first.key}"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMedicalVocabularyTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMedicalVocabularyConfigBase(rName), fmt.Sprintf(`
+resource "aws_transcribe_medical_vocabulary" "test" {
+  vocabulary_name     = %[1]q
+  language_code       = "en-US"
+  vocabulary_file_uri = "s3://${aws_s3_object.first.bucket}/${aws_s3_object.first.key}"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,58 @@
+package transcribe
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusVocabulary(ctx context.Context, conn *transcribeservice.TranscribeService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVocabularyByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.VocabularyState), nil
+	}
+}
+
+func statusMedicalVocabulary(ctx context.Context, conn *transcribeservice.TranscribeService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMedicalVocabularyByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.VocabularyState), nil
+	}
+}
+
+func statusLanguageModel(ctx context.Context, conn *transcribeservice.TranscribeService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLanguageModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ModelStatus), nil
+	}
+}
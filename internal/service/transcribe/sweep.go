@@ -0,0 +1,263 @@
+//go:build sweep
+// +build sweep
+
+package transcribe
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_transcribe_call_analytics_category", &resource.Sweeper{
+		Name: "aws_transcribe_call_analytics_category",
+		F:    sweepCallAnalyticsCategories,
+	})
+
+	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+		Name: "aws_transcribe_language_model",
+		F:    sweepLanguageModels,
+	})
+
+	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+		Name: "aws_transcribe_medical_vocabulary",
+		F:    sweepMedicalVocabularies,
+	})
+
+	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+		Name: "aws_transcribe_vocabulary",
+		F:    sweepVocabularies,
+	})
+
+	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+		Name: "aws_transcribe_vocabulary_filter",
+		F:    sweepVocabularyFilters,
+	})
+}
+
+func sweepCallAnalyticsCategories(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranscribeConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListCallAnalyticsCategoriesPagesWithContext(context.Background(), &transcribeservice.ListCallAnalyticsCategoriesInput{}, func(page *transcribeservice.ListCallAnalyticsCategoriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Categories {
+			r := ResourceCallAnalyticsCategory()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CategoryName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transcribe Call Analytics Category sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transcribe Call Analytics Categories (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transcribe Call Analytics Categories (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepLanguageModels(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranscribeConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListLanguageModelsPagesWithContext(context.Background(), &transcribeservice.ListLanguageModelsInput{}, func(page *transcribeservice.ListLanguageModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Models {
+			r := ResourceLanguageModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ModelName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transcribe Language Model sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transcribe Language Models (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transcribe Language Models (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepMedicalVocabularies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranscribeConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListMedicalVocabulariesPagesWithContext(context.Background(), &transcribeservice.ListMedicalVocabulariesInput{}, func(page *transcribeservice.ListMedicalVocabulariesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Vocabularies {
+			r := ResourceMedicalVocabulary()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VocabularyName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transcribe Medical Vocabulary sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transcribe Medical Vocabularies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transcribe Medical Vocabularies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepVocabularies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranscribeConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListVocabulariesPagesWithContext(context.Background(), &transcribeservice.ListVocabulariesInput{}, func(page *transcribeservice.ListVocabulariesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Vocabularies {
+			r := ResourceVocabulary()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VocabularyName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transcribe Vocabulary sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transcribe Vocabularies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transcribe Vocabularies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepVocabularyFilters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).TranscribeConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListVocabularyFiltersPagesWithContext(context.Background(), &transcribeservice.ListVocabularyFiltersInput{}, func(page *transcribeservice.ListVocabularyFiltersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VocabularyFilters {
+			r := ResourceVocabularyFilter()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VocabularyFilterName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transcribe Vocabulary Filters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transcribe Vocabulary Filters (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package transcribe
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 200),
+	validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z._-]+$`), "must contain only alphanumeric characters, periods, underscores and hyphens"),
+)
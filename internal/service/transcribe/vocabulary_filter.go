@@ -0,0 +1,209 @@
+package transcribe
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVocabularyFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVocabularyFilterCreate,
+		ReadWithoutTimeout:   resourceVocabularyFilterRead,
+		UpdateWithoutTimeout: resourceVocabularyFilterUpdate,
+		DeleteWithoutTimeout: resourceVocabularyFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"download_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"language_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transcribeservice.LanguageCode_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vocabulary_filter_file_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+				ExactlyOneOf: []string{"vocabulary_filter_file_uri", "words"},
+			},
+			"vocabulary_filter_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"words": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"vocabulary_filter_file_uri", "words"},
+			},
+		},
+	}
+}
+
+func resourceVocabularyFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("vocabulary_filter_name").(string)
+	input := &transcribeservice.CreateVocabularyFilterInput{
+		LanguageCode:         aws.String(d.Get("language_code").(string)),
+		VocabularyFilterName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("vocabulary_filter_file_uri"); ok {
+		input.VocabularyFilterFileUri = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("words"); ok && len(v.([]interface{})) > 0 {
+		input.Words = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transcribe Vocabulary Filter: %s", input)
+	_, err := conn.CreateVocabularyFilterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Transcribe Vocabulary Filter (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceVocabularyFilterRead(ctx, d, meta)
+}
+
+func resourceVocabularyFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	filter, err := FindVocabularyFilterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transcribe Vocabulary Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Transcribe Vocabulary Filter (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "transcribe",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "vocabulary-filter/" + d.Id(),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("download_uri", filter.DownloadUri)
+	d.Set("language_code", filter.LanguageCode)
+	d.Set("vocabulary_filter_name", filter.VocabularyFilterName)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Transcribe Vocabulary Filter (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVocabularyFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &transcribeservice.UpdateVocabularyFilterInput{
+			VocabularyFilterName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("vocabulary_filter_file_uri"); ok {
+			input.VocabularyFilterFileUri = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("words"); ok && len(v.([]interface{})) > 0 {
+			input.Words = flex.ExpandStringList(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Transcribe Vocabulary Filter: %s", input)
+		_, err := conn.UpdateVocabularyFilterWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Transcribe Vocabulary Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Transcribe Vocabulary Filter (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVocabularyFilterRead(ctx, d, meta)
+}
+
+func resourceVocabularyFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TranscribeConn
+
+	log.Printf("[DEBUG] Deleting Transcribe Vocabulary Filter: %s", d.Id())
+	_, err := conn.DeleteVocabularyFilterWithContext(ctx, &transcribeservice.DeleteVocabularyFilterInput{
+		VocabularyFilterName: aws.String(d.Id()),
+	})
+
+	if isNotFoundError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Transcribe Vocabulary Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package transcribe_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranscribe "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranscribeVocabularyFilter_basic(t *testing.T) {
+	var v transcribeservice.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVocabularyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterConfig(rName, "Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "transcribe", fmt.Sprintf("vocabulary-filter/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "download_uri"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "words.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "words.0", "Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "vocabulary_filter_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"words"},
+			},
+			{
+				Config: testAccVocabularyFilterConfig(rName, "HashiCorp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "words.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "words.0", "HashiCorp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabularyFilter_disappears(t *testing.T) {
+	var v transcribeservice.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVocabularyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterConfig(rName, "Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tftranscribe.ResourceVocabularyFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranscribeVocabularyFilter_tags(t *testing.T) {
+	var v transcribeservice.GetVocabularyFilterOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_transcribe_vocabulary_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, transcribeservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVocabularyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVocabularyFilterTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"words"},
+			},
+			{
+				Config: testAccVocabularyFilterTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVocabularyFilterTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVocabularyFilterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVocabularyFilterExists(n string, v *transcribeservice.GetVocabularyFilterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transcribe Vocabulary Filter ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+		output, err := tftranscribe.FindVocabularyFilterByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVocabularyFilterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranscribeConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transcribe_vocabulary_filter" {
+			continue
+		}
+
+		_, err := tftranscribe.FindVocabularyFilterByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transcribe Vocabulary Filter %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccVocabularyFilterConfig(rName, phrase string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name = %[1]q
+  language_code          = "en-US"
+  words                  = [%[2]q, "Transcribe"]
+}
+`, rName, phrase)
+}
+
+func testAccVocabularyFilterTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name = %[1]q
+  language_code          = "en-US"
+  words                  = ["Terraform"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccVocabularyFilterTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transcribe_vocabulary_filter" "test" {
+  vocabulary_filter_name = %[1]q
+  language_code          = "en-US"
+  words                  = ["Terraform"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
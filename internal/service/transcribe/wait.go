@@ -0,0 +1,119 @@
+package transcribe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transcribeservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for IAM role changes to propagate to Transcribe
+	propagationTimeout = 2 * time.Minute
+
+	// Training polls are spaced out as language models take hours to train
+	trainingPollInterval = 1 * time.Minute
+)
+
+func waitVocabularyReady(ctx context.Context, conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transcribeservice.VocabularyStatePending},
+		Target:  []string{transcribeservice.VocabularyStateReady},
+		Refresh: statusVocabulary(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*transcribeservice.GetVocabularyOutput); ok {
+		if state := aws.StringValue(output.VocabularyState); state == transcribeservice.VocabularyStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVocabularyDeleted(ctx context.Context, conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: transcribeservice.VocabularyState_Values(),
+		Target:  []string{},
+		Refresh: statusVocabulary(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*transcribeservice.GetVocabularyOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMedicalVocabularyReady(ctx context.Context, conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetMedicalVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{transcribeservice.VocabularyStatePending},
+		Target:  []string{transcribeservice.VocabularyStateReady},
+		Refresh: statusMedicalVocabulary(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*transcribeservice.GetMedicalVocabularyOutput); ok {
+		if state := aws.StringValue(output.VocabularyState); state == transcribeservice.VocabularyStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMedicalVocabularyDeleted(ctx context.Context, conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.GetMedicalVocabularyOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: transcribeservice.VocabularyState_Values(),
+		Target:  []string{},
+		Refresh: statusMedicalVocabulary(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*transcribeservice.GetMedicalVocabularyOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLanguageModelTrained(ctx context.Context, conn *transcribeservice.TranscribeService, name string, timeout time.Duration) (*transcribeservice.LanguageModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{transcribeservice.ModelStatusInProgress},
+		Target:       []string{transcribeservice.ModelStatusCompleted},
+		Refresh:      statusLanguageModel(ctx, conn, name),
+		Timeout:      timeout,
+		Delay:        trainingPollInterval,
+		PollInterval: trainingPollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*transcribeservice.LanguageModel); ok {
+		if status := aws.StringValue(output.ModelStatus); status == transcribeservice.ModelStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,229 @@
+package workmail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dkim_verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 209),
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"is_test_domain": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ownership_verification_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID := d.Get("organization_id").(string)
+	domainName := d.Get("domain_name").(string)
+	id := DomainCreateResourceID(organizationID, domainName)
+	input := &workmail.RegisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	log.Printf("[DEBUG] Creating WorkMail Domain: %s", input)
+	_, err := conn.RegisterMailDomainWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating WorkMail Domain (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("is_default").(bool) {
+		if err := updateDefaultMailDomain(ctx, conn, organizationID, domainName); err != nil {
+			return diag.Errorf("error setting WorkMail Domain (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, domainName, err := DomainParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	domain, err := FindMailDomainByTwoPartKey(ctx, conn, organizationID, domainName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading WorkMail Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set("dkim_verification_status", domain.DkimVerificationStatus)
+	d.Set("domain_name", domainName)
+	d.Set("is_default", domain.IsDefault)
+	d.Set("is_test_domain", domain.IsTestDomain)
+	d.Set("organization_id", organizationID)
+	d.Set("ownership_verification_status", domain.OwnershipVerificationStatus)
+
+	if err := d.Set("records", flattenDNSRecords(domain.Records)); err != nil {
+		return diag.Errorf("error setting records: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, domainName, err := DomainParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("is_default") {
+		// An organization always has exactly one default domain, so the default
+		// can only be moved by setting it on another domain.
+		if !d.Get("is_default").(bool) {
+			return diag.Errorf("error updating WorkMail Domain (%s): the default mail domain can only be changed by setting is_default on another domain", d.Id())
+		}
+
+		if err := updateDefaultMailDomain(ctx, conn, organizationID, domainName); err != nil {
+			return diag.Errorf("error setting WorkMail Domain (%s) as default: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, domainName, err := DomainParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Domain: %s", d.Id())
+	_, err = conn.DeregisterMailDomainWithContext(ctx, &workmail.DeregisterMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeMailDomainNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting WorkMail Domain (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateDefaultMailDomain(ctx context.Context, conn *workmail.WorkMail, organizationID, domainName string) error {
+	input := &workmail.UpdateDefaultMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	log.Printf("[DEBUG] Updating WorkMail default mail domain: %s", input)
+	_, err := conn.UpdateDefaultMailDomainWithContext(ctx, input)
+
+	return err
+}
+
+func flattenDNSRecords(apiObjects []*workmail.DnsRecord) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"hostname": aws.StringValue(apiObject.Hostname),
+			"type":     aws.StringValue(apiObject.Type),
+			"value":    aws.StringValue(apiObject.Value),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,140 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailDomain_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_workmail_domain.test"
+	organizationResourceName := "aws_workmail_organization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "dkim_verification_status"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_test_domain", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", organizationResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "ownership_verification_status", "PENDING"),
+					resource.TestCheckResourceAttrSet(resourceName, "records.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "records.0.hostname"),
+					resource.TestCheckResourceAttrSet(resourceName, "records.0.type"),
+					resource.TestCheckResourceAttrSet(resourceName, "records.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailDomain_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+	resourceName := "aws_workmail_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_basic(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkmail.ResourceDomain(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDomainDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workmail_domain" {
+			continue
+		}
+
+		organizationID, domainName, err := tfworkmail.DomainParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfworkmail.FindMailDomainByTwoPartKey(context.Background(), conn, organizationID, domainName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkMail Domain %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Domain ID is set")
+		}
+
+		organizationID, domainName, err := tfworkmail.DomainParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+		_, err = tfworkmail.FindMailDomainByTwoPartKey(context.Background(), conn, organizationID, domainName)
+
+		return err
+	}
+}
+
+func testAccDomainConfig_basic(rName, domainName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_domain" "test" {
+  organization_id = aws_workmail_organization.test.id
+  domain_name     = %[1]q
+}
+`, domainName))
+}
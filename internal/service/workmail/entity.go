@@ -0,0 +1,47 @@
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+)
+
+// updateEntityEmail registers, re-addresses or deregisters a WorkMail user or group
+// so that its primary email address matches the configured value.
+// deregister is deregisterUser or deregisterGroup, which wait for the entity to be
+// disabled so that it can be registered again.
+func updateEntityEmail(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID, oldEmail, newEmail string, deregister func(context.Context, *workmail.WorkMail, string, string) error) error {
+	switch {
+	case oldEmail == "" && newEmail != "":
+		input := &workmail.RegisterToWorkMailInput{
+			Email:          aws.String(newEmail),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		}
+
+		log.Printf("[DEBUG] Registering WorkMail entity: %s", input)
+		if _, err := conn.RegisterToWorkMailWithContext(ctx, input); err != nil {
+			return fmt.Errorf("registering: %w", err)
+		}
+	case oldEmail != "" && newEmail == "":
+		if err := deregister(ctx, conn, organizationID, entityID); err != nil {
+			return err
+		}
+	case oldEmail != newEmail:
+		input := &workmail.UpdatePrimaryEmailAddressInput{
+			Email:          aws.String(newEmail),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		}
+
+		log.Printf("[DEBUG] Updating WorkMail entity primary email address: %s", input)
+		if _, err := conn.UpdatePrimaryEmailAddressWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating primary email address: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package workmail
+
+const (
+	organizationStateActive    = "Active"
+	organizationStateCreating  = "Creating"
+	organizationStateDeleted   = "Deleted"
+	organizationStateDeleting  = "Deleting"
+	organizationStateFailed    = "Failed"
+	organizationStateRequested = "Requested"
+)
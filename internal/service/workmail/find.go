@@ -0,0 +1,281 @@
+package workmail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindOrganizationByID(ctx context.Context, conn *workmail.WorkMail, id string) (*workmail.DescribeOrganizationOutput, error) {
+	input := &workmail.DescribeOrganizationInput{
+		OrganizationId: aws.String(id),
+	}
+
+	output, err := conn.DescribeOrganizationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeOrganizationNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == organizationStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindUserByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, userID string) (*workmail.DescribeUserOutput, error) {
+	input := &workmail.DescribeUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	}
+
+	output, err := conn.DescribeUserWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == workmail.EntityStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindGroupByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID string) (*workmail.DescribeGroupOutput, error) {
+	input := &workmail.DescribeGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.DescribeGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.StringValue(output.State); state == workmail.EntityStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindGroupMemberByThreePartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID, memberID string) (*workmail.Member, error) {
+	input := &workmail.ListGroupMembersInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+	var output *workmail.Member
+
+	err := conn.ListGroupMembersPagesWithContext(ctx, input, func(page *workmail.ListGroupMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Members {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Id) == memberID && aws.StringValue(v.State) != workmail.EntityStateDeleted {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMailboxPermissionByThreePartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, entityID, granteeID string) (*workmail.Permission, error) {
+	input := &workmail.ListMailboxPermissionsInput{
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+	var output *workmail.Permission
+
+	err := conn.ListMailboxPermissionsPagesWithContext(ctx, input, func(page *workmail.ListMailboxPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.GranteeId) == granteeID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.PermissionValues) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMailDomainByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, domainName string) (*workmail.GetMailDomainOutput, error) {
+	input := &workmail.GetMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.GetMailDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeMailDomainNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDefaultRetentionPolicyByOrganizationID(ctx context.Context, conn *workmail.WorkMail, organizationID string) (*workmail.GetDefaultRetentionPolicyOutput, error) {
+	input := &workmail.GetDefaultRetentionPolicyInput{
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.GetDefaultRetentionPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Id == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMobileDeviceAccessRuleByTwoPartKey(ctx context.Context, conn *workmail.WorkMail, organizationID, ruleID string) (*workmail.MobileDeviceAccessRule, error) {
+	input := &workmail.ListMobileDeviceAccessRulesInput{
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.ListMobileDeviceAccessRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Rules {
+		if v != nil && aws.StringValue(v.MobileDeviceAccessRuleId) == ruleID {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
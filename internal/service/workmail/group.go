@@ -0,0 +1,202 @@
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupCreate,
+		ReadWithoutTimeout:   resourceGroupRead,
+		UpdateWithoutTimeout: resourceGroupUpdate,
+		DeleteWithoutTimeout: resourceGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateGroupInput{
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	log.Printf("[DEBUG] Creating WorkMail Group: %s", name)
+	output, err := conn.CreateGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating WorkMail Group (%s): %s", name, err)
+	}
+
+	groupID := aws.StringValue(output.GroupId)
+	d.SetId(EntityCreateResourceID(organizationID, groupID))
+
+	if v, ok := d.GetOk("email"); ok {
+		if err := updateEntityEmail(ctx, conn, organizationID, groupID, "", v.(string), deregisterGroup); err != nil {
+			return diag.Errorf("error registering WorkMail Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, groupID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := FindGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("email", group.Email)
+	d.Set("group_id", group.GroupId)
+	d.Set("name", group.Name)
+	d.Set("organization_id", organizationID)
+	d.Set("state", group.State)
+
+	return nil
+}
+
+func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, groupID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("email") {
+		o, n := d.GetChange("email")
+
+		if err := updateEntityEmail(ctx, conn, organizationID, groupID, o.(string), n.(string), deregisterGroup); err != nil {
+			return diag.Errorf("error updating WorkMail Group (%s) email: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGroupRead(ctx, d, meta)
+}
+
+func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, groupID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := deregisterGroup(ctx, conn, organizationID, groupID); err != nil {
+		return diag.Errorf("error deleting WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Group: %s", d.Id())
+	_, err = conn.DeleteGroupWithContext(ctx, &workmail.DeleteGroupInput{
+		OrganizationId: aws.String(organizationID),
+		GroupId:        aws.String(groupID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting WorkMail Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deregisterGroup disables a group's email address, which WorkMail requires before the group can be deleted
+// or registered again.
+func deregisterGroup(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID string) error {
+	group, err := FindGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(group.State) != workmail.EntityStateEnabled {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deregistering WorkMail Group: %s", groupID)
+	_, err = conn.DeregisterFromWorkMailWithContext(ctx, &workmail.DeregisterFromWorkMailInput{
+		EntityId:       aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deregistering: %w", err)
+	}
+
+	if _, err := waitGroupDisabled(ctx, conn, organizationID, groupID); err != nil {
+		return fmt.Errorf("waiting for deregistration: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package workmail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMemberCreate,
+		ReadWithoutTimeout:   resourceGroupMemberRead,
+		DeleteWithoutTimeout: resourceGroupMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID := d.Get("organization_id").(string)
+	groupID := d.Get("group_id").(string)
+	memberID := d.Get("member_id").(string)
+	id := GroupMemberCreateResourceID(organizationID, groupID, memberID)
+	input := &workmail.AssociateMemberToGroupInput{
+		GroupId:        aws.String(groupID),
+		MemberId:       aws.String(memberID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	log.Printf("[DEBUG] Creating WorkMail Group Member: %s", input)
+	_, err := conn.AssociateMemberToGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating WorkMail Group Member (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceGroupMemberRead(ctx, d, meta)
+}
+
+func resourceGroupMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, groupID, memberID, err := GroupMemberParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := FindGroupMemberByThreePartKey(ctx, conn, organizationID, groupID, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Group Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading WorkMail Group Member (%s): %s", d.Id(), err)
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("member_id", member.Id)
+	d.Set("member_type", member.Type)
+	d.Set("organization_id", organizationID)
+
+	return nil
+}
+
+func resourceGroupMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, groupID, memberID, err := GroupMemberParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Group Member: %s", d.Id())
+	_, err = conn.DisassociateMemberFromGroupWithContext(ctx, &workmail.DisassociateMemberFromGroupInput{
+		GroupId:        aws.String(groupID),
+		MemberId:       aws.String(memberID),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting WorkMail Group Member (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
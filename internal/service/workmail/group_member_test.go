@@ -0,0 +1,147 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailGroupMember_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group_member.test"
+	groupResourceName := "aws_workmail_group.test"
+	userResourceName := "aws_workmail_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMemberConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupMemberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_id", userResourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_type", "USER"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkMailGroupMember_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_group_member.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMemberConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMemberExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkmail.ResourceGroupMember(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMemberDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workmail_group_member" {
+			continue
+		}
+
+		organizationID, groupID, memberID, err := tfworkmail.GroupMemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfworkmail.FindGroupMemberByThreePartKey(context.Background(), conn, organizationID, groupID, memberID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkMail Group Member %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGroupMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Group Member ID is set")
+		}
+
+		organizationID, groupID, memberID, err := tfworkmail.GroupMemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+		_, err = tfworkmail.FindGroupMemberByThreePartKey(context.Background(), conn, organizationID, groupID, memberID)
+
+		return err
+	}
+}
+
+func testAccGroupMemberConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_group" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "%[1]s-group"
+  email           = "%[1]s-group@${aws_workmail_organization.test.default_mail_domain}"
+}
+
+resource "aws_workmail_user" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "%[1]s-user"
+  display_name    = "%[1]s-user"
+  password        = "Terraform-Test-Passw0rd-1"
+  email           = "%[1]s-user@${aws_workmail_organization.test.default_mail_domain}"
+}
+
+resource "aws_workmail_group_member" "test" {
+  organization_id = aws_workmail_organization.test.id
+  group_id        = aws_workmail_group.test.group_id
+  member_id       = aws_workmail_user.test.user_id
+}
+`, rName))
+}
@@ -0,0 +1,101 @@
+package workmail
+
+import (
+	"fmt"
+	"strings"
+)
+
+const entityResourceIDSeparator = "/"
+
+func EntityCreateResourceID(organizationID, entityID string) string {
+	parts := []string{organizationID, entityID}
+	id := strings.Join(parts, entityResourceIDSeparator)
+
+	return id
+}
+
+func EntityParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, entityResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATIONID%[2]sENTITYID", id, entityResourceIDSeparator)
+}
+
+const domainResourceIDSeparator = "/"
+
+func DomainCreateResourceID(organizationID, domainName string) string {
+	parts := []string{organizationID, domainName}
+	id := strings.Join(parts, domainResourceIDSeparator)
+
+	return id
+}
+
+func DomainParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, domainResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATIONID%[2]sDOMAINNAME", id, domainResourceIDSeparator)
+}
+
+const groupMemberResourceIDSeparator = "/"
+
+func GroupMemberCreateResourceID(organizationID, groupID, memberID string) string {
+	parts := []string{organizationID, groupID, memberID}
+	id := strings.Join(parts, groupMemberResourceIDSeparator)
+
+	return id
+}
+
+func GroupMemberParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, groupMemberResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATIONID%[2]sGROUPID%[2]sMEMBERID", id, groupMemberResourceIDSeparator)
+}
+
+const mailboxPermissionResourceIDSeparator = "/"
+
+func MailboxPermissionCreateResourceID(organizationID, entityID, granteeID string) string {
+	parts := []string{organizationID, entityID, granteeID}
+	id := strings.Join(parts, mailboxPermissionResourceIDSeparator)
+
+	return id
+}
+
+func MailboxPermissionParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, mailboxPermissionResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATIONID%[2]sENTITYID%[2]sGRANTEEID", id, mailboxPermissionResourceIDSeparator)
+}
+
+const mobileDeviceAccessRuleResourceIDSeparator = "/"
+
+func MobileDeviceAccessRuleCreateResourceID(organizationID, ruleID string) string {
+	parts := []string{organizationID, ruleID}
+	id := strings.Join(parts, mobileDeviceAccessRuleResourceIDSeparator)
+
+	return id
+}
+
+func MobileDeviceAccessRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, mobileDeviceAccessRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ORGANIZATIONID%[2]sRULEID", id, mobileDeviceAccessRuleResourceIDSeparator)
+}
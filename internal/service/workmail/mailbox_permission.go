@@ -0,0 +1,145 @@
+package workmail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceMailboxPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMailboxPermissionPut,
+		ReadWithoutTimeout:   resourceMailboxPermissionRead,
+		UpdateWithoutTimeout: resourceMailboxPermissionPut,
+		DeleteWithoutTimeout: resourceMailboxPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission_values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(workmail.PermissionType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceMailboxPermissionPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID := d.Get("organization_id").(string)
+	entityID := d.Get("entity_id").(string)
+	granteeID := d.Get("grantee_id").(string)
+	id := MailboxPermissionCreateResourceID(organizationID, entityID, granteeID)
+	input := &workmail.PutMailboxPermissionsInput{
+		EntityId:         aws.String(entityID),
+		GranteeId:        aws.String(granteeID),
+		OrganizationId:   aws.String(organizationID),
+		PermissionValues: flex.ExpandStringSet(d.Get("permission_values").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Putting WorkMail Mailbox Permission: %s", input)
+	_, err := conn.PutMailboxPermissionsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting WorkMail Mailbox Permission (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceMailboxPermissionRead(ctx, d, meta)
+}
+
+func resourceMailboxPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, entityID, granteeID, err := MailboxPermissionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permission, err := FindMailboxPermissionByThreePartKey(ctx, conn, organizationID, entityID, granteeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Mailbox Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading WorkMail Mailbox Permission (%s): %s", d.Id(), err)
+	}
+
+	d.Set("entity_id", entityID)
+	d.Set("grantee_id", permission.GranteeId)
+	d.Set("grantee_type", permission.GranteeType)
+	d.Set("organization_id", organizationID)
+	d.Set("permission_values", aws.StringValueSlice(permission.PermissionValues))
+
+	return nil
+}
+
+func resourceMailboxPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, entityID, granteeID, err := MailboxPermissionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Mailbox Permission: %s", d.Id())
+	_, err = conn.DeleteMailboxPermissionsWithContext(ctx, &workmail.DeleteMailboxPermissionsInput{
+		EntityId:       aws.String(entityID),
+		GranteeId:      aws.String(granteeID),
+		OrganizationId: aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting WorkMail Mailbox Permission (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailMailboxPermission_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mailbox_permission.test"
+	ownerResourceName := "aws_workmail_user.owner"
+	granteeResourceName := "aws_workmail_user.grantee"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMailboxPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailboxPermissionConfig_basic(rName, `"FULL_ACCESS"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMailboxPermissionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_id", ownerResourceName, "user_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "grantee_id", granteeResourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "grantee_type", "USER"),
+					resource.TestCheckResourceAttr(resourceName, "permission_values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_values.*", "FULL_ACCESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMailboxPermissionConfig_basic(rName, `"SEND_AS", "SEND_ON_BEHALF"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMailboxPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permission_values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_values.*", "SEND_AS"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission_values.*", "SEND_ON_BEHALF"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailMailboxPermission_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mailbox_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMailboxPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMailboxPermissionConfig_basic(rName, `"FULL_ACCESS"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMailboxPermissionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkmail.ResourceMailboxPermission(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMailboxPermissionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workmail_mailbox_permission" {
+			continue
+		}
+
+		organizationID, entityID, granteeID, err := tfworkmail.MailboxPermissionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfworkmail.FindMailboxPermissionByThreePartKey(context.Background(), conn, organizationID, entityID, granteeID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkMail Mailbox Permission %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMailboxPermissionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Mailbox Permission ID is set")
+		}
+
+		organizationID, entityID, granteeID, err := tfworkmail.MailboxPermissionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+		_, err = tfworkmail.FindMailboxPermissionByThreePartKey(context.Background(), conn, organizationID, entityID, granteeID)
+
+		return err
+	}
+}
+
+func testAccMailboxPermissionConfig_basic(rName, permissionValues string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_user" "owner" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "%[1]s-owner"
+  display_name    = "%[1]s-owner"
+  password        = "Terraform-Test-Passw0rd-1"
+  email           = "%[1]s-owner@${aws_workmail_organization.test.default_mail_domain}"
+}
+
+resource "aws_workmail_user" "grantee" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "%[1]s-grantee"
+  display_name    = "%[1]s-grantee"
+  password        = "Terraform-Test-Passw0rd-1"
+  email           = "%[1]s-grantee@${aws_workmail_organization.test.default_mail_domain}"
+}
+
+resource "aws_workmail_mailbox_permission" "test" {
+  organization_id   = aws_workmail_organization.test.id
+  entity_id         = aws_workmail_user.owner.user_id
+  grantee_id        = aws_workmail_user.grantee.user_id
+  permission_values = [%[2]s]
+}
+`, rName, permissionValues))
+}
@@ -0,0 +1,263 @@
+package workmail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceMobileDeviceAccessRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMobileDeviceAccessRuleCreate,
+		ReadWithoutTimeout:   resourceMobileDeviceAccessRuleRead,
+		UpdateWithoutTimeout: resourceMobileDeviceAccessRuleUpdate,
+		DeleteWithoutTimeout: resourceMobileDeviceAccessRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"device_models":            mobileDeviceAccessRuleFilterSchema(),
+			"device_operating_systems": mobileDeviceAccessRuleFilterSchema(),
+			"device_types":             mobileDeviceAccessRuleFilterSchema(),
+			"device_user_agents":       mobileDeviceAccessRuleFilterSchema(),
+			"effect": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workmail.MobileDeviceAccessRuleEffect_Values(), false),
+			},
+			"mobile_device_access_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"not_device_models":            mobileDeviceAccessRuleFilterSchema(),
+			"not_device_operating_systems": mobileDeviceAccessRuleFilterSchema(),
+			"not_device_types":             mobileDeviceAccessRuleFilterSchema(),
+			"not_device_user_agents":       mobileDeviceAccessRuleFilterSchema(),
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func mobileDeviceAccessRuleFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 10,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	}
+}
+
+func resourceMobileDeviceAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID := d.Get("organization_id").(string)
+	name := d.Get("name").(string)
+	input := &workmail.CreateMobileDeviceAccessRuleInput{
+		Effect:         aws.String(d.Get("effect").(string)),
+		Name:           aws.String(name),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("device_models"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceModels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("device_operating_systems"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceOperatingSystems = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("device_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("device_user_agents"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceUserAgents = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_models"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceModels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_operating_systems"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceOperatingSystems = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_user_agents"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceUserAgents = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating WorkMail Mobile Device Access Rule: %s", input)
+	output, err := conn.CreateMobileDeviceAccessRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating WorkMail Mobile Device Access Rule (%s): %s", name, err)
+	}
+
+	d.SetId(MobileDeviceAccessRuleCreateResourceID(organizationID, aws.StringValue(output.MobileDeviceAccessRuleId)))
+
+	return resourceMobileDeviceAccessRuleRead(ctx, d, meta)
+}
+
+func resourceMobileDeviceAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, ruleID, err := MobileDeviceAccessRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := FindMobileDeviceAccessRuleByTwoPartKey(ctx, conn, organizationID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Mobile Device Access Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading WorkMail Mobile Device Access Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", rule.Description)
+	d.Set("device_models", aws.StringValueSlice(rule.DeviceModels))
+	d.Set("device_operating_systems", aws.StringValueSlice(rule.DeviceOperatingSystems))
+	d.Set("device_types", aws.StringValueSlice(rule.DeviceTypes))
+	d.Set("device_user_agents", aws.StringValueSlice(rule.DeviceUserAgents))
+	d.Set("effect", rule.Effect)
+	d.Set("mobile_device_access_rule_id", rule.MobileDeviceAccessRuleId)
+	d.Set("name", rule.Name)
+	d.Set("not_device_models", aws.StringValueSlice(rule.NotDeviceModels))
+	d.Set("not_device_operating_systems", aws.StringValueSlice(rule.NotDeviceOperatingSystems))
+	d.Set("not_device_types", aws.StringValueSlice(rule.NotDeviceTypes))
+	d.Set("not_device_user_agents", aws.StringValueSlice(rule.NotDeviceUserAgents))
+	d.Set("organization_id", organizationID)
+
+	return nil
+}
+
+func resourceMobileDeviceAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, ruleID, err := MobileDeviceAccessRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The update replaces the whole rule, so every argument is sent.
+	input := &workmail.UpdateMobileDeviceAccessRuleInput{
+		Effect:                   aws.String(d.Get("effect").(string)),
+		MobileDeviceAccessRuleId: aws.String(ruleID),
+		Name:                     aws.String(d.Get("name").(string)),
+		OrganizationId:           aws.String(organizationID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("device_models"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceModels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("device_operating_systems"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceOperatingSystems = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("device_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("device_user_agents"); ok && v.(*schema.Set).Len() > 0 {
+		input.DeviceUserAgents = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_models"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceModels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_operating_systems"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceOperatingSystems = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("not_device_user_agents"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotDeviceUserAgents = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Updating WorkMail Mobile Device Access Rule: %s", input)
+	_, err = conn.UpdateMobileDeviceAccessRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating WorkMail Mobile Device Access Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceMobileDeviceAccessRuleRead(ctx, d, meta)
+}
+
+func resourceMobileDeviceAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID, ruleID, err := MobileDeviceAccessRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting WorkMail Mobile Device Access Rule: %s", d.Id())
+	_, err = conn.DeleteMobileDeviceAccessRuleWithContext(ctx, &workmail.DeleteMobileDeviceAccessRuleInput{
+		MobileDeviceAccessRuleId: aws.String(ruleID),
+		OrganizationId:           aws.String(organizationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting WorkMail Mobile Device Access Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailMobileDeviceAccessRule_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMobileDeviceAccessRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "device_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "device_types.*", "iPhone"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "DENY"),
+					resource.TestCheckResourceAttrSet(resourceName, "mobile_device_access_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "not_device_types.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "device_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "effect", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "not_device_operating_systems.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "not_device_operating_systems.*", "iOS 9.0"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "not_device_operating_systems.*", "Android 5.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailMobileDeviceAccessRule_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_mobile_device_access_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMobileDeviceAccessRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMobileDeviceAccessRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMobileDeviceAccessRuleExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkmail.ResourceMobileDeviceAccessRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMobileDeviceAccessRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workmail_mobile_device_access_rule" {
+			continue
+		}
+
+		organizationID, ruleID, err := tfworkmail.MobileDeviceAccessRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfworkmail.FindMobileDeviceAccessRuleByTwoPartKey(context.Background(), conn, organizationID, ruleID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkMail Mobile Device Access Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckMobileDeviceAccessRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Mobile Device Access Rule ID is set")
+		}
+
+		organizationID, ruleID, err := tfworkmail.MobileDeviceAccessRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+		_, err = tfworkmail.FindMobileDeviceAccessRuleByTwoPartKey(context.Background(), conn, organizationID, ruleID)
+
+		return err
+	}
+}
+
+func testAccMobileDeviceAccessRuleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_mobile_device_access_rule" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = %[1]q
+  effect          = "DENY"
+  device_types    = ["iPhone"]
+}
+`, rName))
+}
+
+func testAccMobileDeviceAccessRuleConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_mobile_device_access_rule" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = %[1]q
+  description     = "updated"
+  effect          = "ALLOW"
+
+  not_device_operating_systems = ["iOS 9.0", "Android 5.0"]
+}
+`, rName))
+}
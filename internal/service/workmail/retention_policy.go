@@ -0,0 +1,208 @@
+package workmail
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRetentionPolicyPut,
+		ReadWithoutTimeout:   resourceRetentionPolicyRead,
+		UpdateWithoutTimeout: resourceRetentionPolicyPut,
+		DeleteWithoutTimeout: resourceRetentionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"folder_configuration": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(workmail.RetentionAction_Values(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(workmail.FolderName_Values(), false),
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 730),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"retention_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRetentionPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	organizationID := d.Get("organization_id").(string)
+	input := &workmail.PutRetentionPolicyInput{
+		FolderConfigurations: expandFolderConfigurations(d.Get("folder_configuration").(*schema.Set).List()),
+		Name:                 aws.String(d.Get("name").(string)),
+		OrganizationId:       aws.String(organizationID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retention_policy_id"); ok {
+		input.Id = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Putting WorkMail Retention Policy: %s", organizationID)
+	_, err := conn.PutRetentionPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting WorkMail Retention Policy (%s): %s", organizationID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(organizationID)
+	}
+
+	return resourceRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceRetentionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	policy, err := FindDefaultRetentionPolicyByOrganizationID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkMail Retention Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading WorkMail Retention Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", policy.Description)
+
+	if err := d.Set("folder_configuration", flattenFolderConfigurations(policy.FolderConfigurations)); err != nil {
+		return diag.Errorf("error setting folder_configuration: %s", err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("organization_id", d.Id())
+	d.Set("retention_policy_id", policy.Id)
+
+	return nil
+}
+
+func resourceRetentionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkMailConn
+
+	log.Printf("[DEBUG] Deleting WorkMail Retention Policy: %s", d.Id())
+	_, err := conn.DeleteRetentionPolicyWithContext(ctx, &workmail.DeleteRetentionPolicyInput{
+		Id:             aws.String(d.Get("retention_policy_id").(string)),
+		OrganizationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workmail.ErrCodeEntityNotFoundException, workmail.ErrCodeOrganizationNotFoundException, workmail.ErrCodeOrganizationStateException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting WorkMail Retention Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFolderConfigurations(tfList []interface{}) []*workmail.FolderConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*workmail.FolderConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &workmail.FolderConfiguration{
+			Action: aws.String(tfMap["action"].(string)),
+			Name:   aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["period"].(int); ok && v != 0 {
+			apiObject.Period = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFolderConfigurations(apiObjects []*workmail.FolderConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action": aws.StringValue(apiObject.Action),
+			"name":   aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Period; v != nil {
+			tfMap["period"] = aws.Int64Value(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
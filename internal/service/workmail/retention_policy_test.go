@@ -0,0 +1,171 @@
+package workmail_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workmail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkmail "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkMailRetentionPolicy_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_retention_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetentionPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRetentionPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "folder_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "folder_configuration.*", map[string]string{
+						"action": "DELETE",
+						"name":   "DELETED_ITEMS",
+						"period": "30",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "retention_policy_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRetentionPolicyConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRetentionPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "folder_configuration.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "folder_configuration.*", map[string]string{
+						"action": "PERMANENTLY_DELETE",
+						"name":   "DELETED_ITEMS",
+						"period": "60",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "folder_configuration.*", map[string]string{
+						"action": "DELETE",
+						"name":   "JUNK_EMAIL",
+						"period": "14",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-updated", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkMailRetentionPolicy_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workmail_retention_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workmail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRetentionPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetentionPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRetentionPolicyExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkmail.ResourceRetentionPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRetentionPolicyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workmail_retention_policy" {
+			continue
+		}
+
+		_, err := tfworkmail.FindDefaultRetentionPolicyByOrganizationID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkMail Retention Policy %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRetentionPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkMail Retention Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkMailConn
+
+		_, err := tfworkmail.FindDefaultRetentionPolicyByOrganizationID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRetentionPolicyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_retention_policy" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = %[1]q
+
+  folder_configuration {
+    name   = "DELETED_ITEMS"
+    action = "DELETE"
+    period = 30
+  }
+}
+`, rName))
+}
+
+func testAccRetentionPolicyConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_workmail_retention_policy" "test" {
+  organization_id = aws_workmail_organization.test.id
+  name            = "%[1]s-updated"
+  description     = "updated"
+
+  folder_configuration {
+    name   = "DELETED_ITEMS"
+    action = "PERMANENTLY_DELETE"
+    period = 60
+  }
+
+  folder_configuration {
+    name   = "JUNK_EMAIL"
+    action = "DELETE"
+    period = 14
+  }
+}
+`, rName))
+}
@@ -0,0 +1,58 @@
+package workmail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusOrganizationState(ctx context.Context, conn *workmail.WorkMail, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusUserState(ctx context.Context, conn *workmail.WorkMail, organizationID, userID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusGroupState(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
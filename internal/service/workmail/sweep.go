@@ -0,0 +1,79 @@
+//go:build sweep
+// +build sweep
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	// Deleting an organization also deletes its users, groups, domains and rules.
+	resource.AddTestSweepers("aws_workmail_organization", &resource.Sweeper{
+		Name: "aws_workmail_organization",
+		F:    sweepOrganizations,
+	})
+}
+
+func sweepOrganizations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).WorkMailConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &workmail.ListOrganizationsInput{}
+
+	err = conn.ListOrganizationsPagesWithContext(ctx, input, func(page *workmail.ListOrganizationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, organization := range page.OrganizationSummaries {
+			if organization == nil {
+				continue
+			}
+
+			if state := aws.StringValue(organization.State); state == organizationStateDeleted || state == organizationStateDeleting {
+				continue
+			}
+
+			r := ResourceOrganization()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(organization.OrganizationId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkMail Organizations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing WorkMail Organizations (%s): %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping WorkMail Organizations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,92 @@
+package workmail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	entityDisabledTimeout = 5 * time.Minute
+)
+
+func waitOrganizationCreated(ctx context.Context, conn *workmail.WorkMail, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationStateCreating, organizationStateRequested},
+		Target:  []string{organizationStateActive},
+		Refresh: statusOrganizationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		if state := aws.StringValue(output.State); state == organizationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationDeleted(ctx context.Context, conn *workmail.WorkMail, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationStateActive, organizationStateDeleting},
+		Target:  []string{},
+		Refresh: statusOrganizationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		if state := aws.StringValue(output.State); state == organizationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitUserDisabled(ctx context.Context, conn *workmail.WorkMail, organizationID, userID string) (*workmail.DescribeUserOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workmail.EntityStateEnabled},
+		Target:  []string{workmail.EntityStateDisabled},
+		Refresh: statusUserState(ctx, conn, organizationID, userID),
+		Timeout: entityDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeUserOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGroupDisabled(ctx context.Context, conn *workmail.WorkMail, organizationID, groupID string) (*workmail.DescribeGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workmail.EntityStateEnabled},
+		Target:  []string{workmail.EntityStateDisabled},
+		Refresh: statusGroupState(ctx, conn, organizationID, groupID),
+		Timeout: entityDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -14,15 +14,18 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
@@ -36,6 +39,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codedeploy"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/codepipeline"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cur"
@@ -57,6 +61,8 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
@@ -64,26 +70,37 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/proton"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
@@ -102,14 +119,19 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )